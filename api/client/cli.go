@@ -10,6 +10,7 @@ import (
 
 	"github.com/docker/docker/api"
 	cliflags "github.com/docker/docker/cli/flags"
+	"github.com/docker/docker/cli/i18n"
 	"github.com/docker/docker/cliconfig"
 	"github.com/docker/docker/cliconfig/configfile"
 	"github.com/docker/docker/cliconfig/credentials"
@@ -87,6 +88,16 @@ func (cli *DockerCli) ConfigFile() *configfile.ConfigFile {
 	return cli.configFile
 }
 
+// IsTerminalIn returns true if the clients stdin is a TTY
+func (cli *DockerCli) IsTerminalIn() bool {
+	return cli.isTerminalIn
+}
+
+// InFd returns the fd for the stdin stream
+func (cli *DockerCli) InFd() uintptr {
+	return cli.inFd
+}
+
 // IsTerminalOut returns true if the clients stdin is a TTY
 func (cli *DockerCli) IsTerminalOut() bool {
 	return cli.isTerminalOut
@@ -203,7 +214,7 @@ func NewDockerCli(in io.ReadCloser, out, err io.Writer, clientFlags *cliflags.Cl
 func LoadDefaultConfigFile(err io.Writer) *configfile.ConfigFile {
 	configFile, e := cliconfig.Load(cliconfig.ConfigDir())
 	if e != nil {
-		fmt.Fprintf(err, "WARNING: Error loading config file:%v\n", e)
+		fmt.Fprintf(err, i18n.T("WARNING: Error loading config file:%v\n"), e)
 	}
 	if !configFile.ContainsAuth() {
 		credentials.DetectDefaultStore(configFile)
@@ -244,7 +255,7 @@ func getServerHost(hosts []string, tlsOptions *tlsconfig.Options) (host string,
 	case 1:
 		host = hosts[0]
 	default:
-		return "", errors.New("请只指定一个 -H")
+		return "", errors.New(i18n.T("Please specify only one -H"))
 	}
 
 	host, err = opts.ParseHost(tlsOptions != nil, host)
@@ -1,7 +1,7 @@
 package container
 
 import (
-	"fmt"
+	"errors"
 	"io"
 	"net/http/httputil"
 
@@ -10,6 +10,7 @@ import (
 	"github.com/Sirupsen/logrus"
 	"github.com/docker/docker/api/client"
 	"github.com/docker/docker/cli"
+	"github.com/docker/docker/cli/i18n"
 	"github.com/docker/docker/pkg/signal"
 	"github.com/docker/engine-api/types"
 	"github.com/spf13/cobra"
@@ -29,7 +30,7 @@ func NewAttachCommand(dockerCli *client.DockerCli) *cobra.Command {
 
 	cmd := &cobra.Command{
 		Use:   "attach [OPTIONS] CONTAINER",
-		Short: "附加到一个运行的容器，包含标准输入，标准输出，标准错误",
+		Short: i18n.T("Attach to a running container"),
 		Args:  cli.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			opts.container = args[0]
@@ -38,9 +39,9 @@ func NewAttachCommand(dockerCli *client.DockerCli) *cobra.Command {
 	}
 
 	flags := cmd.Flags()
-	flags.BoolVar(&opts.noStdin, "no-stdin", false, "不附加标准输入")
-	flags.BoolVar(&opts.proxy, "sig-proxy", true, "代理所有接收到的信号至进程")
-	flags.StringVar(&opts.detachKeys, "detach-keys", "", "覆盖从一个容器停止附加的输入键顺序")
+	flags.BoolVar(&opts.noStdin, "no-stdin", false, i18n.T("Do not attach STDIN"))
+	flags.BoolVar(&opts.proxy, "sig-proxy", true, i18n.T("Proxy all received signals to the process"))
+	flags.StringVar(&opts.detachKeys, "detach-keys", "", i18n.T("Override the key sequence for detaching a container"))
 	return cmd
 }
 
@@ -53,11 +54,11 @@ func runAttach(dockerCli *client.DockerCli, opts *attachOptions) error {
 	}
 
 	if !c.State.Running {
-		return fmt.Errorf("您不能附加到一个停止的容器中，请先启动此容器。")
+		return errors.New(i18n.T("You cannot attach to a stopped container, start it first"))
 	}
 
 	if c.State.Paused {
-		return fmt.Errorf("您不能附加到一个暂停的容器中，请先启动此容器。")
+		return errors.New(i18n.T("You cannot attach to a paused container, unpause it first"))
 	}
 
 	if err := dockerCli.CheckTtyInput(!opts.noStdin, c.Config.Tty); err != nil {
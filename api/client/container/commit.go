@@ -8,6 +8,7 @@ import (
 
 	"github.com/docker/docker/api/client"
 	"github.com/docker/docker/cli"
+	"github.com/docker/docker/cli/i18n"
 	dockeropts "github.com/docker/docker/opts"
 	"github.com/docker/engine-api/types"
 	containertypes "github.com/docker/engine-api/types/container"
@@ -31,7 +32,7 @@ func NewCommitCommand(dockerCli *client.DockerCli) *cobra.Command {
 
 	cmd := &cobra.Command{
 		Use:   "commit [OPTIONS] CONTAINER [REPOSITORY[:TAG]]",
-		Short: "从一个容器的变化部分创建一个新的镜像",
+		Short: i18n.T("Create a new image from a container's changes"),
 		Args:  cli.RequiresRangeArgs(1, 2),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			opts.container = args[0]
@@ -45,16 +46,16 @@ func NewCommitCommand(dockerCli *client.DockerCli) *cobra.Command {
 	flags := cmd.Flags()
 	flags.SetInterspersed(false)
 
-	flags.BoolVarP(&opts.pause, "pause", "p", true, "在容器提交镜像过程中先暂停容器运行")
-	flags.StringVarP(&opts.comment, "message", "m", "", "容器提交镜像的消息")
-	flags.StringVarP(&opts.author, "author", "a", "", "执行提交操作的作者 (比如, \"张三 <hannibal@a-team.com>\")")
+	flags.BoolVarP(&opts.pause, "pause", "p", true, i18n.T("Pause container during commit"))
+	flags.StringVarP(&opts.comment, "message", "m", "", i18n.T("Commit message"))
+	flags.StringVarP(&opts.author, "author", "a", "", i18n.T("Author (e.g., \"John Hannibal Smith <hannibal@a-team.com>\")"))
 
 	opts.changes = dockeropts.NewListOpts(nil)
-	flags.VarP(&opts.changes, "change", "c", "在创建的镜像中添加Dockerfile中的指令")
+	flags.VarP(&opts.changes, "change", "c", i18n.T("Apply Dockerfile instruction to the created image"))
 
 	// FIXME: --run is deprecated, it will be replaced with inline Dockerfile commands.
-	flags.StringVar(&opts.config, "run", "", "该参数已经被废弃，并会在未来的版本中被移除")
-	flags.MarkDeprecated("run", "此选项将来会被Dockerfile内部的命令所替代")
+	flags.StringVar(&opts.config, "run", "", i18n.T("This flag is deprecated and will be removed in a future version"))
+	flags.MarkDeprecated("run", i18n.T("it will be replaced with inline Dockerfile commands."))
 
 	return cmd
 }
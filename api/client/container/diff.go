@@ -1,12 +1,14 @@
 package container
 
 import (
+	"errors"
 	"fmt"
 
 	"golang.org/x/net/context"
 
 	"github.com/docker/docker/api/client"
 	"github.com/docker/docker/cli"
+	"github.com/docker/docker/cli/i18n"
 	"github.com/docker/docker/pkg/archive"
 	"github.com/spf13/cobra"
 )
@@ -21,7 +23,7 @@ func NewDiffCommand(dockerCli *client.DockerCli) *cobra.Command {
 
 	cmd := &cobra.Command{
 		Use:   "diff CONTAINER",
-		Short: "查看容器文件系统的变化差异",
+		Short: i18n.T("Inspect changes on a container's filesystem"),
 		Args:  cli.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			opts.container = args[0]
@@ -34,7 +36,7 @@ func NewDiffCommand(dockerCli *client.DockerCli) *cobra.Command {
 
 func runDiff(dockerCli *client.DockerCli, opts *diffOptions) error {
 	if opts.container == "" {
-		return fmt.Errorf("容器名称不能为空")
+		return errors.New(i18n.T("Container name cannot be empty"))
 	}
 	ctx := context.Background()
 
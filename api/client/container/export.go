@@ -8,6 +8,7 @@ import (
 
 	"github.com/docker/docker/api/client"
 	"github.com/docker/docker/cli"
+	"github.com/docker/docker/cli/i18n"
 	"github.com/spf13/cobra"
 )
 
@@ -22,7 +23,7 @@ func NewExportCommand(dockerCli *client.DockerCli) *cobra.Command {
 
 	cmd := &cobra.Command{
 		Use:   "export [OPTIONS] CONTAINER",
-		Short: "以一个压缩包的形式导出一个容器的文件系统",
+		Short: i18n.T("Export a container's filesystem as a tar archive"),
 		Args:  cli.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			opts.container = args[0]
@@ -32,14 +33,14 @@ func NewExportCommand(dockerCli *client.DockerCli) *cobra.Command {
 
 	flags := cmd.Flags()
 
-	flags.StringVarP(&opts.output, "output", "o", "", "写入一个本地文件，而不是标准输出STDOUT")
+	flags.StringVarP(&opts.output, "output", "o", "", i18n.T("Write to a file, instead of STDOUT"))
 
 	return cmd
 }
 
 func runExport(dockerCli *client.DockerCli, opts exportOptions) error {
 	if opts.output == "" && dockerCli.IsTerminalOut() {
-		return errors.New("终端拒绝保存导出内容，请使用 -o 参数或者重定向。")
+		return errors.New(i18n.T("Cowardly refusing to save to a terminal. Use the -o flag or redirect."))
 	}
 
 	clnt := dockerCli.Client()
@@ -8,6 +8,7 @@ import (
 
 	"github.com/docker/docker/api/client"
 	"github.com/docker/docker/cli"
+	"github.com/docker/docker/cli/i18n"
 	"github.com/spf13/cobra"
 )
 
@@ -23,7 +24,7 @@ func NewKillCommand(dockerCli *client.DockerCli) *cobra.Command {
 
 	cmd := &cobra.Command{
 		Use:   "kill [OPTIONS] CONTAINER [CONTAINER...]",
-		Short: "终止一个或多个运行的容器",
+		Short: i18n.T("Kill one or more running containers"),
 		Args:  cli.RequiresMinArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			opts.containers = args
@@ -32,7 +33,7 @@ func NewKillCommand(dockerCli *client.DockerCli) *cobra.Command {
 	}
 
 	flags := cmd.Flags()
-	flags.StringVarP(&opts.signal, "signal", "s", "KILL", "发送给容器的信号")
+	flags.StringVarP(&opts.signal, "signal", "s", "KILL", i18n.T("Signal to send to the container"))
 	return cmd
 }
 
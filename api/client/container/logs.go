@@ -8,6 +8,7 @@ import (
 
 	"github.com/docker/docker/api/client"
 	"github.com/docker/docker/cli"
+	"github.com/docker/docker/cli/i18n"
 	"github.com/docker/docker/pkg/stdcopy"
 	"github.com/docker/engine-api/types"
 	"github.com/spf13/cobra"
@@ -21,6 +22,7 @@ var validDrivers = map[string]bool{
 type logsOptions struct {
 	follow     bool
 	since      string
+	until      string
 	timestamps bool
 	details    bool
 	tail       string
@@ -34,7 +36,7 @@ func NewLogsCommand(dockerCli *client.DockerCli) *cobra.Command {
 
 	cmd := &cobra.Command{
 		Use:   "logs [OPTIONS] CONTAINER",
-		Short: "获取一个容器的运行日志",
+		Short: i18n.T("Fetch the logs of a container"),
 		Args:  cli.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			opts.container = args[0]
@@ -43,11 +45,12 @@ func NewLogsCommand(dockerCli *client.DockerCli) *cobra.Command {
 	}
 
 	flags := cmd.Flags()
-	flags.BoolVarP(&opts.follow, "follow", "f", false, "跟踪容器日志输出")
-	flags.StringVar(&opts.since, "since", "", "从某一个时间戳开始获取日志")
-	flags.BoolVarP(&opts.timestamps, "timestamps", "t", false, "显示日志的时间戳")
-	flags.BoolVar(&opts.details, "details", false, "显示提供给日志的额外细节")
-	flags.StringVar(&opts.tail, "tail", "all", "从日志尾部往上显示制定数量行数的日志，all代表显示所有日志")
+	flags.BoolVarP(&opts.follow, "follow", "f", false, i18n.T("Follow log output"))
+	flags.StringVar(&opts.since, "since", "", i18n.T("Show logs since timestamp"))
+	flags.StringVar(&opts.until, "until", "", i18n.T("Show logs before timestamp"))
+	flags.BoolVarP(&opts.timestamps, "timestamps", "t", false, i18n.T("Show timestamps"))
+	flags.BoolVar(&opts.details, "details", false, i18n.T("Show extra details provided to logs"))
+	flags.StringVar(&opts.tail, "tail", "all", i18n.T("Number of lines to show from the end of the logs"))
 	return cmd
 }
 
@@ -60,13 +63,14 @@ func runLogs(dockerCli *client.DockerCli, opts *logsOptions) error {
 	}
 
 	if !validDrivers[c.HostConfig.LogConfig.Type] {
-		return fmt.Errorf("\"logs\" 命令只支持\"json-file\"和\"journald\"日志驱动(当前日志驱动为: %s)", c.HostConfig.LogConfig.Type)
+		return fmt.Errorf(i18n.T("\"logs\" command is supported only for \"json-file\" and \"journald\" logging drivers (got: %s)"), c.HostConfig.LogConfig.Type)
 	}
 
 	options := types.ContainerLogsOptions{
 		ShowStdout: true,
 		ShowStderr: true,
 		Since:      opts.since,
+		Until:      opts.until,
 		Timestamps: opts.timestamps,
 		Follow:     opts.follow,
 		Tail:       opts.tail,
@@ -8,6 +8,7 @@ import (
 
 	"github.com/docker/docker/api/client"
 	"github.com/docker/docker/cli"
+	"github.com/docker/docker/cli/i18n"
 	"github.com/spf13/cobra"
 )
 
@@ -21,7 +22,7 @@ func NewPauseCommand(dockerCli *client.DockerCli) *cobra.Command {
 
 	cmd := &cobra.Command{
 		Use:   "pause CONTAINER [CONTAINER...]",
-		Short: "暂停一个或多个容器内部的所有进程的运行",
+		Short: i18n.T("Pause all processes within one or more containers"),
 		Args:  cli.RequiresMinArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			opts.containers = args
@@ -8,6 +8,7 @@ import (
 
 	"github.com/docker/docker/api/client"
 	"github.com/docker/docker/cli"
+	"github.com/docker/docker/cli/i18n"
 	"github.com/docker/go-connections/nat"
 	"github.com/spf13/cobra"
 )
@@ -24,7 +25,7 @@ func NewPortCommand(dockerCli *client.DockerCli) *cobra.Command {
 
 	cmd := &cobra.Command{
 		Use:   "port CONTAINER [PRIVATE_PORT[/PROTO]]",
-		Short: "罗列为容器指定的所有端口映射信息",
+		Short: i18n.T("List port mappings or a specific mapping for the container"),
 		Args:  cli.RequiresRangeArgs(1, 2),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			opts.container = args[0]
@@ -66,7 +67,7 @@ func runPort(dockerCli *client.DockerCli, opts *portOptions) error {
 			}
 			return nil
 		}
-		return fmt.Errorf("错误: 没有暴露端口'%s'给容器 %s", natPort, opts.container)
+		return fmt.Errorf(i18n.T("Error: No public port '%s' published for %s"), natPort, opts.container)
 	}
 
 	for from, frontends := range c.NetworkSettings.Ports {
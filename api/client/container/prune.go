@@ -0,0 +1,73 @@
+package container
+
+import (
+	"fmt"
+
+	"golang.org/x/net/context"
+
+	"github.com/docker/docker/api/client"
+	"github.com/docker/docker/cli"
+	"github.com/docker/docker/cli/i18n"
+	"github.com/docker/docker/opts"
+	"github.com/docker/engine-api/types/filters"
+	"github.com/docker/go-units"
+	"github.com/spf13/cobra"
+)
+
+type pruneOptions struct {
+	force  bool
+	filter opts.FilterOpt
+}
+
+func newPruneCommand(dockerCli *client.DockerCli) *cobra.Command {
+	opts := pruneOptions{filter: opts.NewFilterOpt()}
+
+	cmd := &cobra.Command{
+		Use:   "prune [OPTIONS]",
+		Short: i18n.T("Remove all stopped containers"),
+		Args:  cli.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runPrune(dockerCli, opts)
+		},
+	}
+
+	flags := cmd.Flags()
+	flags.BoolVarP(&opts.force, "force", "f", false, i18n.T("Do not prompt for confirmation"))
+	flags.Var(&opts.filter, "filter", i18n.T("Provide filter values (e.g. 'until=<timestamp>')"))
+
+	return cmd
+}
+
+func runPrune(dockerCli *client.DockerCli, opts pruneOptions) error {
+	if !opts.force && !client.PromptForConfirmation(dockerCli.In(), dockerCli.Out(), i18n.T("WARNING! This will remove all stopped containers.\nAre you sure you want to continue?")) {
+		return nil
+	}
+
+	spaceReclaimed, output, err := RunPrune(dockerCli, opts.filter.Value())
+	if err != nil {
+		return err
+	}
+	fmt.Fprint(dockerCli.Out(), output)
+	fmt.Fprintln(dockerCli.Out(), i18n.T("Total reclaimed space:"), units.HumanSize(float64(spaceReclaimed)))
+	return nil
+}
+
+// RunPrune removes the stopped containers matching pruneFilters without
+// asking for confirmation, and returns the space reclaimed and the list of
+// removed containers to print.
+func RunPrune(dockerCli *client.DockerCli, pruneFilters filters.Args) (uint64, string, error) {
+	report, err := dockerCli.Client().ContainersPrune(context.Background(), pruneFilters)
+	if err != nil {
+		return 0, "", err
+	}
+
+	output := ""
+	if len(report.ContainersDeleted) > 0 {
+		output = i18n.T("Deleted Containers:") + "\n"
+		for _, id := range report.ContainersDeleted {
+			output += id + "\n"
+		}
+		output += "\n"
+	}
+	return report.SpaceReclaimed, output, nil
+}
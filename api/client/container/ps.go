@@ -6,6 +6,7 @@ import (
 	"github.com/docker/docker/api/client"
 	"github.com/docker/docker/api/client/formatter"
 	"github.com/docker/docker/cli"
+	"github.com/docker/docker/cli/i18n"
 	"github.com/docker/engine-api/types"
 	"github.com/docker/engine-api/types/filters"
 
@@ -41,7 +42,7 @@ func NewPsCommand(dockerCli *client.DockerCli) *cobra.Command {
 
 	cmd := &cobra.Command{
 		Use:   "ps [OPTIONS]",
-		Short: "罗列所有容器",
+		Short: i18n.T("List containers"),
 		Args:  cli.NoArgs,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			return runPs(dockerCli, &opts)
@@ -50,14 +51,14 @@ func NewPsCommand(dockerCli *client.DockerCli) *cobra.Command {
 
 	flags := cmd.Flags()
 
-	flags.BoolVarP(&opts.quiet, "quiet", "q", false, "仅显示容器ID")
-	flags.BoolVarP(&opts.size, "size", "s", false, "显示所有的文件大小")
-	flags.BoolVarP(&opts.all, "all", "a", false, "显示所有的容器(默认仅显示运行的容器)")
-	flags.BoolVar(&opts.noTrunc, "no-trunc", false, "不截断输出")
-	flags.BoolVarP(&opts.nLatest, "latest", "l", false, "显示最新创建的容器(包含所有的状态)")
-	flags.IntVarP(&opts.last, "last", "n", -1, "显示n个最新创建的容器(包含所有的状态)")
-	flags.StringVarP(&opts.format, "format", "", "", "使用一个Go语言的模板打印容器")
-	flags.StringSliceVarP(&opts.filter, "filter", "f", []string{}, "基于指定的条件过滤命令输出内容")
+	flags.BoolVarP(&opts.quiet, "quiet", "q", false, i18n.T("Only display numeric IDs"))
+	flags.BoolVarP(&opts.size, "size", "s", false, i18n.T("Display total file sizes"))
+	flags.BoolVarP(&opts.all, "all", "a", false, i18n.T("Show all containers (default shows just running)"))
+	flags.BoolVar(&opts.noTrunc, "no-trunc", false, i18n.T("Don't truncate output"))
+	flags.BoolVarP(&opts.nLatest, "latest", "l", false, i18n.T("Show the latest created container (includes all states)"))
+	flags.IntVarP(&opts.last, "last", "n", -1, i18n.T("Show n last created containers (includes all states)"))
+	flags.StringVarP(&opts.format, "format", "", "", i18n.T("Pretty-print containers using a Go template"))
+	flags.StringSliceVarP(&opts.filter, "filter", "f", []string{}, i18n.T("Filter output based on conditions provided"))
 
 	return cmd
 }
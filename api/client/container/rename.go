@@ -1,6 +1,7 @@
 package container
 
 import (
+	"errors"
 	"fmt"
 	"strings"
 
@@ -8,6 +9,7 @@ import (
 
 	"github.com/docker/docker/api/client"
 	"github.com/docker/docker/cli"
+	"github.com/docker/docker/cli/i18n"
 	"github.com/spf13/cobra"
 )
 
@@ -22,7 +24,7 @@ func NewRenameCommand(dockerCli *client.DockerCli) *cobra.Command {
 
 	cmd := &cobra.Command{
 		Use:   "rename CONTAINER NEW_NAME",
-		Short: "重命名一个容器",
+		Short: i18n.T("Rename a container"),
 		Args:  cli.ExactArgs(2),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			opts.oldName = args[0]
@@ -41,12 +43,12 @@ func runRename(dockerCli *client.DockerCli, opts *renameOptions) error {
 	newName := strings.TrimSpace(opts.newName)
 
 	if oldName == "" || newName == "" {
-		return fmt.Errorf("错误: 新名称和旧名称均不能为空")
+		return errors.New(i18n.T("Error: Neither old nor new names may be empty"))
 	}
 
 	if err := dockerCli.Client().ContainerRename(ctx, oldName, newName); err != nil {
 		fmt.Fprintf(dockerCli.Err(), "%s\n", err)
-		return fmt.Errorf("错误: 重命名容器名称到 %s 失败", oldName)
+		return fmt.Errorf(i18n.T("Error: failed to rename container named %s"), oldName)
 	}
 	return nil
 }
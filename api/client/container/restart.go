@@ -9,6 +9,7 @@ import (
 
 	"github.com/docker/docker/api/client"
 	"github.com/docker/docker/cli"
+	"github.com/docker/docker/cli/i18n"
 	"github.com/spf13/cobra"
 )
 
@@ -24,7 +25,7 @@ func NewRestartCommand(dockerCli *client.DockerCli) *cobra.Command {
 
 	cmd := &cobra.Command{
 		Use:   "restart [OPTIONS] CONTAINER [CONTAINER...]",
-		Short: "重启一个或多个容器",
+		Short: i18n.T("Restart a container"),
 		Args:  cli.RequiresMinArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			opts.containers = args
@@ -33,7 +34,7 @@ func NewRestartCommand(dockerCli *client.DockerCli) *cobra.Command {
 	}
 
 	flags := cmd.Flags()
-	flags.IntVarP(&opts.nSeconds, "time", "t", 10, "在终止一个容器前，等待容器停止的秒数")
+	flags.IntVarP(&opts.nSeconds, "time", "t", 10, i18n.T("Seconds to wait for stop before killing the container"))
 	return cmd
 }
 
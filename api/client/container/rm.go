@@ -1,6 +1,7 @@
 package container
 
 import (
+	"errors"
 	"fmt"
 	"strings"
 
@@ -8,6 +9,7 @@ import (
 
 	"github.com/docker/docker/api/client"
 	"github.com/docker/docker/cli"
+	"github.com/docker/docker/cli/i18n"
 	"github.com/docker/engine-api/types"
 	"github.com/spf13/cobra"
 )
@@ -26,7 +28,7 @@ func NewRmCommand(dockerCli *client.DockerCli) *cobra.Command {
 
 	cmd := &cobra.Command{
 		Use:   "rm [OPTIONS] CONTAINER [CONTAINER...]",
-		Short: "删除一个或多个容器",
+		Short: i18n.T("Remove one or more containers"),
 		Args:  cli.RequiresMinArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			opts.containers = args
@@ -35,9 +37,9 @@ func NewRmCommand(dockerCli *client.DockerCli) *cobra.Command {
 	}
 
 	flags := cmd.Flags()
-	flags.BoolVarP(&opts.rmVolumes, "volumes", "v", false, "删除容器时同时删除容器相关的存储卷")
-	flags.BoolVarP(&opts.rmLink, "link", "l", false, "删除指定的链接")
-	flags.BoolVarP(&opts.force, "force", "f", false, "强制删除一个运行的容器(使用信号SIGKILL)")
+	flags.BoolVarP(&opts.rmVolumes, "volumes", "v", false, i18n.T("Remove the volumes associated with the container"))
+	flags.BoolVarP(&opts.rmLink, "link", "l", false, i18n.T("Remove the specified link"))
+	flags.BoolVarP(&opts.force, "force", "f", false, i18n.T("Force the removal of a running container (uses SIGKILL)"))
 	return cmd
 }
 
@@ -47,7 +49,7 @@ func runRm(dockerCli *client.DockerCli, opts *rmOptions) error {
 	var errs []string
 	for _, name := range opts.containers {
 		if name == "" {
-			return fmt.Errorf("容器名不能为空")
+			return errors.New(i18n.T("Container name cannot be empty"))
 		}
 		name = strings.Trim(name, "/")
 
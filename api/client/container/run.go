@@ -1,6 +1,7 @@
 package container
 
 import (
+	"errors"
 	"fmt"
 	"io"
 	"net/http/httputil"
@@ -14,6 +15,7 @@ import (
 	"github.com/Sirupsen/logrus"
 	"github.com/docker/docker/api/client"
 	"github.com/docker/docker/cli"
+	"github.com/docker/docker/cli/i18n"
 	opttypes "github.com/docker/docker/opts"
 	"github.com/docker/docker/pkg/promise"
 	"github.com/docker/docker/pkg/signal"
@@ -44,7 +46,7 @@ func NewRunCommand(dockerCli *client.DockerCli) *cobra.Command {
 
 	cmd := &cobra.Command{
 		Use:   "run [OPTIONS] IMAGE [COMMAND] [ARG...]",
-		Short: "在一个新的容器中运行一条命令",
+		Short: i18n.T("Run a command in a new container"),
 		Args:  cli.RequiresMinArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			copts.Image = args[0]
@@ -59,15 +61,15 @@ func NewRunCommand(dockerCli *client.DockerCli) *cobra.Command {
 	flags.SetInterspersed(false)
 
 	// These are flags not stored in Config/HostConfig
-	flags.BoolVar(&opts.autoRemove, "rm", false, "当容器退出时自动删除容器")
-	flags.BoolVarP(&opts.detach, "detach", "d", false, "在后台运行容器并打印容器ID")
-	flags.BoolVar(&opts.sigProxy, "sig-proxy", true, "代理指定的信号到容器运行进程")
-	flags.StringVar(&opts.name, "name", "", "为容器赋予一个名称")
-	flags.StringVar(&opts.detachKeys, "detach-keys", "", "覆盖从容器停止附加时的按键值顺序")
+	flags.BoolVar(&opts.autoRemove, "rm", false, i18n.T("Automatically remove the container when it exits"))
+	flags.BoolVarP(&opts.detach, "detach", "d", false, i18n.T("Run container in background and print container ID"))
+	flags.BoolVar(&opts.sigProxy, "sig-proxy", true, i18n.T("Proxy received signals to the process"))
+	flags.StringVar(&opts.name, "name", "", i18n.T("Assign a name to the container"))
+	flags.StringVar(&opts.detachKeys, "detach-keys", "", i18n.T("Override the key sequence for detaching a container"))
 
 	// Add an explicit help that doesn't have a `-h` to prevent the conflict
 	// with hostname
-	flags.Bool("help", false, "打印用途")
+	flags.Bool("help", false, i18n.T("Print usage"))
 
 	client.AddTrustedFlags(flags, true)
 	copts = runconfigopts.AddFlags(flags)
@@ -82,9 +84,9 @@ func runRun(dockerCli *client.DockerCli, flags *pflag.FlagSet, opts *runOptions,
 
 	var (
 		flAttach                              *opttypes.ListOpts
-		ErrConflictAttachDetach               = fmt.Errorf("选项冲突: -a and -d")
-		ErrConflictRestartPolicyAndAutoRemove = fmt.Errorf("选项冲突: --restart and --rm")
-		ErrConflictDetachAutoRemove           = fmt.Errorf("选项冲突: --rm and -d")
+		ErrConflictAttachDetach               = errors.New(i18n.T("Conflicting options: -a and -d"))
+		ErrConflictRestartPolicyAndAutoRemove = errors.New(i18n.T("Conflicting options: --restart and --rm"))
+		ErrConflictDetachAutoRemove           = errors.New(i18n.T("Conflicting options: --rm and -d"))
 	)
 
 	config, hostConfig, networkingConfig, err := runconfigopts.Parse(flags, copts)
@@ -96,7 +98,7 @@ func runRun(dockerCli *client.DockerCli, flags *pflag.FlagSet, opts *runOptions,
 	}
 
 	if hostConfig.OomKillDisable != nil && *hostConfig.OomKillDisable && hostConfig.Memory == 0 {
-		fmt.Fprintf(stderr, "警告: 在容器上禁用OOM killer时没有设定'-m/--memory'限制将带来危险.\n")
+		fmt.Fprint(stderr, i18n.T("WARNING: Disabling the OOM killer on containers without setting a '-m/--memory' limit may be dangerous.\n"))
 	}
 
 	if len(hostConfig.DNS) > 0 {
@@ -105,7 +107,7 @@ func runRun(dockerCli *client.DockerCli, flags *pflag.FlagSet, opts *runOptions,
 		// set a DNS to a localhost address
 		for _, dnsIP := range hostConfig.DNS {
 			if dns.IsLocalhost(dnsIP) {
-				fmt.Fprintf(stderr, "警告: 本地的DNS设定(--dns=%s)在容器内有可能失效.\n", dnsIP)
+				fmt.Fprintf(stderr, i18n.T("WARNING: Localhost DNS setting (--dns=%s) may fail in containers.\n"), dnsIP)
 				break
 			}
 		}
@@ -248,7 +250,7 @@ func runRun(dockerCli *client.DockerCli, flags *pflag.FlagSet, opts *runOptions,
 
 	if (config.AttachStdin || config.AttachStdout || config.AttachStderr) && config.Tty && dockerCli.IsTerminalOut() {
 		if err := dockerCli.MonitorTtySize(ctx, createResponse.ID, false); err != nil {
-			fmt.Fprintf(stderr, "监视终端大小出错: %s\n", err)
+			fmt.Fprintf(stderr, i18n.T("Error monitoring TTY size: %s\n"), err)
 		}
 	}
 
@@ -12,8 +12,8 @@ import (
 
 	"github.com/Sirupsen/logrus"
 	"github.com/docker/docker/api/client"
-	"github.com/docker/docker/api/client/system"
 	"github.com/docker/docker/cli"
+	"github.com/docker/docker/cli/i18n"
 	"github.com/docker/engine-api/types"
 	"github.com/docker/engine-api/types/events"
 	"github.com/docker/engine-api/types/filters"
@@ -33,7 +33,7 @@ func NewStatsCommand(dockerCli *client.DockerCli) *cobra.Command {
 
 	cmd := &cobra.Command{
 		Use:   "stats [OPTIONS] [CONTAINER...]",
-		Short: "显示容器资源使用情况的实时流",
+		Short: i18n.T("Display a live stream of container(s) resource usage statistics"),
 		Args:  cli.RequiresMinArgs(0),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			opts.containers = args
@@ -42,8 +42,8 @@ func NewStatsCommand(dockerCli *client.DockerCli) *cobra.Command {
 	}
 
 	flags := cmd.Flags()
-	flags.BoolVarP(&opts.all, "all", "a", false, "显示所有容器(默认仅显示运行状态的容器)")
-	flags.BoolVar(&opts.noStream, "no-stream", false, "禁用实时数据并下拉第一次返回的结果")
+	flags.BoolVarP(&opts.all, "all", "a", false, i18n.T("Show all containers (default shows just running)"))
+	flags.BoolVar(&opts.noStream, "no-stream", false, i18n.T("Disable streaming stats and only pull the first result"))
 	return cmd
 }
 
@@ -73,7 +73,7 @@ func runStats(dockerCli *client.DockerCli, opts *statsOptions) error {
 		}
 		defer resBody.Close()
 
-		system.DecodeEvents(resBody, func(event events.Message, err error) error {
+		client.DecodeEvents(resBody, func(event events.Message, err error) error {
 			if err != nil {
 				closeChan <- err
 				return nil
@@ -112,7 +112,7 @@ func runStats(dockerCli *client.DockerCli, opts *statsOptions) error {
 		// retrieving the list of running containers to avoid a race where we
 		// would "miss" a creation.
 		started := make(chan struct{})
-		eh := system.InitEventHandler()
+		eh := client.InitEventHandler()
 		eh.Handle("create", func(e events.Message) {
 			if opts.all {
 				s := &containerStats{Name: e.ID[:12]}
@@ -187,7 +187,7 @@ func runStats(dockerCli *client.DockerCli, opts *statsOptions) error {
 			fmt.Fprint(dockerCli.Out(), "\033[2J")
 			fmt.Fprint(dockerCli.Out(), "\033[H")
 		}
-		io.WriteString(w, "容器\tCPU %\t内存使用量/限制\t内存 %\t网络I/O\t磁盘I/O\t进程号\n")
+		io.WriteString(w, i18n.T("CONTAINER\tCPU %\tMEM USAGE / LIMIT\tMEM %\tNET I/O\tBLOCK I/O\tPIDS\n"))
 	}
 
 	for range time.Tick(500 * time.Millisecond) {
@@ -10,6 +10,7 @@ import (
 	"time"
 
 	"github.com/Sirupsen/logrus"
+	"github.com/docker/docker/cli/i18n"
 	"github.com/docker/engine-api/client"
 	"github.com/docker/engine-api/types"
 	"github.com/docker/go-units"
@@ -148,7 +149,7 @@ func (s *containerStats) Collect(ctx context.Context, cli client.APIClient, stre
 			s.BlockRead = 0
 			s.BlockWrite = 0
 			s.PidsCurrent = 0
-			s.err = errors.New("timeout waiting for stats")
+			s.err = errors.New(i18n.T("timeout waiting for stats"))
 			s.mu.Unlock()
 			// if this is the first stat you get, release WaitGroup
 			if !getFirst {
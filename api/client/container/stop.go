@@ -9,6 +9,7 @@ import (
 
 	"github.com/docker/docker/api/client"
 	"github.com/docker/docker/cli"
+	"github.com/docker/docker/cli/i18n"
 	"github.com/spf13/cobra"
 )
 
@@ -24,7 +25,7 @@ func NewStopCommand(dockerCli *client.DockerCli) *cobra.Command {
 
 	cmd := &cobra.Command{
 		Use:   "stop [OPTIONS] CONTAINER [CONTAINER...]",
-		Short: "停止一个或多个运行容器",
+		Short: i18n.T("Stop one or more running containers"),
 		Args:  cli.RequiresMinArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			opts.containers = args
@@ -33,7 +34,7 @@ func NewStopCommand(dockerCli *client.DockerCli) *cobra.Command {
 	}
 
 	flags := cmd.Flags()
-	flags.IntVarP(&opts.time, "time", "t", 10, "终止容器前等待容器停止的秒数")
+	flags.IntVarP(&opts.time, "time", "t", 10, i18n.T("Seconds to wait for stop before killing it"))
 	return cmd
 }
 
@@ -8,6 +8,7 @@ import (
 
 	"github.com/docker/docker/api/client"
 	"github.com/docker/docker/cli"
+	"github.com/docker/docker/cli/i18n"
 	"github.com/spf13/cobra"
 )
 
@@ -21,7 +22,7 @@ func NewWaitCommand(dockerCli *client.DockerCli) *cobra.Command {
 
 	cmd := &cobra.Command{
 		Use:   "wait CONTAINER [CONTAINER...]",
-		Short: "阻塞直到一个或多个容器停止运行，并打印它们的容器退出码",
+		Short: i18n.T("Block until a container stops, then print its exit code"),
 		Args:  cli.RequiresMinArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			opts.containers = args
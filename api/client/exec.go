@@ -8,6 +8,7 @@ import (
 
 	"github.com/Sirupsen/logrus"
 	Cli "github.com/docker/docker/cli"
+	"github.com/docker/docker/cli/i18n"
 	flag "github.com/docker/docker/pkg/mflag"
 	"github.com/docker/docker/pkg/promise"
 	"github.com/docker/engine-api/types"
@@ -18,7 +19,7 @@ import (
 // Usage: docker exec [OPTIONS] CONTAINER COMMAND [ARG...]
 func (cli *DockerCli) CmdExec(args ...string) error {
 	cmd := Cli.Subcmd("exec", []string{"[OPTIONS] CONTAINER COMMAND [ARG...]"}, Cli.DockerCommands["exec"].Description, true)
-	detachKeys := cmd.String([]string{"-detach-keys"}, "", "覆盖从容器停止附加的退出键顺序")
+	detachKeys := cmd.String([]string{"-detach-keys"}, "", i18n.T("Override the key sequence for detaching a container"))
 
 	execConfig, err := ParseExec(cmd, args)
 	container := cmd.Arg(0)
@@ -43,7 +44,7 @@ func (cli *DockerCli) CmdExec(args ...string) error {
 
 	execID := response.ID
 	if execID == "" {
-		fmt.Fprintf(cli.out, "exec ID为空")
+		fmt.Fprint(cli.out, i18n.T("exec ID empty"))
 		return nil
 	}
 
@@ -98,7 +99,7 @@ func (cli *DockerCli) CmdExec(args ...string) error {
 
 	if execConfig.Tty && cli.isTerminalIn {
 		if err := cli.MonitorTtySize(ctx, execID, true); err != nil {
-			fmt.Fprintf(cli.err, "Error monitoring TTY size: %s\n", err)
+			fmt.Fprintf(cli.err, i18n.T("Error monitoring TTY size: %s\n"), err)
 		}
 	}
 
@@ -125,11 +126,11 @@ func (cli *DockerCli) CmdExec(args ...string) error {
 // not valid, it will return an error.
 func ParseExec(cmd *flag.FlagSet, args []string) (*types.ExecConfig, error) {
 	var (
-		flStdin      = cmd.Bool([]string{"i", "-interactive"}, false, "即使容器没有被附加标准输出，标准错误，也爆出输出输入的畅通")
-		flTty        = cmd.Bool([]string{"t", "-tty"}, false, "分配一个伪终端")
-		flDetach     = cmd.Bool([]string{"d", "-detach"}, false, "后台模式: 在后台运行用户指定的命令")
-		flUser       = cmd.String([]string{"u", "-user"}, "", "用户名或用户名ID (格式: <用户名|用户名ID>[:<组|组ID>])")
-		flPrivileged = cmd.Bool([]string{"-privileged"}, false, "为运行命令授予格外的特权")
+		flStdin      = cmd.Bool([]string{"i", "-interactive"}, false, i18n.T("Keep STDIN open even if not attached"))
+		flTty        = cmd.Bool([]string{"t", "-tty"}, false, i18n.T("Allocate a pseudo-TTY"))
+		flDetach     = cmd.Bool([]string{"d", "-detach"}, false, i18n.T("Detached mode: run command in the background"))
+		flUser       = cmd.String([]string{"u", "-user"}, "", i18n.T("Username or UID (format: <name|uid>[:<group|gid>])"))
+		flPrivileged = cmd.Bool([]string{"-privileged"}, false, i18n.T("Give extended privileges to the command"))
 		execCmd      []string
 	)
 	cmd.Require(flag.Min, 2)
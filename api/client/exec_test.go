@@ -5,6 +5,7 @@ import (
 	"io/ioutil"
 	"testing"
 
+	"github.com/docker/docker/cli/i18n"
 	flag "github.com/docker/docker/pkg/mflag"
 	"github.com/docker/engine-api/types"
 )
@@ -14,6 +15,9 @@ type arguments struct {
 }
 
 func TestParseExec(t *testing.T) {
+	i18n.SetLocale(i18n.SourceLocale)
+	defer i18n.SetLocale("")
+
 	invalids := map[*arguments]error{
 		&arguments{[]string{"-unknown"}}: fmt.Errorf("flag provided but not defined: -unknown"),
 		&arguments{[]string{"-u"}}:       fmt.Errorf("flag needs an argument: -u"),
@@ -4,20 +4,25 @@ import (
 	"archive/tar"
 	"bufio"
 	"bytes"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"os"
 	"path/filepath"
 	"regexp"
 	"runtime"
+	"strings"
 
 	"golang.org/x/net/context"
 
 	"github.com/docker/docker/api"
 	"github.com/docker/docker/api/client"
 	"github.com/docker/docker/builder"
+	"github.com/docker/docker/builder/dockerfile/lint"
 	"github.com/docker/docker/builder/dockerignore"
 	"github.com/docker/docker/cli"
+	"github.com/docker/docker/cli/i18n"
 	"github.com/docker/docker/opts"
 	"github.com/docker/docker/pkg/archive"
 	"github.com/docker/docker/pkg/fileutils"
@@ -55,21 +60,37 @@ type buildOptions struct {
 	rm             bool
 	forceRm        bool
 	pull           bool
+	target         string
+	squash         bool
+	cacheFrom      []string
+	networkMode    string
+	extraHosts     opts.ListOpts
+	progress       string
+	check          bool
 }
 
+const (
+	// progressPlain prints the output of the build as it comes.
+	progressPlain = "plain"
+	// progressJSON prints a JSON object for every step of the build, and
+	// the output of the build to the standard error.
+	progressJSON = "json"
+)
+
 // NewBuildCommand creates a new `docker build` command
 func NewBuildCommand(dockerCli *client.DockerCli) *cobra.Command {
 	ulimits := make(map[string]*units.Ulimit)
 	options := buildOptions{
-		tags:      opts.NewListOpts(validateTag),
-		buildArgs: opts.NewListOpts(runconfigopts.ValidateEnv),
-		ulimits:   runconfigopts.NewUlimitOpt(&ulimits),
-		labels:    opts.NewListOpts(runconfigopts.ValidateEnv),
+		tags:       opts.NewListOpts(validateTag),
+		buildArgs:  opts.NewListOpts(runconfigopts.ValidateEnv),
+		ulimits:    runconfigopts.NewUlimitOpt(&ulimits),
+		labels:     opts.NewListOpts(runconfigopts.ValidateEnv),
+		extraHosts: opts.NewListOpts(runconfigopts.ValidateExtraHost),
 	}
 
 	cmd := &cobra.Command{
 		Use:   "build [OPTIONS] PATH | URL | -",
-		Short: "从一个Dockerfile构建新的镜像",
+		Short: i18n.T("Build an image from a Dockerfile"),
 		Args:  cli.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			options.context = args[0]
@@ -79,26 +100,33 @@ func NewBuildCommand(dockerCli *client.DockerCli) *cobra.Command {
 
 	flags := cmd.Flags()
 
-	flags.VarP(&options.tags, "tag", "t", "镜像名称以及可选的标签，若指定标签，格式为：'名称:标签' ")
-	flags.Var(&options.buildArgs, "build-arg", "设置构建时的环境变量")
-	flags.Var(options.ulimits, "ulimit", "设置Ulimit参数")
-	flags.StringVarP(&options.dockerfileName, "file", "f", "", "Dockerfile的名称(默认为当前目录下的Dockerfile文件路径)")
-	flags.StringVarP(&options.memory, "memory", "m", "", "内存限制")
-	flags.StringVar(&options.memorySwap, "memory-swap", "", "交换内存限制 等于 实际内存 ＋ 交换区内存: '-1' 代表启用不受限的交换区内存")
-	flags.StringVar(&options.shmSize, "shm-size", "", "共享内存/dev/shm 的大小, 默认值是64MB")
-	flags.Int64VarP(&options.cpuShares, "cpu-shares", "c", 0, "CPU计算资源的值(相对值)")
-	flags.Int64Var(&options.cpuPeriod, "cpu-period", 0, "限制CPU绝对公平调度算法(CFS)的时间周期")
-	flags.Int64Var(&options.cpuQuota, "cpu-quota", 0, "限制CPU绝对公平调度算法(CFS)的时间限额")
-	flags.StringVar(&options.cpuSetCpus, "cpuset-cpus", "", "允许容器执行的CPU核指定(0-3,0,1): 0-3代表运行运行在0,1,2,3这4个核上")
-	flags.StringVar(&options.cpuSetMems, "cpuset-mems", "", "允许容器执行的CPU内存所在核指定(0-3,0,1): 0-3代表运行运行在0,1,2,3这4个核上")
-	flags.StringVar(&options.cgroupParent, "cgroup-parent", "", "容器的可选cgroup父路径")
-	flags.StringVar(&options.isolation, "isolation", "", "设置容器的隔离策略")
-	flags.Var(&options.labels, "label", "为一个镜像设置元数据")
-	flags.BoolVar(&options.noCache, "no-cache", false, "构建镜像时不使用镜像缓存")
-	flags.BoolVar(&options.rm, "rm", true, "成狗构建后删除中间容器")
-	flags.BoolVar(&options.forceRm, "force-rm", false, "总是产出中间结果的容器")
-	flags.BoolVarP(&options.quiet, "quiet", "q", false, "压缩构建输出，并在构建成功时打印镜像ID")
-	flags.BoolVar(&options.pull, "pull", false, "总是尝试下拉最新版本的镜像")
+	flags.VarP(&options.tags, "tag", "t", i18n.T("Name and optionally a tag in the 'name:tag' format"))
+	flags.Var(&options.buildArgs, "build-arg", i18n.T("Set build-time variables"))
+	flags.Var(options.ulimits, "ulimit", i18n.T("Ulimit options"))
+	flags.StringVarP(&options.dockerfileName, "file", "f", "", i18n.T("Name of the Dockerfile (Default is 'PATH/Dockerfile')"))
+	flags.StringVarP(&options.memory, "memory", "m", "", i18n.T("Memory limit"))
+	flags.StringVar(&options.memorySwap, "memory-swap", "", i18n.T("Swap limit equal to memory plus swap: '-1' to enable unlimited swap"))
+	flags.StringVar(&options.shmSize, "shm-size", "", i18n.T("Size of /dev/shm, default value is 64MB"))
+	flags.Int64VarP(&options.cpuShares, "cpu-shares", "c", 0, i18n.T("CPU shares (relative weight)"))
+	flags.Int64Var(&options.cpuPeriod, "cpu-period", 0, i18n.T("Limit the CPU CFS (Completely Fair Scheduler) period"))
+	flags.Int64Var(&options.cpuQuota, "cpu-quota", 0, i18n.T("Limit the CPU CFS (Completely Fair Scheduler) quota"))
+	flags.StringVar(&options.cpuSetCpus, "cpuset-cpus", "", i18n.T("CPUs in which to allow execution (0-3, 0,1)"))
+	flags.StringVar(&options.cpuSetMems, "cpuset-mems", "", i18n.T("MEMs in which to allow execution (0-3, 0,1)"))
+	flags.StringVar(&options.cgroupParent, "cgroup-parent", "", i18n.T("Optional parent cgroup for the container"))
+	flags.StringVar(&options.isolation, "isolation", "", i18n.T("Container isolation technology"))
+	flags.Var(&options.labels, "label", i18n.T("Set metadata for an image"))
+	flags.BoolVar(&options.noCache, "no-cache", false, i18n.T("Do not use cache when building the image"))
+	flags.BoolVar(&options.rm, "rm", true, i18n.T("Remove intermediate containers after a successful build"))
+	flags.BoolVar(&options.forceRm, "force-rm", false, i18n.T("Always remove intermediate containers"))
+	flags.BoolVarP(&options.quiet, "quiet", "q", false, i18n.T("Suppress the build output and print image ID on success"))
+	flags.BoolVar(&options.pull, "pull", false, i18n.T("Always attempt to pull a newer version of the image"))
+	flags.StringVar(&options.target, "target", "", i18n.T("Set the target build stage to build"))
+	flags.BoolVar(&options.squash, "squash", false, i18n.T("Squash newly built layers into a single new layer"))
+	flags.StringSliceVar(&options.cacheFrom, "cache-from", []string{}, i18n.T("Images to consider as cache sources"))
+	flags.StringVar(&options.networkMode, "network", "default", i18n.T("Set the networking mode for the RUN instructions during build"))
+	flags.Var(&options.extraHosts, "add-host", i18n.T("Add a custom host-to-IP mapping (host:ip)"))
+	flags.StringVar(&options.progress, "progress", progressPlain, i18n.T("Set type of progress output (plain, json)"))
+	flags.BoolVar(&options.check, "check", false, i18n.T("Check the Dockerfile for common mistakes instead of building it"))
 
 	client.AddTrustedFlags(flags, true)
 
@@ -122,8 +150,25 @@ func runBuild(dockerCli *client.DockerCli, options buildOptions) error {
 		buildBuff     io.Writer
 	)
 
-	progBuff = dockerCli.Out()
-	buildBuff = dockerCli.Out()
+	var auxCallback func(*json.RawMessage)
+	switch options.progress {
+	case progressPlain:
+		progBuff = dockerCli.Out()
+		buildBuff = dockerCli.Out()
+	case progressJSON:
+		// Only the step events go to the standard output, one per line.
+		progBuff = dockerCli.Err()
+		buildBuff = dockerCli.Err()
+		auxCallback = func(aux *json.RawMessage) {
+			var step builder.BuildStep
+			if err := json.Unmarshal(*aux, &step); err != nil || step.Step == 0 {
+				return
+			}
+			fmt.Fprintf(dockerCli.Out(), "%s\n", *aux)
+		}
+	default:
+		return fmt.Errorf(i18n.T("invalid progress type: %s, expected %s or %s"), options.progress, progressPlain, progressJSON)
+	}
 	if options.quiet {
 		progBuff = bytes.NewBuffer(nil)
 		buildBuff = bytes.NewBuffer(nil)
@@ -144,7 +189,7 @@ func runBuild(dockerCli *client.DockerCli, options buildOptions) error {
 		if options.quiet && urlutil.IsURL(specifiedContext) {
 			fmt.Fprintln(dockerCli.Err(), progBuff)
 		}
-		return fmt.Errorf("准备构建上下文失败: %s", err)
+		return fmt.Errorf(i18n.T("unable to prepare context: %s"), err)
 	}
 
 	if tempDir != "" {
@@ -152,11 +197,19 @@ func runBuild(dockerCli *client.DockerCli, options buildOptions) error {
 		contextDir = tempDir
 	}
 
+	if options.check {
+		if buildCtx != nil {
+			buildCtx.Close()
+			return errors.New(i18n.T("--check requires a local directory or a Git repository as context"))
+		}
+		return checkDockerfile(dockerCli, filepath.Join(contextDir, relDockerfile), options.progress)
+	}
+
 	if buildCtx == nil {
 		// And canonicalize dockerfile name to a platform-independent one
 		relDockerfile, err = archive.CanonicalTarNameForPath(relDockerfile)
 		if err != nil {
-			return fmt.Errorf("不能规范Dockerfile路径 %s: %v", relDockerfile, err)
+			return fmt.Errorf(i18n.T("Cannot canonicalize dockerfile path %s: %v"), relDockerfile, err)
 		}
 
 		f, err := os.Open(filepath.Join(contextDir, ".dockerignore"))
@@ -173,7 +226,7 @@ func runBuild(dockerCli *client.DockerCli, options buildOptions) error {
 		}
 
 		if err := builder.ValidateContextDirectory(contextDir, excludes); err != nil {
-			return fmt.Errorf("检验构建上下文出错: '%s'.", err)
+			return fmt.Errorf(i18n.T("Error checking context: '%s'."), err)
 		}
 
 		// If .dockerignore mentions .dockerignore or the Dockerfile
@@ -266,6 +319,11 @@ func runBuild(dockerCli *client.DockerCli, options buildOptions) error {
 		BuildArgs:      runconfigopts.ConvertKVStringsToMap(options.buildArgs.GetAll()),
 		AuthConfigs:    dockerCli.RetrieveAuthConfigs(),
 		Labels:         runconfigopts.ConvertKVStringsToMap(options.labels.GetAll()),
+		Target:         options.target,
+		Squash:         options.squash,
+		CacheFrom:      options.cacheFrom,
+		NetworkMode:    options.networkMode,
+		ExtraHosts:     options.extraHosts.GetAll(),
 	}
 
 	response, err := dockerCli.Client().ImageBuild(ctx, body, buildOptions)
@@ -274,7 +332,8 @@ func runBuild(dockerCli *client.DockerCli, options buildOptions) error {
 	}
 	defer response.Body.Close()
 
-	err = jsonmessage.DisplayJSONMessagesStream(response.Body, buildBuff, dockerCli.OutFd(), dockerCli.IsTerminalOut(), nil)
+	isTerminal := dockerCli.IsTerminalOut() && options.progress == progressPlain
+	err = jsonmessage.DisplayJSONMessagesStream(response.Body, buildBuff, dockerCli.OutFd(), isTerminal, auxCallback)
 	if err != nil {
 		if jerr, ok := err.(*jsonmessage.JSONError); ok {
 			// If no error code is set, default to 1
@@ -291,7 +350,7 @@ func runBuild(dockerCli *client.DockerCli, options buildOptions) error {
 	// Windows: show error message about modified file permissions if the
 	// daemon isn't running Windows.
 	if response.OSType != "windows" && runtime.GOOS == "windows" {
-		fmt.Fprintln(dockerCli.Err(), `安全警告: 您在一个非Windows的Docker引擎上构建Windows机器的Docker的镜像。所有添加到构建上下文的文件和目录将添加'-rwxr-xr-x'权限。推荐您为敏感的文件和目录进行权限的重复查验。`)
+		fmt.Fprintln(dockerCli.Err(), i18n.T(`SECURITY WARNING: You are building a Docker image from Windows against a non-Windows Docker host. All files and directories added to build context will have '-rwxr-xr-x' permissions. It is recommended to double check and reset permissions for sensitive files and directories.`))
 	}
 
 	// Everything worked so if -q was provided the output from the daemon
@@ -315,6 +374,40 @@ func runBuild(dockerCli *client.DockerCli, options buildOptions) error {
 
 type translatorFunc func(context.Context, reference.NamedTagged) (reference.Canonical, error)
 
+// checkDockerfile prints the findings of the linter for the Dockerfile at
+// path, as text or as one JSON object per line. It fails if any finding is
+// an error.
+func checkDockerfile(dockerCli *client.DockerCli, path, progressType string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	findings, err := lint.Lint(f)
+	if err != nil {
+		return fmt.Errorf(i18n.T("failed to parse %s: %v"), path, err)
+	}
+
+	failed := false
+	for _, finding := range findings {
+		if progressType == progressJSON {
+			if err := json.NewEncoder(dockerCli.Out()).Encode(finding); err != nil {
+				return err
+			}
+		} else {
+			fmt.Fprintf(dockerCli.Out(), "%s:%d: %s %s: %s\n", path, finding.Line, finding.Severity, finding.Rule, finding.Message)
+		}
+		if finding.Severity == lint.SeverityError {
+			failed = true
+		}
+	}
+	if failed {
+		return cli.StatusError{StatusCode: 1}
+	}
+	return nil
+}
+
 // validateTag checks if the given image name can be resolved.
 func validateTag(rawRepo string) (string, error) {
 	_, err := reference.ParseNamed(rawRepo)
@@ -325,7 +418,10 @@ func validateTag(rawRepo string) (string, error) {
 	return rawRepo, nil
 }
 
-var dockerfileFromLinePattern = regexp.MustCompile(`(?i)^[\s]*FROM[ \f\r\t\v]+(?P<image>[^ \f\r\t\v\n#]+)`)
+var (
+	dockerfileFromLinePattern  = regexp.MustCompile(`(?i)^[\s]*FROM[ \f\r\t\v]+(?P<image>[^ \f\r\t\v\n#]+)`)
+	dockerfileFromStagePattern = regexp.MustCompile(`(?i)^[\s]*FROM[ \f\r\t\v]+[^ \f\r\t\v\n#]+[ \f\r\t\v]+AS[ \f\r\t\v]+(?P<stage>[^ \f\r\t\v\n#]+)`)
+)
 
 // resolvedTag records the repository, tag, and resolved digest reference
 // from a Dockerfile rewrite.
@@ -341,13 +437,17 @@ type resolvedTag struct {
 func rewriteDockerfileFrom(ctx context.Context, dockerfile io.Reader, translator translatorFunc) (newDockerfile []byte, resolvedTags []*resolvedTag, err error) {
 	scanner := bufio.NewScanner(dockerfile)
 	buf := bytes.NewBuffer(nil)
+	// The names of the stages of a multi-stage build, which are not images.
+	stages := make(map[string]bool)
 
 	// Scan the lines of the Dockerfile, looking for a "FROM" line.
 	for scanner.Scan() {
 		line := scanner.Text()
 
 		matches := dockerfileFromLinePattern.FindStringSubmatch(line)
-		if matches != nil && matches[1] != api.NoBaseImageSpecifier {
+		// Images referring to build args are only known to the daemon, and
+		// are left as they are.
+		if matches != nil && matches[1] != api.NoBaseImageSpecifier && !stages[strings.ToLower(matches[1])] && !strings.Contains(matches[1], "$") {
 			// Replace the line with a resolved "FROM repo@digest"
 			ref, err := reference.ParseNamed(matches[1])
 			if err != nil {
@@ -368,6 +468,10 @@ func rewriteDockerfileFrom(ctx context.Context, dockerfile io.Reader, translator
 			}
 		}
 
+		if stage := dockerfileFromStagePattern.FindStringSubmatch(line); stage != nil {
+			stages[strings.ToLower(stage[1])] = true
+		}
+
 		_, err := fmt.Fprintln(buf, line)
 		if err != nil {
 			return nil, nil, err
@@ -6,6 +6,7 @@ import (
 	"github.com/docker/docker/api/client"
 	"github.com/docker/docker/api/client/formatter"
 	"github.com/docker/docker/cli"
+	"github.com/docker/docker/cli/i18n"
 	"github.com/docker/engine-api/types"
 	"github.com/docker/engine-api/types/filters"
 	"github.com/spf13/cobra"
@@ -28,7 +29,7 @@ func NewImagesCommand(dockerCli *client.DockerCli) *cobra.Command {
 
 	cmd := &cobra.Command{
 		Use:   "images [OPTIONS] [REPOSITORY[:TAG]]",
-		Short: "罗列所有镜像",
+		Short: i18n.T("List images"),
 		Args:  cli.RequiresMaxArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			if len(args) > 0 {
@@ -40,12 +41,12 @@ func NewImagesCommand(dockerCli *client.DockerCli) *cobra.Command {
 
 	flags := cmd.Flags()
 
-	flags.BoolVarP(&opts.quiet, "quiet", "q", false, "仅显示数字ID")
-	flags.BoolVarP(&opts.all, "all", "a", false, "显示所有的镜像(默认情况隐藏中间镜像)")
-	flags.BoolVar(&opts.noTrunc, "no-trunc", false, "不截断命令输出内容")
-	flags.BoolVar(&opts.showDigests, "digests", false, "显示验证信息")
-	flags.StringVar(&opts.format, "format", "", "使用一个Go语言模板打印镜像信息")
-	flags.StringSliceVarP(&opts.filter, "filter", "f", []string{}, "基于指定条件过滤命令输出内容")
+	flags.BoolVarP(&opts.quiet, "quiet", "q", false, i18n.T("Only show numeric IDs"))
+	flags.BoolVarP(&opts.all, "all", "a", false, i18n.T("Show all images (default hides intermediate images)"))
+	flags.BoolVar(&opts.noTrunc, "no-trunc", false, i18n.T("Do not truncate the output"))
+	flags.BoolVar(&opts.showDigests, "digests", false, i18n.T("Show digests"))
+	flags.StringVar(&opts.format, "format", "", i18n.T("Pretty-print images using a Go template"))
+	flags.StringSliceVarP(&opts.filter, "filter", "f", []string{}, i18n.T("Filter output based on conditions provided"))
 
 	return cmd
 }
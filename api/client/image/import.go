@@ -8,6 +8,7 @@ import (
 
 	"github.com/docker/docker/api/client"
 	"github.com/docker/docker/cli"
+	"github.com/docker/docker/cli/i18n"
 	"github.com/docker/docker/pkg/jsonmessage"
 	"github.com/docker/docker/pkg/urlutil"
 	"github.com/docker/engine-api/types"
@@ -27,7 +28,7 @@ func NewImportCommand(dockerCli *client.DockerCli) *cobra.Command {
 
 	cmd := &cobra.Command{
 		Use:   "import [OPTIONS] file|URL|- [REPOSITORY[:TAG]]",
-		Short: "从一个压缩包中导入内容，从而创建一个文件系统镜像",
+		Short: i18n.T("Import the contents from a tarball to create a filesystem image"),
 		Args:  cli.RequiresMinArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			opts.source = args[0]
@@ -40,8 +41,8 @@ func NewImportCommand(dockerCli *client.DockerCli) *cobra.Command {
 
 	flags := cmd.Flags()
 
-	flags.StringSliceVarP(&opts.changes, "change", "c", []string{}, "应用Dockerfile中的指令到新创建出的镜像")
-	flags.StringVarP(&opts.message, "message", "m", "", "为导入的镜像设置提交信息")
+	flags.StringSliceVarP(&opts.changes, "change", "c", []string{}, i18n.T("Apply Dockerfile instruction to the created image"))
+	flags.StringVarP(&opts.message, "message", "m", "", i18n.T("Set commit message for imported image"))
 
 	return cmd
 }
@@ -8,6 +8,7 @@ import (
 
 	"github.com/docker/docker/api/client"
 	"github.com/docker/docker/cli"
+	"github.com/docker/docker/cli/i18n"
 	"github.com/docker/docker/pkg/jsonmessage"
 	"github.com/spf13/cobra"
 )
@@ -23,7 +24,7 @@ func NewLoadCommand(dockerCli *client.DockerCli) *cobra.Command {
 
 	cmd := &cobra.Command{
 		Use:   "load [OPTIONS]",
-		Short: "从一个压缩包或者标准输入加载一个镜像",
+		Short: i18n.T("Load an image from a tar archive or STDIN"),
 		Args:  cli.NoArgs,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			return runLoad(dockerCli, opts)
@@ -32,8 +33,8 @@ func NewLoadCommand(dockerCli *client.DockerCli) *cobra.Command {
 
 	flags := cmd.Flags()
 
-	flags.StringVarP(&opts.input, "input", "i", "", "从压缩包中读取内容，而不是标准输入")
-	flags.BoolVarP(&opts.quiet, "quiet", "q", false, "压缩导入输出")
+	flags.StringVarP(&opts.input, "input", "i", "", i18n.T("Read from tar archive file, instead of STDIN"))
+	flags.BoolVarP(&opts.quiet, "quiet", "q", false, i18n.T("Suppress the load output"))
 
 	return cmd
 }
@@ -0,0 +1,87 @@
+package image
+
+import (
+	"fmt"
+
+	"golang.org/x/net/context"
+
+	"github.com/docker/docker/api/client"
+	"github.com/docker/docker/cli"
+	"github.com/docker/docker/cli/i18n"
+	"github.com/docker/docker/opts"
+	"github.com/docker/engine-api/types/filters"
+	"github.com/docker/go-units"
+	"github.com/spf13/cobra"
+)
+
+type pruneOptions struct {
+	force  bool
+	all    bool
+	filter opts.FilterOpt
+}
+
+func newPruneCommand(dockerCli *client.DockerCli) *cobra.Command {
+	opts := pruneOptions{filter: opts.NewFilterOpt()}
+
+	cmd := &cobra.Command{
+		Use:   "prune [OPTIONS]",
+		Short: i18n.T("Remove unused images"),
+		Args:  cli.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runPrune(dockerCli, opts)
+		},
+	}
+
+	flags := cmd.Flags()
+	flags.BoolVarP(&opts.force, "force", "f", false, i18n.T("Do not prompt for confirmation"))
+	flags.BoolVarP(&opts.all, "all", "a", false, i18n.T("Remove all unused images, not just dangling ones"))
+	flags.Var(&opts.filter, "filter", i18n.T("Provide filter values (e.g. 'until=<timestamp>')"))
+
+	return cmd
+}
+
+func runPrune(dockerCli *client.DockerCli, opts pruneOptions) error {
+	warning := i18n.T("WARNING! This will remove all dangling images.\nAre you sure you want to continue?")
+	if opts.all {
+		warning = i18n.T("WARNING! This will remove all images without at least one container associated to them.\nAre you sure you want to continue?")
+	}
+	if !opts.force && !client.PromptForConfirmation(dockerCli.In(), dockerCli.Out(), warning) {
+		return nil
+	}
+
+	spaceReclaimed, output, err := RunPrune(dockerCli, opts.all, opts.filter.Value())
+	if err != nil {
+		return err
+	}
+	fmt.Fprint(dockerCli.Out(), output)
+	fmt.Fprintln(dockerCli.Out(), i18n.T("Total reclaimed space:"), units.HumanSize(float64(spaceReclaimed)))
+	return nil
+}
+
+// RunPrune removes the unused images matching pruneFilters without asking
+// for confirmation, and returns the space reclaimed and the list of removed
+// images to print. Only dangling images are removed unless all is set.
+func RunPrune(dockerCli *client.DockerCli, all bool, pruneFilters filters.Args) (uint64, string, error) {
+	if all {
+		pruneFilters.Add("dangling", "false")
+	}
+
+	report, err := dockerCli.Client().ImagesPrune(context.Background(), pruneFilters)
+	if err != nil {
+		return 0, "", err
+	}
+
+	output := ""
+	if len(report.ImagesDeleted) > 0 {
+		output = i18n.T("Deleted Images:") + "\n"
+		for _, st := range report.ImagesDeleted {
+			if st.Deleted != "" {
+				output += fmt.Sprintf(i18n.T("Deleted: %s\n"), st.Deleted)
+			} else {
+				output += fmt.Sprintf(i18n.T("Untagged: %s\n"), st.Untagged)
+			}
+		}
+		output += "\n"
+	}
+	return report.SpaceReclaimed, output, nil
+}
@@ -8,6 +8,7 @@ import (
 
 	"github.com/docker/docker/api/client"
 	"github.com/docker/docker/cli"
+	"github.com/docker/docker/cli/i18n"
 	"github.com/docker/docker/reference"
 	"github.com/docker/docker/registry"
 	"github.com/spf13/cobra"
@@ -24,7 +25,7 @@ func NewPullCommand(dockerCli *client.DockerCli) *cobra.Command {
 
 	cmd := &cobra.Command{
 		Use:   "pull [OPTIONS] NAME[:TAG|@DIGEST]",
-		Short: "从一个镜像仓库下拉一个镜像",
+		Short: i18n.T("Pull an image or a repository from a registry"),
 		Args:  cli.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			opts.remote = args[0]
@@ -34,7 +35,7 @@ func NewPullCommand(dockerCli *client.DockerCli) *cobra.Command {
 
 	flags := cmd.Flags()
 
-	flags.BoolVarP(&opts.all, "all-tags", "a", false, "从镜像仓库中下拉所有标签的镜像")
+	flags.BoolVarP(&opts.all, "all-tags", "a", false, i18n.T("Download all tagged images in the repository"))
 	client.AddTrustedFlags(flags, true)
 
 	return cmd
@@ -46,12 +47,12 @@ func runPull(dockerCli *client.DockerCli, opts pullOptions) error {
 		return err
 	}
 	if opts.all && !reference.IsNameOnly(distributionRef) {
-		return errors.New("标签不能使用 --all-tags/-a")
+		return errors.New(i18n.T("tag can't be used with --all-tags/-a"))
 	}
 
 	if !opts.all && reference.IsNameOnly(distributionRef) {
 		distributionRef = reference.WithDefaultTag(distributionRef)
-		fmt.Fprintf(dockerCli.Out(), "使用默认标签: %s\n", reference.DefaultTag)
+		fmt.Fprintf(dockerCli.Out(), i18n.T("Using default tag: %s\n"), reference.DefaultTag)
 	}
 
 	var tag string
@@ -80,6 +81,13 @@ func runPull(dockerCli *client.DockerCli, opts pullOptions) error {
 		return dockerCli.TrustedPull(ctx, repoInfo, registryRef, authConfig, requestPrivilege)
 	}
 
+	// Docker Hub pulls go through the configured mirrors. Digests and
+	// --all-tags cannot be re-tagged to the original name, so they go to
+	// the registry directly.
+	if tagged, ok := distributionRef.(reference.NamedTagged); ok && repoInfo.Index.Official && len(dockerCli.ConfigFile().Mirrors) > 0 {
+		return dockerCli.MirrorPull(ctx, tagged, authConfig, requestPrivilege)
+	}
+
 	return dockerCli.ImagePullPrivileged(ctx, authConfig, distributionRef.String(), requestPrivilege, opts.all)
 
 }
@@ -8,6 +8,7 @@ import (
 
 	"github.com/docker/docker/api/client"
 	"github.com/docker/docker/cli"
+	"github.com/docker/docker/cli/i18n"
 	"github.com/docker/engine-api/types"
 	"github.com/spf13/cobra"
 )
@@ -23,7 +24,7 @@ func NewRemoveCommand(dockerCli *client.DockerCli) *cobra.Command {
 
 	cmd := &cobra.Command{
 		Use:   "rmi [OPTIONS] IMAGE [IMAGE...]",
-		Short: "删除一个或者多个镜像",
+		Short: i18n.T("Remove one or more images"),
 		Args:  cli.RequiresMinArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			return runRemove(dockerCli, opts, args)
@@ -32,8 +33,8 @@ func NewRemoveCommand(dockerCli *client.DockerCli) *cobra.Command {
 
 	flags := cmd.Flags()
 
-	flags.BoolVarP(&opts.force, "force", "f", false, "强制删除镜像")
-	flags.BoolVar(&opts.noPrune, "no-prune", false, "不删除没有标签的父镜像")
+	flags.BoolVarP(&opts.force, "force", "f", false, i18n.T("Force removal of the image"))
+	flags.BoolVar(&opts.noPrune, "no-prune", false, i18n.T("Do not delete untagged parents"))
 
 	return cmd
 }
@@ -55,9 +56,9 @@ func runRemove(dockerCli *client.DockerCli, opts removeOptions, images []string)
 		} else {
 			for _, del := range dels {
 				if del.Deleted != "" {
-					fmt.Fprintf(dockerCli.Out(), "已删除: %s\n", del.Deleted)
+					fmt.Fprintf(dockerCli.Out(), i18n.T("Deleted: %s\n"), del.Deleted)
 				} else {
-					fmt.Fprintf(dockerCli.Out(), "去标签: %s\n", del.Untagged)
+					fmt.Fprintf(dockerCli.Out(), i18n.T("Untagged: %s\n"), del.Untagged)
 				}
 			}
 		}
@@ -8,6 +8,7 @@ import (
 
 	"github.com/docker/docker/api/client"
 	"github.com/docker/docker/cli"
+	"github.com/docker/docker/cli/i18n"
 	"github.com/spf13/cobra"
 )
 
@@ -22,7 +23,7 @@ func NewSaveCommand(dockerCli *client.DockerCli) *cobra.Command {
 
 	cmd := &cobra.Command{
 		Use:   "save [OPTIONS] IMAGE [IMAGE...]",
-		Short: "将一个或多个镜像保存至压缩包(默认情况下流传输至标准输出)",
+		Short: i18n.T("Save one or more images to a tar archive (streamed to STDOUT by default)"),
 		Args:  cli.RequiresMinArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			opts.images = args
@@ -32,14 +33,14 @@ func NewSaveCommand(dockerCli *client.DockerCli) *cobra.Command {
 
 	flags := cmd.Flags()
 
-	flags.StringVarP(&opts.output, "output", "o", "", "写入一个文件，而不是标准输出")
+	flags.StringVarP(&opts.output, "output", "o", "", i18n.T("Write to a file, instead of STDOUT"))
 
 	return cmd
 }
 
 func runSave(dockerCli *client.DockerCli, opts saveOptions) error {
 	if opts.output == "" && dockerCli.IsTerminalOut() {
-		return errors.New("终端拒绝保存输出内容，请您使用 -o 参数或者重定向。")
+		return errors.New(i18n.T("Cowardly refusing to save to a terminal. Use the -o flag or redirect."))
 	}
 
 	responseBody, err := dockerCli.Client().ImageSave(context.Background(), opts.images)
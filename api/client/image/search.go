@@ -10,6 +10,7 @@ import (
 
 	"github.com/docker/docker/api/client"
 	"github.com/docker/docker/cli"
+	"github.com/docker/docker/cli/i18n"
 	"github.com/docker/docker/pkg/stringutils"
 	"github.com/docker/docker/registry"
 	"github.com/docker/engine-api/types"
@@ -35,7 +36,7 @@ func NewSearchCommand(dockerCli *client.DockerCli) *cobra.Command {
 
 	cmd := &cobra.Command{
 		Use:   "search [OPTIONS] TERM",
-		Short: "在 Docker Hub(Docker官方镜像仓库)中搜索镜像",
+		Short: i18n.T("Search the Docker Hub for images"),
 		Args:  cli.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			opts.term = args[0]
@@ -45,15 +46,15 @@ func NewSearchCommand(dockerCli *client.DockerCli) *cobra.Command {
 
 	flags := cmd.Flags()
 
-	flags.BoolVar(&opts.noTrunc, "no-trunc", false, "不截断命令输出内容")
-	flags.StringSliceVarP(&opts.filter, "filter", "f", []string{}, "基于指定条件过滤命令输出内容")
-	flags.IntVar(&opts.limit, "limit", registry.DefaultSearchLimit, "搜索结果的最大数")
+	flags.BoolVar(&opts.noTrunc, "no-trunc", false, i18n.T("Do not truncate the output"))
+	flags.StringSliceVarP(&opts.filter, "filter", "f", []string{}, i18n.T("Filter output based on conditions provided"))
+	flags.IntVar(&opts.limit, "limit", registry.DefaultSearchLimit, i18n.T("Max number of search results"))
 
-	flags.BoolVar(&opts.automated, "automated", false, "只显示自动化构建出来的镜像")
-	flags.UintVarP(&opts.stars, "stars", "s", 0, "只显示至少有 x 个用户星星的镜像")
+	flags.BoolVar(&opts.automated, "automated", false, i18n.T("Only show automated builds"))
+	flags.UintVarP(&opts.stars, "stars", "s", 0, i18n.T("Only displays with at least x stars"))
 
-	flags.MarkDeprecated("automated", "使用 --filter=automated=true ")
-	flags.MarkDeprecated("stars", "使用 --filter=stars=3 ")
+	flags.MarkDeprecated("automated", i18n.T("use --filter=automated=true instead"))
+	flags.MarkDeprecated("stars", i18n.T("use --filter=stars=3 instead"))
 
 	return cmd
 }
@@ -101,7 +102,7 @@ func runSearch(dockerCli *client.DockerCli, opts searchOptions) error {
 	sort.Sort(results)
 
 	w := tabwriter.NewWriter(dockerCli.Out(), 10, 1, 3, ' ', 0)
-	fmt.Fprintf(w, "名称\t描述\t星星数\t是否官方\t是否自动构建\n")
+	fmt.Fprint(w, i18n.T("NAME\tDESCRIPTION\tSTARS\tOFFICIAL\tAUTOMATED\n"))
 	for _, res := range results {
 		// --automated and -s, --stars are deprecated since Docker 1.12
 		if (opts.automated && !res.IsAutomated) || (int(opts.stars) > res.StarCount) {
@@ -1,10 +1,14 @@
 package image
 
 import (
+	"errors"
+
 	"golang.org/x/net/context"
 
 	"github.com/docker/docker/api/client"
 	"github.com/docker/docker/cli"
+	"github.com/docker/docker/cli/i18n"
+	"github.com/docker/docker/reference"
 	"github.com/spf13/cobra"
 )
 
@@ -19,7 +23,7 @@ func NewTagCommand(dockerCli *client.DockerCli) *cobra.Command {
 
 	cmd := &cobra.Command{
 		Use:   "tag IMAGE[:TAG] IMAGE[:TAG]",
-		Short: "为一个镜像添加一个标签",
+		Short: i18n.T("Tag an image into a repository"),
 		Args:  cli.ExactArgs(2),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			opts.image = args[0]
@@ -37,5 +41,12 @@ func NewTagCommand(dockerCli *client.DockerCli) *cobra.Command {
 func runTag(dockerCli *client.DockerCli, opts tagOptions) error {
 	ctx := context.Background()
 
+	// Digests are only recorded by pulls.
+	if ref, err := reference.ParseNamed(opts.name); err == nil {
+		if _, isCanonical := ref.(reference.Canonical); isCanonical {
+			return errors.New(i18n.T("refusing to create a tag with a digest reference"))
+		}
+	}
+
 	return dockerCli.Client().ImageTag(ctx, opts.image, opts.name)
 }
@@ -8,6 +8,7 @@ import (
 	"golang.org/x/net/context"
 
 	Cli "github.com/docker/docker/cli"
+	"github.com/docker/docker/cli/i18n"
 	"github.com/docker/docker/pkg/ioutils"
 	flag "github.com/docker/docker/pkg/mflag"
 	"github.com/docker/docker/utils"
@@ -30,20 +31,20 @@ func (cli *DockerCli) CmdInfo(args ...string) error {
 		return err
 	}
 
-	fmt.Fprintf(cli.out, "容器数: %d\n", info.Containers)
-	fmt.Fprintf(cli.out, " 运行: %d\n", info.ContainersRunning)
-	fmt.Fprintf(cli.out, " 暂停: %d\n", info.ContainersPaused)
-	fmt.Fprintf(cli.out, " 停止: %d\n", info.ContainersStopped)
-	fmt.Fprintf(cli.out, "镜像数: %d\n", info.Images)
-	ioutils.FprintfIfNotEmpty(cli.out, "Docker引擎版本: %s\n", info.ServerVersion)
-	ioutils.FprintfIfNotEmpty(cli.out, "存储驱动: %s\n", info.Driver)
+	fmt.Fprintf(cli.out, i18n.T("Containers: %d\n"), info.Containers)
+	fmt.Fprintf(cli.out, i18n.T(" Running: %d\n"), info.ContainersRunning)
+	fmt.Fprintf(cli.out, i18n.T(" Paused: %d\n"), info.ContainersPaused)
+	fmt.Fprintf(cli.out, i18n.T(" Stopped: %d\n"), info.ContainersStopped)
+	fmt.Fprintf(cli.out, i18n.T("Images: %d\n"), info.Images)
+	ioutils.FprintfIfNotEmpty(cli.out, i18n.T("Server Version: %s\n"), info.ServerVersion)
+	ioutils.FprintfIfNotEmpty(cli.out, i18n.T("Storage Driver: %s\n"), info.Driver)
 	if info.DriverStatus != nil {
 		for _, pair := range info.DriverStatus {
 			fmt.Fprintf(cli.out, " %s: %s\n", pair[0], pair[1])
 
 			// print a warning if devicemapper is using a loopback file
 			if pair[0] == "Data loop file" {
-				fmt.Fprintln(cli.err, " 警告: 环回设备loopback严重不建议在生产环境中使用。详见 `--storage-opt dm.thinpooldev` 来指定一个自定义的块存储设备。")
+				fmt.Fprintln(cli.err, i18n.T(" WARNING: Usage of loopback devices is strongly discouraged for production use. Use `--storage-opt dm.thinpooldev` to specify a custom block storage device."))
 			}
 		}
 
@@ -53,153 +54,153 @@ func (cli *DockerCli) CmdInfo(args ...string) error {
 			fmt.Fprintf(cli.out, "%s: %s\n", pair[0], pair[1])
 		}
 	}
-	ioutils.FprintfIfNotEmpty(cli.out, "日志驱动: %s\n", info.LoggingDriver)
-	ioutils.FprintfIfNotEmpty(cli.out, "Cgroup 驱动: %s\n", info.CgroupDriver)
+	ioutils.FprintfIfNotEmpty(cli.out, i18n.T("Logging Driver: %s\n"), info.LoggingDriver)
+	ioutils.FprintfIfNotEmpty(cli.out, i18n.T("Cgroup Driver: %s\n"), info.CgroupDriver)
 
-	fmt.Fprintf(cli.out, "插件:\n")
-	fmt.Fprintf(cli.out, " 存储卷:")
+	fmt.Fprint(cli.out, i18n.T("Plugins:\n"))
+	fmt.Fprint(cli.out, i18n.T(" Volume:"))
 	fmt.Fprintf(cli.out, " %s", strings.Join(info.Plugins.Volume, " "))
 	fmt.Fprintf(cli.out, "\n")
-	fmt.Fprintf(cli.out, " 网络:")
+	fmt.Fprint(cli.out, i18n.T(" Network:"))
 	fmt.Fprintf(cli.out, " %s", strings.Join(info.Plugins.Network, " "))
 	fmt.Fprintf(cli.out, "\n")
 
 	if len(info.Plugins.Authorization) != 0 {
-		fmt.Fprintf(cli.out, " 认证:")
+		fmt.Fprint(cli.out, i18n.T(" Authorization:"))
 		fmt.Fprintf(cli.out, " %s", strings.Join(info.Plugins.Authorization, " "))
 		fmt.Fprintf(cli.out, "\n")
 	}
 
-	fmt.Fprintf(cli.out, "Swarm集群: %v\n", info.Swarm.LocalNodeState)
+	fmt.Fprintf(cli.out, i18n.T("Swarm: %v\n"), info.Swarm.LocalNodeState)
 	if info.Swarm.LocalNodeState != swarm.LocalNodeStateInactive {
-		fmt.Fprintf(cli.out, " 节点ID: %s\n", info.Swarm.NodeID)
+		fmt.Fprintf(cli.out, i18n.T(" NodeID: %s\n"), info.Swarm.NodeID)
 		if info.Swarm.Error != "" {
-			fmt.Fprintf(cli.out, " 错误: %v\n", info.Swarm.Error)
+			fmt.Fprintf(cli.out, i18n.T(" Error: %v\n"), info.Swarm.Error)
 		}
-		fmt.Fprintf(cli.out, " 是否是管理者: %v\n", info.Swarm.ControlAvailable)
+		fmt.Fprintf(cli.out, i18n.T(" Is Manager: %v\n"), info.Swarm.ControlAvailable)
 		if info.Swarm.ControlAvailable {
-			fmt.Fprintf(cli.out, " 集群ID: %s\n", info.Swarm.Cluster.ID)
-			fmt.Fprintf(cli.out, " 管理者数量: %d\n", info.Swarm.Managers)
-			fmt.Fprintf(cli.out, " 节点数: %d\n", info.Swarm.Nodes)
-			fmt.Fprintf(cli.out, " 编排:\n")
-			fmt.Fprintf(cli.out, "  历史任务保留上线: %d\n", info.Swarm.Cluster.Spec.Orchestration.TaskHistoryRetentionLimit)
+			fmt.Fprintf(cli.out, i18n.T(" ClusterID: %s\n"), info.Swarm.Cluster.ID)
+			fmt.Fprintf(cli.out, i18n.T(" Managers: %d\n"), info.Swarm.Managers)
+			fmt.Fprintf(cli.out, i18n.T(" Nodes: %d\n"), info.Swarm.Nodes)
+			fmt.Fprint(cli.out, i18n.T(" Orchestration:\n"))
+			fmt.Fprintf(cli.out, i18n.T("  Task History Retention Limit: %d\n"), info.Swarm.Cluster.Spec.Orchestration.TaskHistoryRetentionLimit)
 			fmt.Fprintf(cli.out, " Raft:\n")
-			fmt.Fprintf(cli.out, "  快照间隔: %d\n", info.Swarm.Cluster.Spec.Raft.SnapshotInterval)
-			fmt.Fprintf(cli.out, "  心跳时钟: %d\n", info.Swarm.Cluster.Spec.Raft.HeartbeatTick)
-			fmt.Fprintf(cli.out, "  选举时钟: %d\n", info.Swarm.Cluster.Spec.Raft.ElectionTick)
-			fmt.Fprintf(cli.out, " 分发器:\n")
-			fmt.Fprintf(cli.out, "  心跳周期: %s\n", units.HumanDuration(time.Duration(info.Swarm.Cluster.Spec.Dispatcher.HeartbeatPeriod)))
-			fmt.Fprintf(cli.out, " CA配置:\n")
-			fmt.Fprintf(cli.out, "  过期周期: %s\n", units.HumanDuration(info.Swarm.Cluster.Spec.CAConfig.NodeCertExpiry))
+			fmt.Fprintf(cli.out, i18n.T("  Snapshot Interval: %d\n"), info.Swarm.Cluster.Spec.Raft.SnapshotInterval)
+			fmt.Fprintf(cli.out, i18n.T("  Heartbeat Tick: %d\n"), info.Swarm.Cluster.Spec.Raft.HeartbeatTick)
+			fmt.Fprintf(cli.out, i18n.T("  Election Tick: %d\n"), info.Swarm.Cluster.Spec.Raft.ElectionTick)
+			fmt.Fprint(cli.out, i18n.T(" Dispatcher:\n"))
+			fmt.Fprintf(cli.out, i18n.T("  Heartbeat Period: %s\n"), units.HumanDuration(time.Duration(info.Swarm.Cluster.Spec.Dispatcher.HeartbeatPeriod)))
+			fmt.Fprint(cli.out, i18n.T(" CA Configuration:\n"))
+			fmt.Fprintf(cli.out, i18n.T("  Expiry Duration: %s\n"), units.HumanDuration(info.Swarm.Cluster.Spec.CAConfig.NodeCertExpiry))
 			if len(info.Swarm.Cluster.Spec.CAConfig.ExternalCAs) > 0 {
-				fmt.Fprintf(cli.out, "  外部CAs:\n")
+				fmt.Fprint(cli.out, i18n.T("  External CAs:\n"))
 				for _, entry := range info.Swarm.Cluster.Spec.CAConfig.ExternalCAs {
 					fmt.Fprintf(cli.out, "    %s: %s\n", entry.Protocol, entry.URL)
 				}
 			}
 		}
-		fmt.Fprintf(cli.out, " 节点地址: %s\n", info.Swarm.NodeAddr)
+		fmt.Fprintf(cli.out, i18n.T(" Node Address: %s\n"), info.Swarm.NodeAddr)
 	}
 
 	if len(info.Runtimes) > 0 {
-		fmt.Fprintf(cli.out, "运行时:")
+		fmt.Fprint(cli.out, i18n.T("Runtimes:"))
 		for name := range info.Runtimes {
 			fmt.Fprintf(cli.out, " %s", name)
 		}
 		fmt.Fprint(cli.out, "\n")
-		fmt.Fprintf(cli.out, "默认运行时: %s\n", info.DefaultRuntime)
+		fmt.Fprintf(cli.out, i18n.T("Default Runtime: %s\n"), info.DefaultRuntime)
 	}
 
-	fmt.Fprintf(cli.out, "安全选项:")
+	fmt.Fprint(cli.out, i18n.T("Security Options:"))
 	ioutils.FprintfIfNotEmpty(cli.out, " %s", strings.Join(info.SecurityOptions, " "))
 	fmt.Fprintf(cli.out, "\n")
 
-	ioutils.FprintfIfNotEmpty(cli.out, "内核版本: %s\n", info.KernelVersion)
-	ioutils.FprintfIfNotEmpty(cli.out, "操作系统: %s\n", info.OperatingSystem)
-	ioutils.FprintfIfNotEmpty(cli.out, "操作系统类型: %s\n", info.OSType)
-	ioutils.FprintfIfNotEmpty(cli.out, "机器架构: %s\n", info.Architecture)
-	fmt.Fprintf(cli.out, "CPU数量: %d\n", info.NCPU)
-	fmt.Fprintf(cli.out, "内存总数: %s\n", units.BytesSize(float64(info.MemTotal)))
-	ioutils.FprintfIfNotEmpty(cli.out, "名称: %s\n", info.Name)
+	ioutils.FprintfIfNotEmpty(cli.out, i18n.T("Kernel Version: %s\n"), info.KernelVersion)
+	ioutils.FprintfIfNotEmpty(cli.out, i18n.T("Operating System: %s\n"), info.OperatingSystem)
+	ioutils.FprintfIfNotEmpty(cli.out, i18n.T("OSType: %s\n"), info.OSType)
+	ioutils.FprintfIfNotEmpty(cli.out, i18n.T("Architecture: %s\n"), info.Architecture)
+	fmt.Fprintf(cli.out, i18n.T("CPUs: %d\n"), info.NCPU)
+	fmt.Fprintf(cli.out, i18n.T("Total Memory: %s\n"), units.BytesSize(float64(info.MemTotal)))
+	ioutils.FprintfIfNotEmpty(cli.out, i18n.T("Name: %s\n"), info.Name)
 	ioutils.FprintfIfNotEmpty(cli.out, "ID: %s\n", info.ID)
-	fmt.Fprintf(cli.out, "Docker引擎根目录: %s\n", info.DockerRootDir)
-	fmt.Fprintf(cli.out, "调试模式(客户端): %v\n", utils.IsDebugEnabled())
-	fmt.Fprintf(cli.out, "调试模式(服务端): %v\n", info.Debug)
+	fmt.Fprintf(cli.out, i18n.T("Docker Root Dir: %s\n"), info.DockerRootDir)
+	fmt.Fprintf(cli.out, i18n.T("Debug Mode (client): %v\n"), utils.IsDebugEnabled())
+	fmt.Fprintf(cli.out, i18n.T("Debug Mode (server): %v\n"), info.Debug)
 
 	if info.Debug {
-		fmt.Fprintf(cli.out, " 文件描述符个数: %d\n", info.NFd)
-		fmt.Fprintf(cli.out, " Go协程综述: %d\n", info.NGoroutines)
-		fmt.Fprintf(cli.out, " 系统时间: %s\n", info.SystemTime)
-		fmt.Fprintf(cli.out, " 事件监听者总数: %d\n", info.NEventsListener)
+		fmt.Fprintf(cli.out, i18n.T(" File Descriptors: %d\n"), info.NFd)
+		fmt.Fprintf(cli.out, i18n.T(" Goroutines: %d\n"), info.NGoroutines)
+		fmt.Fprintf(cli.out, i18n.T(" System Time: %s\n"), info.SystemTime)
+		fmt.Fprintf(cli.out, i18n.T(" EventsListeners: %d\n"), info.NEventsListener)
 	}
 
-	ioutils.FprintfIfNotEmpty(cli.out, "Http代理: %s\n", info.HTTPProxy)
-	ioutils.FprintfIfNotEmpty(cli.out, "Https代理: %s\n", info.HTTPSProxy)
+	ioutils.FprintfIfNotEmpty(cli.out, i18n.T("Http Proxy: %s\n"), info.HTTPProxy)
+	ioutils.FprintfIfNotEmpty(cli.out, i18n.T("Https Proxy: %s\n"), info.HTTPSProxy)
 	ioutils.FprintfIfNotEmpty(cli.out, "No Proxy: %s\n", info.NoProxy)
 
 	if info.IndexServerAddress != "" {
 		u := cli.configFile.AuthConfigs[info.IndexServerAddress].Username
 		if len(u) > 0 {
-			fmt.Fprintf(cli.out, "用户名: %v\n", u)
+			fmt.Fprintf(cli.out, i18n.T("Username: %v\n"), u)
 		}
-		fmt.Fprintf(cli.out, "镜像仓库: %v\n", info.IndexServerAddress)
+		fmt.Fprintf(cli.out, i18n.T("Registry: %v\n"), info.IndexServerAddress)
 	}
 
 	// Only output these warnings if the server does not support these features
 	if info.OSType != "windows" {
 		if !info.MemoryLimit {
-			fmt.Fprintln(cli.err, "警告: 不支持内存限制")
+			fmt.Fprintln(cli.err, i18n.T("WARNING: No memory limit support"))
 		}
 		if !info.SwapLimit {
-			fmt.Fprintln(cli.err, "警告: 不支持交换区内存限制")
+			fmt.Fprintln(cli.err, i18n.T("WARNING: No swap limit support"))
 		}
 		if !info.KernelMemory {
-			fmt.Fprintln(cli.err, "警告: 不支持内核内存限制")
+			fmt.Fprintln(cli.err, i18n.T("WARNING: No kernel memory limit support"))
 		}
 		if !info.OomKillDisable {
-			fmt.Fprintln(cli.err, "警告: 不支持oom kill 禁用")
+			fmt.Fprintln(cli.err, i18n.T("WARNING: No oom kill disable support"))
 		}
 		if !info.CPUCfsQuota {
-			fmt.Fprintln(cli.err, "警告: 不支持 cpu cfs 限额 ")
+			fmt.Fprintln(cli.err, i18n.T("WARNING: No cpu cfs quota support"))
 		}
 		if !info.CPUCfsPeriod {
-			fmt.Fprintln(cli.err, "警告: 不支持 cpu cfs 周期 ")
+			fmt.Fprintln(cli.err, i18n.T("WARNING: No cpu cfs period support"))
 		}
 		if !info.CPUShares {
-			fmt.Fprintln(cli.err, "警告: 不支持 cpu 时间")
+			fmt.Fprintln(cli.err, i18n.T("WARNING: No cpu shares support"))
 		}
 		if !info.CPUSet {
-			fmt.Fprintln(cli.err, "警告: 不支持 cpuset")
+			fmt.Fprintln(cli.err, i18n.T("WARNING: No cpuset support"))
 		}
 		if !info.IPv4Forwarding {
-			fmt.Fprintln(cli.err, "警告: IPv4转发功能已禁用")
+			fmt.Fprintln(cli.err, i18n.T("WARNING: IPv4 forwarding is disabled"))
 		}
 		if !info.BridgeNfIptables {
-			fmt.Fprintln(cli.err, "警告: bridge-nf-call-iptables已禁用")
+			fmt.Fprintln(cli.err, i18n.T("WARNING: bridge-nf-call-iptables is disabled"))
 		}
 		if !info.BridgeNfIP6tables {
-			fmt.Fprintln(cli.err, "警告: bridge-nf-call-ip6tables已禁用")
+			fmt.Fprintln(cli.err, i18n.T("WARNING: bridge-nf-call-ip6tables is disabled"))
 		}
 	}
 
 	if info.Labels != nil {
-		fmt.Fprintln(cli.out, "标签:")
+		fmt.Fprintln(cli.out, i18n.T("Labels:"))
 		for _, attribute := range info.Labels {
 			fmt.Fprintf(cli.out, " %s\n", attribute)
 		}
 	}
 
-	ioutils.FprintfIfTrue(cli.out, "试验版: %v\n", info.ExperimentalBuild)
+	ioutils.FprintfIfTrue(cli.out, i18n.T("Experimental: %v\n"), info.ExperimentalBuild)
 	if info.ClusterStore != "" {
-		fmt.Fprintf(cli.out, "集群存储: %s\n", info.ClusterStore)
+		fmt.Fprintf(cli.out, i18n.T("Cluster Store: %s\n"), info.ClusterStore)
 	}
 
 	if info.ClusterAdvertise != "" {
-		fmt.Fprintf(cli.out, "集群广播地址: %s\n", info.ClusterAdvertise)
+		fmt.Fprintf(cli.out, i18n.T("Cluster Advertise: %s\n"), info.ClusterAdvertise)
 	}
 
 	if info.RegistryConfig != nil && (len(info.RegistryConfig.InsecureRegistryCIDRs) > 0 || len(info.RegistryConfig.IndexConfigs) > 0) {
-		fmt.Fprintln(cli.out, "不受信的镜像仓库:")
+		fmt.Fprintln(cli.out, i18n.T("Insecure Registries:"))
 		for _, registry := range info.RegistryConfig.IndexConfigs {
 			if registry.Secure == false {
 				fmt.Fprintf(cli.out, " %s\n", registry.Name)
@@ -211,5 +212,22 @@ func (cli *DockerCli) CmdInfo(args ...string) error {
 			fmt.Fprintf(cli.out, " %s/%d\n", registry.IP.String(), mask)
 		}
 	}
+
+	if len(info.MirrorHealth) > 0 {
+		fmt.Fprintln(cli.out, i18n.T("Registry Mirrors:"))
+		for _, mirror := range info.MirrorHealth {
+			fmt.Fprintf(cli.out, " %s\n", mirror.Mirror)
+			if mirror.Latency > 0 {
+				fmt.Fprintf(cli.out, i18n.T("  Latency: %s\n"), mirror.Latency/time.Millisecond*time.Millisecond)
+			}
+			fmt.Fprintf(cli.out, i18n.T("  Error Rate: %.0f%%\n"), mirror.ErrorRate*100)
+			if !mirror.LastFailure.IsZero() {
+				fmt.Fprintf(cli.out, i18n.T("  Last Failure: %s ago, %s\n"), units.HumanDuration(time.Now().Sub(mirror.LastFailure)), mirror.LastError)
+			}
+			if !mirror.SkippedUntil.IsZero() {
+				fmt.Fprintf(cli.out, i18n.T("  Skipped For: %s\n"), units.HumanDuration(mirror.SkippedUntil.Sub(time.Now())))
+			}
+		}
+	}
 	return nil
 }
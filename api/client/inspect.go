@@ -7,6 +7,7 @@ import (
 
 	"github.com/docker/docker/api/client/inspect"
 	Cli "github.com/docker/docker/cli"
+	"github.com/docker/docker/cli/i18n"
 	flag "github.com/docker/docker/pkg/mflag"
 	"github.com/docker/engine-api/client"
 )
@@ -16,15 +17,15 @@ import (
 // Usage: docker inspect [OPTIONS] CONTAINER|IMAGE|TASK [CONTAINER|IMAGE|TASK...]
 func (cli *DockerCli) CmdInspect(args ...string) error {
 	cmd := Cli.Subcmd("inspect", []string{"[OPTIONS] CONTAINER|IMAGE|TASK [CONTAINER|IMAGE|TASK...]"}, Cli.DockerCommands["inspect"].Description, true)
-	tmplStr := cmd.String([]string{"f", "-format"}, "", "基于指定的Go语言模板格式化命令输出内容")
-	inspectType := cmd.String([]string{"-type"}, "", "为指定的类型返回JSON内容")
-	size := cmd.Bool([]string{"s", "-size"}, false, "如果类型为容器，显示所有的文件大小信息")
+	tmplStr := cmd.String([]string{"f", "-format"}, "", i18n.T("Format the output using the given go template"))
+	inspectType := cmd.String([]string{"-type"}, "", i18n.T("Return JSON for specified type, (e.g image, container or task)"))
+	size := cmd.Bool([]string{"s", "-size"}, false, i18n.T("Display total file sizes if the type is container"))
 	cmd.Require(flag.Min, 1)
 
 	cmd.ParseFlags(args, true)
 
 	if *inspectType != "" && *inspectType != "container" && *inspectType != "image" && *inspectType != "task" {
-		return fmt.Errorf("对 --type 而言，%q 不是一个有效的值", *inspectType)
+		return fmt.Errorf(i18n.T("%q is not a valid value for --type"), *inspectType)
 	}
 
 	ctx := context.Background()
@@ -37,7 +38,7 @@ func (cli *DockerCli) CmdInspect(args ...string) error {
 		elementSearcher = cli.inspectImages(ctx, *size)
 	case "task":
 		if *size {
-			fmt.Fprintln(cli.err, "警告: --size 被任务所忽略")
+			fmt.Fprintln(cli.err, i18n.T("WARNING: --size ignored for tasks"))
 		}
 		elementSearcher = cli.inspectTasks(ctx)
 	default:
@@ -77,10 +78,10 @@ func (cli *DockerCli) inspectAll(ctx context.Context, getSize bool) inspect.GetR
 						// Search for task with that id if an image doesn't exists.
 						t, rawTask, err := cli.client.TaskInspectWithRaw(ctx, ref)
 						if err != nil {
-							return nil, nil, fmt.Errorf("错误：没有次容器，镜像，任务: %s", ref)
+							return nil, nil, fmt.Errorf(i18n.T("Error: No such container, image or task: %s"), ref)
 						}
 						if getSize {
-							fmt.Fprintln(cli.err, "警告: --size 被任务所忽略")
+							fmt.Fprintln(cli.err, i18n.T("WARNING: --size ignored for tasks"))
 						}
 						return t, rawTask, nil
 					}
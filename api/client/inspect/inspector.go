@@ -9,6 +9,7 @@ import (
 
 	"github.com/Sirupsen/logrus"
 	"github.com/docker/docker/cli"
+	"github.com/docker/docker/cli/i18n"
 	"github.com/docker/docker/utils/templates"
 )
 
@@ -43,7 +44,7 @@ func NewTemplateInspectorFromString(out io.Writer, tmplStr string) (Inspector, e
 
 	tmpl, err := templates.Parse(tmplStr)
 	if err != nil {
-		return nil, fmt.Errorf("Template parsing error: %s", err)
+		return nil, fmt.Errorf(i18n.T("Template parsing error: %s"), err)
 	}
 	return NewTemplateInspector(out, tmpl), nil
 }
@@ -91,7 +92,7 @@ func (i *TemplateInspector) Inspect(typedElement interface{}, rawElement []byte)
 	buffer := new(bytes.Buffer)
 	if err := i.tmpl.Execute(buffer, typedElement); err != nil {
 		if rawElement == nil {
-			return fmt.Errorf("Template parsing error: %v", err)
+			return fmt.Errorf(i18n.T("Template parsing error: %v"), err)
 		}
 		return i.tryRawInspectFallback(rawElement)
 	}
@@ -109,12 +110,12 @@ func (i *TemplateInspector) tryRawInspectFallback(rawElement []byte) error {
 	dec := json.NewDecoder(rdr)
 
 	if rawErr := dec.Decode(&raw); rawErr != nil {
-		return fmt.Errorf("unable to read inspect data: %v", rawErr)
+		return fmt.Errorf(i18n.T("unable to read inspect data: %v"), rawErr)
 	}
 
 	tmplMissingKey := i.tmpl.Option("missingkey=error")
 	if rawErr := tmplMissingKey.Execute(buffer, raw); rawErr != nil {
-		return fmt.Errorf("Template parsing error: %v", rawErr)
+		return fmt.Errorf(i18n.T("Template parsing error: %v"), rawErr)
 	}
 
 	i.buffer.Write(buffer.Bytes())
@@ -5,6 +5,7 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/docker/docker/cli/i18n"
 	"github.com/docker/docker/utils/templates"
 )
 
@@ -48,6 +49,9 @@ func TestTemplateInspectorEmpty(t *testing.T) {
 }
 
 func TestTemplateInspectorTemplateError(t *testing.T) {
+	i18n.SetLocale(i18n.SourceLocale)
+	defer i18n.SetLocale("")
+
 	b := new(bytes.Buffer)
 	tmpl, err := templates.Parse("{{.Foo}}")
 	if err != nil {
@@ -85,6 +89,9 @@ func TestTemplateInspectorRawFallback(t *testing.T) {
 }
 
 func TestTemplateInspectorRawFallbackError(t *testing.T) {
+	i18n.SetLocale(i18n.SourceLocale)
+	defer i18n.SetLocale("")
+
 	b := new(bytes.Buffer)
 	tmpl, err := templates.Parse("{{.Dns}}")
 	if err != nil {
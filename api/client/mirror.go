@@ -0,0 +1,187 @@
+package client
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"sync"
+	"time"
+
+	"golang.org/x/net/context"
+	"golang.org/x/net/context/ctxhttp"
+
+	"github.com/docker/docker/cli/i18n"
+	"github.com/docker/docker/reference"
+	"github.com/docker/docker/registry"
+	"github.com/docker/engine-api/types"
+)
+
+// mirrorProbeTimeout bounds the time spent waiting for a single mirror to
+// answer a latency probe.
+const mirrorProbeTimeout = 5 * time.Second
+
+// MirrorProbe is the result of probing a registry mirror.
+type MirrorProbe struct {
+	Mirror  string
+	Latency time.Duration
+	Err     error
+}
+
+// byLatency sorts reachable mirrors by increasing latency, followed by the
+// unreachable ones in their original order.
+type byLatency []MirrorProbe
+
+func (p byLatency) Len() int      { return len(p) }
+func (p byLatency) Swap(i, j int) { p[i], p[j] = p[j], p[i] }
+func (p byLatency) Less(i, j int) bool {
+	if (p[i].Err == nil) != (p[j].Err == nil) {
+		return p[i].Err == nil
+	}
+	return p[i].Err == nil && p[i].Latency < p[j].Latency
+}
+
+// ProbeMirror measures the time the mirror takes to answer a request to its
+// v2 API endpoint. Any answer other than a server error, including the 401
+// returned by registries requiring authentication, counts as reachable.
+func ProbeMirror(ctx context.Context, mirror string) (time.Duration, error) {
+	ctx, cancel := context.WithTimeout(ctx, mirrorProbeTimeout)
+	defer cancel()
+
+	start := time.Now()
+	resp, err := ctxhttp.Get(ctx, http.DefaultClient, mirror+"v2/")
+	if err != nil {
+		return 0, err
+	}
+	resp.Body.Close()
+	latency := time.Since(start)
+
+	if resp.StatusCode >= http.StatusInternalServerError {
+		return 0, fmt.Errorf(i18n.T("unexpected status %s"), resp.Status)
+	}
+	return latency, nil
+}
+
+// ProbeMirrors probes all mirrors concurrently and returns the results
+// ordered from the fastest to the slowest mirror, unreachable mirrors last.
+func ProbeMirrors(ctx context.Context, mirrors []string) []MirrorProbe {
+	probes := make([]MirrorProbe, len(mirrors))
+
+	var wg sync.WaitGroup
+	for i, mirror := range mirrors {
+		wg.Add(1)
+		go func(i int, mirror string) {
+			defer wg.Done()
+			latency, err := ProbeMirror(ctx, mirror)
+			probes[i] = MirrorProbe{Mirror: mirror, Latency: latency, Err: err}
+		}(i, mirror)
+	}
+	wg.Wait()
+
+	sort.Stable(byLatency(probes))
+	return probes
+}
+
+// MirrorReference rewrites a Docker Hub reference to point at the mirror,
+// e.g. "ubuntu:16.04" becomes "mirror.example.com/library/ubuntu:16.04".
+func MirrorReference(mirror string, ref reference.NamedTagged) (reference.NamedTagged, error) {
+	uri, err := url.Parse(mirror)
+	if err != nil {
+		return nil, err
+	}
+	name, err := reference.WithName(uri.Host + "/" + ref.RemoteName())
+	if err != nil {
+		return nil, err
+	}
+	return reference.WithTag(name, ref.Tag())
+}
+
+// MirrorPull pulls a Docker Hub image through the mirrors of the client
+// configuration, trying them from the fastest to the slowest. The tag and
+// digest of the image are moved from the mirror name to its original name, so
+// the result is the same as a pull from Docker Hub. If every mirror fails, the image is
+// pulled from Docker Hub itself.
+func (cli *DockerCli) MirrorPull(ctx context.Context, ref reference.NamedTagged, authConfig types.AuthConfig, requestPrivilege types.RequestPrivilegeFunc) error {
+	for _, probe := range ProbeMirrors(ctx, cli.configFile.Mirrors) {
+		if probe.Err != nil {
+			fmt.Fprintf(cli.err, i18n.T("Skipping unreachable mirror %s: %v\n"), probe.Mirror, probe.Err)
+			continue
+		}
+		if err := cli.pullFromMirror(ctx, probe.Mirror, ref); err != nil {
+			fmt.Fprintf(cli.err, i18n.T("Failed to pull from mirror %s: %v\n"), probe.Mirror, err)
+			continue
+		}
+		return nil
+	}
+
+	if len(cli.configFile.Mirrors) > 0 {
+		fmt.Fprintln(cli.out, i18n.T("Falling back to Docker Hub"))
+	}
+	return cli.ImagePullPrivileged(ctx, authConfig, ref.String(), requestPrivilege, false)
+}
+
+func (cli *DockerCli) pullFromMirror(ctx context.Context, mirror string, ref reference.NamedTagged) error {
+	mirrorRef, err := MirrorReference(mirror, ref)
+	if err != nil {
+		return err
+	}
+	repoInfo, err := registry.ParseRepositoryInfo(mirrorRef)
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintf(cli.out, i18n.T("Pulling %s from mirror %s\n"), ref.String(), mirror)
+	authConfig := cli.ResolveAuthConfig(ctx, repoInfo.Index)
+	if err := cli.ImagePullPrivileged(ctx, authConfig, mirrorRef.String(), nil, false); err != nil {
+		return err
+	}
+
+	// The pull also recorded the digest of the image under the mirror name.
+	mirrorDigests, err := cli.repoDigests(ctx, mirrorRef)
+	if err != nil {
+		return err
+	}
+
+	if err := cli.client.ImageTag(ctx, mirrorRef.String(), ref.String()); err != nil {
+		return err
+	}
+	for _, mirrorDigest := range mirrorDigests {
+		digestRef, err := reference.WithDigest(ref, mirrorDigest.Digest())
+		if err != nil {
+			return err
+		}
+		if err := cli.client.ImageTag(ctx, mirrorRef.String(), digestRef.String()); err != nil {
+			return err
+		}
+	}
+
+	for _, mirrorDigest := range mirrorDigests {
+		if _, err := cli.client.ImageRemove(ctx, mirrorDigest.String(), types.ImageRemoveOptions{}); err != nil {
+			return fmt.Errorf(i18n.T("failed to untag %s: %v"), mirrorDigest.String(), err)
+		}
+	}
+	if _, err := cli.client.ImageRemove(ctx, mirrorRef.String(), types.ImageRemoveOptions{}); err != nil {
+		return fmt.Errorf(i18n.T("failed to untag %s: %v"), mirrorRef.String(), err)
+	}
+	return nil
+}
+
+// repoDigests returns the digest references of the image named by ref that
+// are in the repository of ref.
+func (cli *DockerCli) repoDigests(ctx context.Context, ref reference.Named) ([]reference.Canonical, error) {
+	img, _, err := cli.client.ImageInspectWithRaw(ctx, ref.String(), false)
+	if err != nil {
+		return nil, err
+	}
+	var digests []reference.Canonical
+	for _, repoDigest := range img.RepoDigests {
+		named, err := reference.ParseNamed(repoDigest)
+		if err != nil {
+			continue
+		}
+		if canonical, ok := named.(reference.Canonical); ok && canonical.Name() == ref.Name() {
+			digests = append(digests, canonical)
+		}
+	}
+	return digests, nil
+}
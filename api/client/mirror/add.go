@@ -0,0 +1,63 @@
+package mirror
+
+import (
+	"fmt"
+
+	"github.com/docker/docker/api/client"
+	"github.com/docker/docker/cli"
+	"github.com/docker/docker/cli/i18n"
+	"github.com/docker/docker/registry"
+	"github.com/spf13/cobra"
+)
+
+func newAddCommand(dockerCli *client.DockerCli) *cobra.Command {
+	return &cobra.Command{
+		Use:     "add MIRROR [MIRROR...]",
+		Short:   i18n.T("Add one or more registry mirrors"),
+		Example: addExample,
+		Args:    cli.RequiresMinArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runAdd(dockerCli, args)
+		},
+	}
+}
+
+func runAdd(dockerCli *client.DockerCli, mirrors []string) error {
+	configFile := dockerCli.ConfigFile()
+
+	var added []string
+	for _, m := range mirrors {
+		mirror, err := registry.ValidateMirror(m)
+		if err != nil {
+			return err
+		}
+		if indexOf(configFile.Mirrors, mirror) >= 0 || indexOf(added, mirror) >= 0 {
+			continue
+		}
+		added = append(added, mirror)
+	}
+
+	configFile.Mirrors = append(configFile.Mirrors, added...)
+	if err := configFile.Save(); err != nil {
+		return fmt.Errorf(i18n.T("Error saving mirrors: %v"), err)
+	}
+	for _, mirror := range added {
+		fmt.Fprintln(dockerCli.Out(), mirror)
+	}
+	return nil
+}
+
+// indexOf returns the position of mirror in mirrors, or -1.
+func indexOf(mirrors []string, mirror string) int {
+	for i, m := range mirrors {
+		if m == mirror {
+			return i
+		}
+	}
+	return -1
+}
+
+var addExample = `
+$ docker mirror add https://mirror.example.com
+https://mirror.example.com/
+`
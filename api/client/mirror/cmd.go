@@ -0,0 +1,46 @@
+package mirror
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/docker/docker/api/client"
+	"github.com/docker/docker/cli"
+	"github.com/docker/docker/cli/i18n"
+)
+
+// NewMirrorCommand returns a cobra command for `mirror` subcommands
+func NewMirrorCommand(dockerCli *client.DockerCli) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "mirror COMMAND",
+		Short: i18n.T("Manage the registry mirrors used to pull from Docker Hub"),
+		Long:  mirrorDescription,
+		Args:  cli.NoArgs,
+		Run: func(cmd *cobra.Command, args []string) {
+			fmt.Fprint(dockerCli.Err(), "\n"+cmd.UsageString())
+		},
+	}
+	cmd.AddCommand(
+		newAddCommand(dockerCli),
+		newListCommand(dockerCli),
+		newRemoveCommand(dockerCli),
+		newTestCommand(dockerCli),
+	)
+	return cmd
+}
+
+var mirrorDescription = `
+The **docker mirror** command has subcommands for managing the registry mirrors
+stored in the client configuration file.
+
+When mirrors are configured, **docker pull** fetches Docker Hub images through
+the mirror answering the fastest, falls back to the next mirror if the pull
+fails and to Docker Hub if every mirror fails. The pulled image is tagged with
+its original name, so it looks exactly like an image pulled from Docker Hub.
+
+To see help for a subcommand, use:
+
+    docker mirror CMD help
+
+`
@@ -0,0 +1,29 @@
+package mirror
+
+import (
+	"fmt"
+
+	"github.com/docker/docker/api/client"
+	"github.com/docker/docker/cli"
+	"github.com/docker/docker/cli/i18n"
+	"github.com/spf13/cobra"
+)
+
+func newListCommand(dockerCli *client.DockerCli) *cobra.Command {
+	return &cobra.Command{
+		Use:     "ls",
+		Aliases: []string{"list"},
+		Short:   i18n.T("List registry mirrors"),
+		Args:    cli.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runList(dockerCli)
+		},
+	}
+}
+
+func runList(dockerCli *client.DockerCli) error {
+	for _, mirror := range dockerCli.ConfigFile().Mirrors {
+		fmt.Fprintln(dockerCli.Out(), mirror)
+	}
+	return nil
+}
@@ -0,0 +1,60 @@
+package mirror
+
+import (
+	"fmt"
+
+	"github.com/docker/docker/api/client"
+	"github.com/docker/docker/cli"
+	"github.com/docker/docker/cli/i18n"
+	"github.com/docker/docker/registry"
+	"github.com/spf13/cobra"
+)
+
+func newRemoveCommand(dockerCli *client.DockerCli) *cobra.Command {
+	return &cobra.Command{
+		Use:     "rm MIRROR [MIRROR...]",
+		Aliases: []string{"remove"},
+		Short:   i18n.T("Remove one or more registry mirrors"),
+		Args:    cli.RequiresMinArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runRemove(dockerCli, args)
+		},
+	}
+}
+
+func runRemove(dockerCli *client.DockerCli, mirrors []string) error {
+	configFile := dockerCli.ConfigFile()
+	status := 0
+
+	var removed []string
+	for _, m := range mirrors {
+		mirror, err := registry.ValidateMirror(m)
+		if err != nil {
+			fmt.Fprintf(dockerCli.Err(), "%s\n", err)
+			status = 1
+			continue
+		}
+		i := indexOf(configFile.Mirrors, mirror)
+		if i < 0 {
+			fmt.Fprintf(dockerCli.Err(), i18n.T("No such mirror: %s\n"), m)
+			status = 1
+			continue
+		}
+		configFile.Mirrors = append(configFile.Mirrors[:i], configFile.Mirrors[i+1:]...)
+		removed = append(removed, mirror)
+	}
+
+	if len(removed) > 0 {
+		if err := configFile.Save(); err != nil {
+			return fmt.Errorf(i18n.T("Error saving mirrors: %v"), err)
+		}
+	}
+	for _, mirror := range removed {
+		fmt.Fprintln(dockerCli.Out(), mirror)
+	}
+
+	if status != 0 {
+		return cli.StatusError{StatusCode: status}
+	}
+	return nil
+}
@@ -0,0 +1,57 @@
+package mirror
+
+import (
+	"fmt"
+	"text/tabwriter"
+	"time"
+
+	"golang.org/x/net/context"
+
+	"github.com/docker/docker/api/client"
+	"github.com/docker/docker/cli/i18n"
+	"github.com/docker/docker/registry"
+	"github.com/spf13/cobra"
+)
+
+func newTestCommand(dockerCli *client.DockerCli) *cobra.Command {
+	return &cobra.Command{
+		Use:   "test [MIRROR...]",
+		Short: i18n.T("Measure the latency of registry mirrors"),
+		Long:  testDescription,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runTest(dockerCli, args)
+		},
+	}
+}
+
+func runTest(dockerCli *client.DockerCli, args []string) error {
+	mirrors := dockerCli.ConfigFile().Mirrors
+	if len(args) > 0 {
+		mirrors = nil
+		for _, m := range args {
+			mirror, err := registry.ValidateMirror(m)
+			if err != nil {
+				return err
+			}
+			mirrors = append(mirrors, mirror)
+		}
+	}
+
+	w := tabwriter.NewWriter(dockerCli.Out(), 20, 1, 3, ' ', 0)
+	fmt.Fprintf(w, "MIRROR\tLATENCY\tSTATUS\n")
+	for _, probe := range client.ProbeMirrors(context.Background(), mirrors) {
+		if probe.Err != nil {
+			fmt.Fprintf(w, "%s\t-\t%v\n", probe.Mirror, probe.Err)
+			continue
+		}
+		fmt.Fprintf(w, "%s\t%dms\tOK\n", probe.Mirror, probe.Latency/time.Millisecond)
+	}
+	w.Flush()
+	return nil
+}
+
+var testDescription = `
+Probes the registry mirrors given as arguments, or the configured mirrors if no
+argument is given, and lists them from the fastest to the slowest. This is the
+order in which **docker pull** tries them.
+`
@@ -0,0 +1,195 @@
+package client
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"golang.org/x/net/context"
+
+	"github.com/docker/docker/cli/i18n"
+	"github.com/docker/docker/cliconfig/configfile"
+	"github.com/docker/docker/reference"
+	"github.com/docker/engine-api/client"
+	"github.com/docker/engine-api/types"
+)
+
+func TestMirrorReference(t *testing.T) {
+	cases := map[string]string{
+		"ubuntu:16.04":         "mirror.example.com/library/ubuntu:16.04",
+		"docker.io/busybox:1":  "mirror.example.com/library/busybox:1",
+		"someone/something:v1": "mirror.example.com/someone/something:v1",
+	}
+	for name, expected := range cases {
+		ref, err := reference.ParseNamed(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		mirrorRef, err := MirrorReference("https://mirror.example.com/", ref.(reference.NamedTagged))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if mirrorRef.String() != expected {
+			t.Fatalf("%s: expected %s, got %s", name, expected, mirrorRef.String())
+		}
+	}
+}
+
+func TestProbeMirrors(t *testing.T) {
+	handler := func(delay time.Duration, status int) http.HandlerFunc {
+		return func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path != "/v2/" {
+				t.Errorf("unexpected probe path %s", r.URL.Path)
+			}
+			time.Sleep(delay)
+			w.WriteHeader(status)
+		}
+	}
+	slow := httptest.NewServer(handler(200*time.Millisecond, http.StatusOK))
+	defer slow.Close()
+	fast := httptest.NewServer(handler(0, http.StatusUnauthorized))
+	defer fast.Close()
+	broken := httptest.NewServer(handler(0, http.StatusInternalServerError))
+	defer broken.Close()
+
+	mirrors := []string{broken.URL + "/", slow.URL + "/", fast.URL + "/"}
+	probes := ProbeMirrors(context.Background(), mirrors)
+
+	expected := []string{fast.URL + "/", slow.URL + "/", broken.URL + "/"}
+	for i, probe := range probes {
+		if probe.Mirror != expected[i] {
+			t.Fatalf("expected mirror %d to be %s, got %s", i, expected[i], probe.Mirror)
+		}
+	}
+	if probes[0].Err != nil || probes[1].Err != nil {
+		t.Fatalf("expected reachable mirrors, got %v and %v", probes[0].Err, probes[1].Err)
+	}
+	if probes[2].Err == nil {
+		t.Fatal("expected an error for a mirror answering with a server error")
+	}
+}
+
+// fakeImageDaemon serves the image endpoints used by MirrorPull from a
+// reference store mapping references to image IDs.
+type fakeImageDaemon struct {
+	mu     sync.Mutex
+	refs   map[string]string
+	id     string
+	digest string
+}
+
+func (d *fakeImageDaemon) repoDigests(id string) []string {
+	var digests []string
+	for ref, refID := range d.refs {
+		if refID == id && strings.Contains(ref, "@") {
+			digests = append(digests, ref)
+		}
+	}
+	sort.Strings(digests)
+	return digests
+}
+
+func (d *fakeImageDaemon) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	path := r.URL.Path[strings.Index(r.URL.Path, "/images/")+len("/images/"):]
+	switch {
+	case r.Method == "POST" && path == "create":
+		name := r.FormValue("fromImage")
+		d.refs[name+":"+r.FormValue("tag")] = d.id
+		d.refs[name+"@"+d.digest] = d.id
+		w.Write([]byte(`{"status":"Downloaded newer image"}` + "\n"))
+	case r.Method == "GET" && strings.HasSuffix(path, "/json"):
+		id, ok := d.refs[strings.TrimSuffix(path, "/json")]
+		if !ok {
+			http.NotFound(w, r)
+			return
+		}
+		json.NewEncoder(w).Encode(types.ImageInspect{ID: id, RepoDigests: d.repoDigests(id)})
+	case r.Method == "POST" && strings.HasSuffix(path, "/tag"):
+		id, ok := d.refs[strings.TrimSuffix(path, "/tag")]
+		if !ok {
+			http.NotFound(w, r)
+			return
+		}
+		sep := ":"
+		if strings.HasPrefix(r.FormValue("tag"), "sha256:") {
+			sep = "@"
+		}
+		d.refs[r.FormValue("repo")+sep+r.FormValue("tag")] = id
+		w.WriteHeader(http.StatusCreated)
+	case r.Method == "DELETE":
+		if _, ok := d.refs[path]; !ok {
+			http.NotFound(w, r)
+			return
+		}
+		delete(d.refs, path)
+		json.NewEncoder(w).Encode([]types.ImageDelete{{Untagged: path}})
+	default:
+		http.Error(w, "unexpected request "+r.Method+" "+r.URL.Path, http.StatusBadRequest)
+	}
+}
+
+func TestMirrorPull(t *testing.T) {
+	i18n.SetLocale(i18n.SourceLocale)
+	defer i18n.SetLocale("")
+
+	mirror := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer mirror.Close()
+	daemon := &fakeImageDaemon{
+		refs:   make(map[string]string),
+		id:     "sha256:" + strings.Repeat("1", 64),
+		digest: "sha256:" + strings.Repeat("2", 64),
+	}
+	server := httptest.NewServer(daemon)
+	defer server.Close()
+
+	u, err := url.Parse(server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	apiClient, err := client.NewClient("tcp://"+u.Host, "1.25", nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cli := &DockerCli{
+		client:     apiClient,
+		configFile: &configfile.ConfigFile{AuthConfigs: make(map[string]types.AuthConfig), Mirrors: []string{mirror.URL + "/"}},
+		out:        ioutil.Discard,
+		err:        ioutil.Discard,
+	}
+
+	ref, err := reference.ParseNamed("ubuntu:16.04")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := cli.MirrorPull(context.Background(), ref.(reference.NamedTagged), types.AuthConfig{}, nil); err != nil {
+		t.Fatal(err)
+	}
+
+	// Nothing is left under the mirror name, and the original name has both
+	// the tag and the digest, as after a pull from Docker Hub.
+	expected := map[string]string{
+		"ubuntu:16.04":            daemon.id,
+		"ubuntu@" + daemon.digest: daemon.id,
+	}
+	if len(daemon.refs) != len(expected) {
+		t.Fatalf("expected references %v, got %v", expected, daemon.refs)
+	}
+	for ref, id := range expected {
+		if daemon.refs[ref] != id {
+			t.Fatalf("expected references %v, got %v", expected, daemon.refs)
+		}
+	}
+	if digests := daemon.repoDigests(daemon.id); len(digests) != 1 || digests[0] != "ubuntu@"+daemon.digest {
+		t.Fatalf("expected the digest under the original name, got %v", digests)
+	}
+}
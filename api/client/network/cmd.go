@@ -7,13 +7,14 @@ import (
 
 	"github.com/docker/docker/api/client"
 	"github.com/docker/docker/cli"
+	"github.com/docker/docker/cli/i18n"
 )
 
 // NewNetworkCommand returns a cobra command for `network` subcommands
 func NewNetworkCommand(dockerCli *client.DockerCli) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "network",
-		Short: "管理Docker网络",
+		Short: i18n.T("Manage Docker networks"),
 		Args:  cli.NoArgs,
 		Run: func(cmd *cobra.Command, args []string) {
 			fmt.Fprintf(dockerCli.Err(), "\n"+cmd.UsageString())
@@ -25,6 +26,7 @@ func NewNetworkCommand(dockerCli *client.DockerCli) *cobra.Command {
 		newDisconnectCommand(dockerCli),
 		newInspectCommand(dockerCli),
 		newListCommand(dockerCli),
+		newPruneCommand(dockerCli),
 		newRemoveCommand(dockerCli),
 	)
 	return cmd
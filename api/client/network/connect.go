@@ -5,6 +5,7 @@ import (
 
 	"github.com/docker/docker/api/client"
 	"github.com/docker/docker/cli"
+	"github.com/docker/docker/cli/i18n"
 	"github.com/docker/docker/opts"
 	runconfigopts "github.com/docker/docker/runconfig/opts"
 	"github.com/docker/engine-api/types/network"
@@ -28,7 +29,7 @@ func newConnectCommand(dockerCli *client.DockerCli) *cobra.Command {
 
 	cmd := &cobra.Command{
 		Use:   "connect [OPTIONS] NETWORK CONTAINER",
-		Short: "连接一个容器到一个网络",
+		Short: i18n.T("Connect a container to a network"),
 		Args:  cli.ExactArgs(2),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			opts.network = args[0]
@@ -38,11 +39,11 @@ func newConnectCommand(dockerCli *client.DockerCli) *cobra.Command {
 	}
 
 	flags := cmd.Flags()
-	flags.StringVar(&opts.ipaddress, "ip", "", "IP 地址")
-	flags.StringVar(&opts.ipv6address, "ip6", "", "IPv6 地址")
-	flags.Var(&opts.links, "link", "为另一个容器添加链接")
-	flags.StringSliceVar(&opts.aliases, "alias", []string{}, "为容器添加网络范围的别名")
-	flags.StringSliceVar(&opts.linklocalips, "link-local-ip", []string{}, "为容器添加一个当地链接的地址")
+	flags.StringVar(&opts.ipaddress, "ip", "", i18n.T("IP Address"))
+	flags.StringVar(&opts.ipv6address, "ip6", "", i18n.T("IPv6 Address"))
+	flags.Var(&opts.links, "link", i18n.T("Add link to another container"))
+	flags.StringSliceVar(&opts.aliases, "alias", []string{}, i18n.T("Add network-scoped alias for the container"))
+	flags.StringSliceVar(&opts.linklocalips, "link-local-ip", []string{}, i18n.T("Add a link-local address for the container"))
 
 	return cmd
 }
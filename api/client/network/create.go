@@ -1,6 +1,7 @@
 package network
 
 import (
+	"errors"
 	"fmt"
 	"net"
 	"strings"
@@ -9,6 +10,7 @@ import (
 
 	"github.com/docker/docker/api/client"
 	"github.com/docker/docker/cli"
+	"github.com/docker/docker/cli/i18n"
 	"github.com/docker/docker/opts"
 	runconfigopts "github.com/docker/docker/runconfig/opts"
 	"github.com/docker/engine-api/types"
@@ -41,7 +43,7 @@ func newCreateCommand(dockerCli *client.DockerCli) *cobra.Command {
 
 	cmd := &cobra.Command{
 		Use:   "create [OPTIONS] NETWORK",
-		Short: "创建一个网络",
+		Short: i18n.T("Create a network"),
 		Args:  cli.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			opts.name = args[0]
@@ -50,19 +52,19 @@ func newCreateCommand(dockerCli *client.DockerCli) *cobra.Command {
 	}
 
 	flags := cmd.Flags()
-	flags.StringVarP(&opts.driver, "driver", "d", "bridge", "管理网络所使用的网络驱动")
-	flags.VarP(&opts.driverOpts, "opt", "o", "设置指定驱动的选项")
-	flags.StringSliceVar(&opts.labels, "label", []string{}, "在一个网络设置元数据")
-	flags.BoolVar(&opts.internal, "internal", false, "限制外界对网络的访问能力")
-	flags.BoolVar(&opts.ipv6, "ipv6", false, "启用 IPv6 网络")
+	flags.StringVarP(&opts.driver, "driver", "d", "bridge", i18n.T("Driver to manage the Network"))
+	flags.VarP(&opts.driverOpts, "opt", "o", i18n.T("Set driver specific options"))
+	flags.StringSliceVar(&opts.labels, "label", []string{}, i18n.T("Set metadata on a network"))
+	flags.BoolVar(&opts.internal, "internal", false, i18n.T("Restrict external access to the network"))
+	flags.BoolVar(&opts.ipv6, "ipv6", false, i18n.T("Enable IPv6 networking"))
 
-	flags.StringVar(&opts.ipamDriver, "ipam-driver", "default", "IP地址管理驱动")
-	flags.StringSliceVar(&opts.ipamSubnet, "subnet", []string{}, "CIDR格式的子网信息，代表一个网络段")
-	flags.StringSliceVar(&opts.ipamIPRange, "ip-range", []string{}, "从一个子网范围内分配容器IP")
-	flags.StringSliceVar(&opts.ipamGateway, "gateway", []string{}, "为主子网设置的IPv4或IPv6网关")
+	flags.StringVar(&opts.ipamDriver, "ipam-driver", "default", i18n.T("IP Address Management Driver"))
+	flags.StringSliceVar(&opts.ipamSubnet, "subnet", []string{}, i18n.T("Subnet in CIDR format that represents a network segment"))
+	flags.StringSliceVar(&opts.ipamIPRange, "ip-range", []string{}, i18n.T("Allocate container ip from a sub-range"))
+	flags.StringSliceVar(&opts.ipamGateway, "gateway", []string{}, i18n.T("IPv4 or IPv6 Gateway for the master subnet"))
 
-	flags.Var(&opts.ipamAux, "aux-address", "被网络驱动使用的辅助IPv4或IPv6地址")
-	flags.Var(&opts.ipamOpt, "ipam-opt", "设置IP地址管理器的特定参数")
+	flags.Var(&opts.ipamAux, "aux-address", i18n.T("Auxiliary IPv4 or IPv6 addresses used by Network driver"))
+	flags.Var(&opts.ipamOpt, "ipam-opt", i18n.T("Set IPAM driver specific options"))
 
 	return cmd
 }
@@ -105,7 +107,7 @@ func runCreate(dockerCli *client.DockerCli, opts createOptions) error {
 // structured ipam data.
 func consolidateIpam(subnets, ranges, gateways []string, auxaddrs map[string]string) ([]network.IPAMConfig, error) {
 	if len(subnets) < len(ranges) || len(subnets) < len(gateways) {
-		return nil, fmt.Errorf("每一个IP范围或网关必须拥有一个相应的子网地址")
+		return nil, errors.New(i18n.T("every ip-range or gateway must have a corresponding subnet"))
 	}
 	iData := map[string]*network.IPAMConfig{}
 
@@ -121,7 +123,7 @@ func consolidateIpam(subnets, ranges, gateways []string, auxaddrs map[string]str
 				return nil, err
 			}
 			if ok1 || ok2 {
-				return nil, fmt.Errorf("多个子网配置信息有重叠的情况，Docker引擎暂不支持")
+				return nil, errors.New(i18n.T("multiple overlapping subnet configuration is not supported"))
 			}
 		}
 		iData[s] = &network.IPAMConfig{Subnet: s, AuxAddress: map[string]string{}}
@@ -139,14 +141,14 @@ func consolidateIpam(subnets, ranges, gateways []string, auxaddrs map[string]str
 				continue
 			}
 			if iData[s].IPRange != "" {
-				return nil, fmt.Errorf("不能配置在同一个子网 (%s)中配置多范围(%s, %s)", s, r, iData[s].IPRange)
+				return nil, fmt.Errorf(i18n.T("cannot configure multiple ranges (%s, %s) on the same subnet (%s)"), r, iData[s].IPRange, s)
 			}
 			d := iData[s]
 			d.IPRange = r
 			match = true
 		}
 		if !match {
-			return nil, fmt.Errorf("没有匹配的子网地址 %s", r)
+			return nil, fmt.Errorf(i18n.T("no matching subnet for range %s"), r)
 		}
 	}
 
@@ -162,14 +164,14 @@ func consolidateIpam(subnets, ranges, gateways []string, auxaddrs map[string]str
 				continue
 			}
 			if iData[s].Gateway != "" {
-				return nil, fmt.Errorf("不能配置在同一个子网 (%s)中配置多个网关(%s, %s)", s, g, iData[s].Gateway)
+				return nil, fmt.Errorf(i18n.T("cannot configure multiple gateways (%s, %s) for the same subnet (%s)"), g, iData[s].Gateway, s)
 			}
 			d := iData[s]
 			d.Gateway = g
 			match = true
 		}
 		if !match {
-			return nil, fmt.Errorf("没有匹配的子网地址 %s", g)
+			return nil, fmt.Errorf(i18n.T("no matching subnet for gateway %s"), g)
 		}
 	}
 
@@ -188,7 +190,7 @@ func consolidateIpam(subnets, ranges, gateways []string, auxaddrs map[string]str
 			match = true
 		}
 		if !match {
-			return nil, fmt.Errorf("没有找到符合辅助网络地址的子网 %s", aa)
+			return nil, fmt.Errorf(i18n.T("no matching subnet for aux-address %s"), aa)
 		}
 	}
 
@@ -206,13 +208,13 @@ func subnetMatches(subnet, data string) (bool, error) {
 
 	_, s, err := net.ParseCIDR(subnet)
 	if err != nil {
-		return false, fmt.Errorf("无效的子网地址 %s : %v", s, err)
+		return false, fmt.Errorf(i18n.T("Invalid subnet %s : %v"), s, err)
 	}
 
 	if strings.Contains(data, "/") {
 		ip, _, err = net.ParseCIDR(data)
 		if err != nil {
-			return false, fmt.Errorf("无效的 CIDR %s : %v", data, err)
+			return false, fmt.Errorf(i18n.T("Invalid cidr %s : %v"), data, err)
 		}
 	} else {
 		ip = net.ParseIP(data)
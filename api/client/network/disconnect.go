@@ -5,6 +5,7 @@ import (
 
 	"github.com/docker/docker/api/client"
 	"github.com/docker/docker/cli"
+	"github.com/docker/docker/cli/i18n"
 	"github.com/spf13/cobra"
 )
 
@@ -19,7 +20,7 @@ func newDisconnectCommand(dockerCli *client.DockerCli) *cobra.Command {
 
 	cmd := &cobra.Command{
 		Use:   "disconnect [OPTIONS] NETWORK CONTAINER",
-		Short: "断开一个容器和一个网络的连接",
+		Short: i18n.T("Disconnect a container from a network"),
 		Args:  cli.ExactArgs(2),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			opts.network = args[0]
@@ -29,7 +30,7 @@ func newDisconnectCommand(dockerCli *client.DockerCli) *cobra.Command {
 	}
 
 	flags := cmd.Flags()
-	flags.BoolVarP(&opts.force, "force", "f", false, "强制容器从网络断开连接")
+	flags.BoolVarP(&opts.force, "force", "f", false, i18n.T("Force the container to disconnect from a network"))
 
 	return cmd
 }
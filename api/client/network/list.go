@@ -9,6 +9,7 @@ import (
 
 	"github.com/docker/docker/api/client"
 	"github.com/docker/docker/cli"
+	"github.com/docker/docker/cli/i18n"
 	"github.com/docker/docker/pkg/stringid"
 	"github.com/docker/engine-api/types"
 	"github.com/docker/engine-api/types/filters"
@@ -33,7 +34,7 @@ func newListCommand(dockerCli *client.DockerCli) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:     "ls [OPTIONS]",
 		Aliases: []string{"list"},
-		Short:   "罗列所有网络",
+		Short:   i18n.T("List networks"),
 		Args:    cli.NoArgs,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			return runList(dockerCli, opts)
@@ -42,9 +43,9 @@ func newListCommand(dockerCli *client.DockerCli) *cobra.Command {
 
 	flags := cmd.Flags()
 
-	flags.BoolVarP(&opts.quiet, "quiet", "q", false, "仅显示网络ID")
-	flags.BoolVar(&opts.noTrunc, "no-trunc", false, "不截断命令输出内容")
-	flags.StringSliceVarP(&opts.filter, "filter", "f", []string{}, "提供一些过滤值(比如 'dangling=true')")
+	flags.BoolVarP(&opts.quiet, "quiet", "q", false, i18n.T("Only display network IDs"))
+	flags.BoolVar(&opts.noTrunc, "no-trunc", false, i18n.T("Do not truncate the output"))
+	flags.StringSliceVarP(&opts.filter, "filter", "f", []string{}, i18n.T("Provide filter values (i.e. 'dangling=true')"))
 
 	return cmd
 }
@@ -72,7 +73,7 @@ func runList(dockerCli *client.DockerCli, opts listOptions) error {
 
 	w := tabwriter.NewWriter(dockerCli.Out(), 20, 1, 3, ' ', 0)
 	if !opts.quiet {
-		fmt.Fprintf(w, "网络ID\t名称\t驱动\t范围")
+		fmt.Fprint(w, i18n.T("NETWORK ID\tNAME\tDRIVER\tSCOPE"))
 		fmt.Fprintf(w, "\n")
 	}
 
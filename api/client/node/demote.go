@@ -5,6 +5,7 @@ import (
 
 	"github.com/docker/docker/api/client"
 	"github.com/docker/docker/cli"
+	"github.com/docker/docker/cli/i18n"
 	"github.com/docker/engine-api/types/swarm"
 	"github.com/spf13/cobra"
 )
@@ -12,7 +13,7 @@ import (
 func newDemoteCommand(dockerCli *client.DockerCli) *cobra.Command {
 	return &cobra.Command{
 		Use:   "demote NODE [NODE...]",
-		Short: "将Swarm集群中的一个或多个节点从管理者降级为工作者",
+		Short: i18n.T("Demote one or more nodes from manager in the swarm"),
 		Args:  cli.RequiresMinArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			return runDemote(dockerCli, args)
@@ -26,7 +27,7 @@ func runDemote(dockerCli *client.DockerCli, nodes []string) error {
 		return nil
 	}
 	success := func(nodeID string) {
-		fmt.Fprintf(dockerCli.Out(), "在Swarm集群中成功将节点 %s 降级为工作者.\n", nodeID)
+		fmt.Fprintf(dockerCli.Out(), i18n.T("Manager %s demoted in the swarm.\n"), nodeID)
 	}
 	return updateNodes(dockerCli, nodes, demote, success)
 }
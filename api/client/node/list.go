@@ -9,6 +9,7 @@ import (
 
 	"github.com/docker/docker/api/client"
 	"github.com/docker/docker/cli"
+	"github.com/docker/docker/cli/i18n"
 	"github.com/docker/docker/opts"
 	"github.com/docker/engine-api/types"
 	"github.com/docker/engine-api/types/swarm"
@@ -30,15 +31,15 @@ func newListCommand(dockerCli *client.DockerCli) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:     "ls [OPTIONS]",
 		Aliases: []string{"list"},
-		Short:   "在Swarm集群中罗列所有的节点",
+		Short:   i18n.T("List nodes in the swarm"),
 		Args:    cli.NoArgs,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			return runList(dockerCli, opts)
 		},
 	}
 	flags := cmd.Flags()
-	flags.BoolVarP(&opts.quiet, "quiet", "q", false, "只显示节点的ID。")
-	flags.VarP(&opts.filter, "filter", "f", "基于指定条件过滤输出。")
+	flags.BoolVarP(&opts.quiet, "quiet", "q", false, i18n.T("Only display IDs"))
+	flags.VarP(&opts.filter, "filter", "f", i18n.T("Filter output based on conditions provided"))
 
 	return cmd
 }
@@ -74,7 +75,7 @@ func printTable(out io.Writer, nodes []swarm.Node, info types.Info) {
 	// Ignore flushing errors
 	defer writer.Flush()
 
-	fmt.Fprintf(writer, listItemFmt, "ID", "主机名", "状态", "可达性", "管理者状态")
+	fmt.Fprintf(writer, listItemFmt, "ID", i18n.T("HOSTNAME"), i18n.T("STATUS"), i18n.T("AVAILABILITY"), i18n.T("MANAGER STATUS"))
 	for _, node := range nodes {
 		name := node.Description.Hostname
 		availability := string(node.Spec.Availability)
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/docker/docker/cli/i18n"
 	"github.com/docker/docker/opts"
 	runconfigopts "github.com/docker/docker/runconfig/opts"
 	"github.com/docker/engine-api/types/swarm"
@@ -41,7 +42,7 @@ func (opts *nodeOptions) ToNodeSpec() (swarm.NodeSpec, error) {
 		spec.Role = swarm.NodeRoleManager
 	case "":
 	default:
-		return swarm.NodeSpec{}, fmt.Errorf("无效的节点角色 %q, 当前Swarm只支持工作者和管理者", opts.role)
+		return swarm.NodeSpec{}, fmt.Errorf(i18n.T("invalid role %q, only worker and manager are supported"), opts.role)
 	}
 
 	switch swarm.NodeAvailability(strings.ToLower(opts.availability)) {
@@ -53,7 +54,7 @@ func (opts *nodeOptions) ToNodeSpec() (swarm.NodeSpec, error) {
 		spec.Availability = swarm.NodeAvailabilityDrain
 	case "":
 	default:
-		return swarm.NodeSpec{}, fmt.Errorf("无效的节点可达性 %q, 只支持活跃，暂停，维护状态", opts.availability)
+		return swarm.NodeSpec{}, fmt.Errorf(i18n.T("invalid availability %q, only active, pause and drain are supported"), opts.availability)
 	}
 
 	return spec, nil
@@ -5,6 +5,7 @@ import (
 
 	"github.com/docker/docker/api/client"
 	"github.com/docker/docker/cli"
+	"github.com/docker/docker/cli/i18n"
 	"github.com/docker/engine-api/types/swarm"
 	"github.com/spf13/cobra"
 )
@@ -12,7 +13,7 @@ import (
 func newPromoteCommand(dockerCli *client.DockerCli) *cobra.Command {
 	return &cobra.Command{
 		Use:   "promote NODE [NODE...]",
-		Short: "在Swarm集群中升级一个或多个到管理者角色",
+		Short: i18n.T("Promote one or more nodes to manager in the swarm"),
 		Args:  cli.RequiresMinArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			return runPromote(dockerCli, args)
@@ -26,7 +27,7 @@ func runPromote(dockerCli *client.DockerCli, nodes []string) error {
 		return nil
 	}
 	success := func(nodeID string) {
-		fmt.Fprintf(dockerCli.Out(), "成功将Swarm集群中的节点 %s 升级到管理者角色。\n", nodeID)
+		fmt.Fprintf(dockerCli.Out(), i18n.T("Node %s promoted to a manager in the swarm.\n"), nodeID)
 	}
 	return updateNodes(dockerCli, nodes, promote, success)
 }
@@ -7,6 +7,7 @@ import (
 	"github.com/docker/docker/api/client/idresolver"
 	"github.com/docker/docker/api/client/task"
 	"github.com/docker/docker/cli"
+	"github.com/docker/docker/cli/i18n"
 	"github.com/docker/docker/opts"
 	"github.com/docker/engine-api/types"
 	"github.com/spf13/cobra"
@@ -23,7 +24,7 @@ func newPSCommand(dockerCli *client.DockerCli) *cobra.Command {
 
 	cmd := &cobra.Command{
 		Use:   "ps [OPTIONS] self|NODE",
-		Short: "罗列一个节点上的运行任务，默认指定指定当前节点",
+		Short: i18n.T("List tasks running on a node, defaults to current node"),
 		Args:  cli.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			opts.nodeID = args[0]
@@ -31,8 +32,8 @@ func newPSCommand(dockerCli *client.DockerCli) *cobra.Command {
 		},
 	}
 	flags := cmd.Flags()
-	flags.BoolVar(&opts.noResolve, "no-resolve", false, "不将ID解析成名称")
-	flags.VarP(&opts.filter, "filter", "f", "通过指定条件过滤命令输出内容")
+	flags.BoolVar(&opts.noResolve, "no-resolve", false, i18n.T("Do not map IDs to Names"))
+	flags.VarP(&opts.filter, "filter", "f", i18n.T("Filter output based on conditions provided"))
 
 	return cmd
 }
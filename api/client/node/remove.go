@@ -7,6 +7,7 @@ import (
 
 	"github.com/docker/docker/api/client"
 	"github.com/docker/docker/cli"
+	"github.com/docker/docker/cli/i18n"
 	"github.com/docker/engine-api/types"
 	"github.com/spf13/cobra"
 )
@@ -21,14 +22,14 @@ func newRemoveCommand(dockerCli *client.DockerCli) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:     "rm [OPTIONS] NODE [NODE...]",
 		Aliases: []string{"remove"},
-		Short:   "从Swarm集群中删除一个或多个节点",
+		Short:   i18n.T("Remove one or more nodes from the swarm"),
 		Args:    cli.RequiresMinArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			return runRemove(dockerCli, args, opts)
 		},
 	}
 	flags := cmd.Flags()
-	flags.BoolVar(&opts.force, "force", false, "强制删除一个活跃节点")
+	flags.BoolVar(&opts.force, "force", false, i18n.T("Force remove an active node"))
 	return cmd
 }
 
@@ -5,6 +5,7 @@ import (
 
 	"github.com/docker/docker/api/client"
 	"github.com/docker/docker/cli"
+	"github.com/docker/docker/cli/i18n"
 	"github.com/docker/docker/opts"
 	runconfigopts "github.com/docker/docker/runconfig/opts"
 	"github.com/docker/engine-api/types/swarm"
@@ -18,7 +19,7 @@ func newUpdateCommand(dockerCli *client.DockerCli) *cobra.Command {
 
 	cmd := &cobra.Command{
 		Use:   "update [OPTIONS] NODE",
-		Short: "更新Swarm集群中的单个节点",
+		Short: i18n.T("Update a node"),
 		Args:  cli.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			return runUpdate(dockerCli, cmd.Flags(), args[0])
@@ -26,11 +27,11 @@ func newUpdateCommand(dockerCli *client.DockerCli) *cobra.Command {
 	}
 
 	flags := cmd.Flags()
-	flags.StringVar(&nodeOpts.role, flagRole, "", "节点角色工作者或管理者(worker/manager)")
-	flags.StringVar(&nodeOpts.availability, flagAvailability, "", "节点的可达状态（活跃/暂停/维护）(active/pause/drain)")
-	flags.Var(&nodeOpts.annotations.labels, flagLabelAdd, "添加或更新一个标签信息（键＝值）(key=value)")
+	flags.StringVar(&nodeOpts.role, flagRole, "", i18n.T("Role of the node (worker/manager)"))
+	flags.StringVar(&nodeOpts.availability, flagAvailability, "", i18n.T("Availability of the node (active/pause/drain)"))
+	flags.Var(&nodeOpts.annotations.labels, flagLabelAdd, i18n.T("Add or update a node label (key=value)"))
 	labelKeys := opts.NewListOpts(nil)
-	flags.Var(&labelKeys, flagLabelRemove, "删除一个节点标签，如果存在的话")
+	flags.Var(&labelKeys, flagLabelRemove, i18n.T("Remove a node label if exists"))
 	return cmd
 }
 
@@ -96,7 +97,7 @@ func mergeNodeUpdate(flags *pflag.FlagSet) func(*swarm.Node) error {
 			for _, k := range keys {
 				// if a key doesn't exist, fail the command explicitly
 				if _, exists := spec.Annotations.Labels[k]; !exists {
-					return fmt.Errorf("key %s doesn't exist in node's labels", k)
+					return fmt.Errorf(i18n.T("key %s doesn't exist in node's labels"), k)
 				}
 				delete(spec.Annotations.Labels, k)
 			}
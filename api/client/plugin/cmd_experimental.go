@@ -7,6 +7,7 @@ import (
 
 	"github.com/docker/docker/api/client"
 	"github.com/docker/docker/cli"
+	"github.com/docker/docker/cli/i18n"
 	"github.com/spf13/cobra"
 )
 
@@ -14,7 +15,7 @@ import (
 func NewPluginCommand(rootCmd *cobra.Command, dockerCli *client.DockerCli) {
 	cmd := &cobra.Command{
 		Use:   "plugin",
-		Short: "Manage Docker plugins",
+		Short: i18n.T("Manage Docker plugins"),
 		Args:  cli.NoArgs,
 		Run: func(cmd *cobra.Command, args []string) {
 			fmt.Fprintf(dockerCli.Err(), "\n"+cmd.UsageString())
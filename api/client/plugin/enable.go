@@ -7,6 +7,7 @@ import (
 
 	"github.com/docker/docker/api/client"
 	"github.com/docker/docker/cli"
+	"github.com/docker/docker/cli/i18n"
 	"github.com/docker/docker/reference"
 	"github.com/spf13/cobra"
 	"golang.org/x/net/context"
@@ -15,7 +16,7 @@ import (
 func newEnableCommand(dockerCli *client.DockerCli) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "enable PLUGIN",
-		Short: "启用指定插件",
+		Short: i18n.T("Enable a plugin"),
 		Args:  cli.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			return runEnable(dockerCli, args[0])
@@ -35,7 +36,7 @@ func runEnable(dockerCli *client.DockerCli, name string) error {
 	}
 	ref, ok := named.(reference.NamedTagged)
 	if !ok {
-		return fmt.Errorf("无效名称: %s", named.String())
+		return fmt.Errorf(i18n.T("invalid name: %s"), named.String())
 	}
 	if err := dockerCli.Client().PluginEnable(context.Background(), ref.String()); err != nil {
 		return err
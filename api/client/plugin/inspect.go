@@ -8,6 +8,7 @@ import (
 
 	"github.com/docker/docker/api/client"
 	"github.com/docker/docker/cli"
+	"github.com/docker/docker/cli/i18n"
 	"github.com/docker/docker/reference"
 	"github.com/spf13/cobra"
 	"golang.org/x/net/context"
@@ -16,7 +17,7 @@ import (
 func newInspectCommand(dockerCli *client.DockerCli) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "inspect PLUGIN",
-		Short: "查看指定插件的详细信息",
+		Short: i18n.T("Inspect a plugin"),
 		Args:  cli.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			return runInspect(dockerCli, args[0])
@@ -36,7 +37,7 @@ func runInspect(dockerCli *client.DockerCli, name string) error {
 	}
 	ref, ok := named.(reference.NamedTagged)
 	if !ok {
-		return fmt.Errorf("无效名称: %s", named.String())
+		return fmt.Errorf(i18n.T("invalid name: %s"), named.String())
 	}
 	p, err := dockerCli.Client().PluginInspect(context.Background(), ref.String())
 	if err != nil {
@@ -9,6 +9,7 @@ import (
 
 	"github.com/docker/docker/api/client"
 	"github.com/docker/docker/cli"
+	"github.com/docker/docker/cli/i18n"
 	"github.com/docker/docker/reference"
 	"github.com/docker/docker/registry"
 	"github.com/docker/engine-api/types"
@@ -26,7 +27,7 @@ func newInstallCommand(dockerCli *client.DockerCli) *cobra.Command {
 	var options pluginOptions
 	cmd := &cobra.Command{
 		Use:   "install [OPTIONS] PLUGIN",
-		Short: "安装指定插件",
+		Short: i18n.T("Install a plugin"),
 		Args:  cli.ExactArgs(1), // TODO: allow for set args
 		RunE: func(cmd *cobra.Command, args []string) error {
 			options.name = args[0]
@@ -35,8 +36,8 @@ func newInstallCommand(dockerCli *client.DockerCli) *cobra.Command {
 	}
 
 	flags := cmd.Flags()
-	flags.BoolVar(&options.grantPerms, "grant-all-permissions", false, "为运行插件授予所有的必须权限")
-	flags.BoolVar(&options.disable, "disable", false, "不在安装过程中启用插件")
+	flags.BoolVar(&options.grantPerms, "grant-all-permissions", false, i18n.T("grant all permissions necessary to run the plugin"))
+	flags.BoolVar(&options.disable, "disable", false, i18n.T("do not enable the plugin on install"))
 
 	return cmd
 }
@@ -51,7 +52,7 @@ func runInstall(dockerCli *client.DockerCli, opts pluginOptions) error {
 	}
 	ref, ok := named.(reference.NamedTagged)
 	if !ok {
-		return fmt.Errorf("无效名称: %s", named.String())
+		return fmt.Errorf(i18n.T("invalid name: %s"), named.String())
 	}
 
 	ctx := context.Background()
@@ -87,12 +88,12 @@ func runInstall(dockerCli *client.DockerCli, opts pluginOptions) error {
 
 func acceptPrivileges(dockerCli *client.DockerCli, name string) func(privileges types.PluginPrivileges) (bool, error) {
 	return func(privileges types.PluginPrivileges) (bool, error) {
-		fmt.Fprintf(dockerCli.Out(), "插件 %q 正在请求以下特权:\n", name)
+		fmt.Fprintf(dockerCli.Out(), i18n.T("Plugin %q is requesting the following privileges:\n"), name)
 		for _, privilege := range privileges {
 			fmt.Fprintf(dockerCli.Out(), " - %s: %v\n", privilege.Name, privilege.Value)
 		}
 
-		fmt.Fprint(dockerCli.Out(), "您允许授予以下特权? [y/N] ")
+		fmt.Fprint(dockerCli.Out(), i18n.T("Do you grant the above permissions? [y/N] "))
 		reader := bufio.NewReader(dockerCli.In())
 		line, _, err := reader.ReadLine()
 		if err != nil {
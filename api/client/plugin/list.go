@@ -8,6 +8,7 @@ import (
 
 	"github.com/docker/docker/api/client"
 	"github.com/docker/docker/cli"
+	"github.com/docker/docker/cli/i18n"
 	"github.com/spf13/cobra"
 	"golang.org/x/net/context"
 )
@@ -15,7 +16,7 @@ import (
 func newListCommand(dockerCli *client.DockerCli) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:     "ls",
-		Short:   "罗列所有插件",
+		Short:   i18n.T("List plugins"),
 		Aliases: []string{"list"},
 		Args:    cli.NoArgs,
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -33,7 +34,7 @@ func runList(dockerCli *client.DockerCli) error {
 	}
 
 	w := tabwriter.NewWriter(dockerCli.Out(), 20, 1, 3, ' ', 0)
-	fmt.Fprintf(w, "名称 \t标签 \t活跃状态")
+	fmt.Fprint(w, i18n.T("NAME \tTAG \tACTIVE"))
 	fmt.Fprintf(w, "\n")
 
 	for _, p := range plugins {
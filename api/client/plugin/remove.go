@@ -7,6 +7,7 @@ import (
 
 	"github.com/docker/docker/api/client"
 	"github.com/docker/docker/cli"
+	"github.com/docker/docker/cli/i18n"
 	"github.com/docker/docker/reference"
 	"github.com/spf13/cobra"
 	"golang.org/x/net/context"
@@ -15,7 +16,7 @@ import (
 func newRemoveCommand(dockerCli *client.DockerCli) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:     "rm PLUGIN",
-		Short:   "删除指定插件",
+		Short:   i18n.T("Remove a plugin"),
 		Aliases: []string{"remove"},
 		Args:    cli.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -38,7 +39,7 @@ func runRemove(dockerCli *client.DockerCli, names []string) error {
 		}
 		ref, ok := named.(reference.NamedTagged)
 		if !ok {
-			return fmt.Errorf("无效名称: %s", named.String())
+			return fmt.Errorf(i18n.T("invalid name: %s"), named.String())
 		}
 		// TODO: pass names to api instead of making multiple api calls
 		if err := dockerCli.Client().PluginRemove(context.Background(), ref.String()); err != nil {
@@ -9,6 +9,7 @@ import (
 
 	"github.com/docker/docker/api/client"
 	"github.com/docker/docker/cli"
+	"github.com/docker/docker/cli/i18n"
 	"github.com/docker/docker/reference"
 	"github.com/spf13/cobra"
 )
@@ -16,7 +17,7 @@ import (
 func newSetCommand(dockerCli *client.DockerCli) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "set PLUGIN key1=value1 [key2=value2...]",
-		Short: "为指定插件修改配置",
+		Short: i18n.T("Change settings for a plugin"),
 		Args:  cli.RequiresMinArgs(2),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			return runSet(dockerCli, args[0], args[1:])
@@ -36,7 +37,7 @@ func runSet(dockerCli *client.DockerCli, name string, args []string) error {
 	}
 	ref, ok := named.(reference.NamedTagged)
 	if !ok {
-		return fmt.Errorf("无效名称: %s", named.String())
+		return fmt.Errorf(i18n.T("invalid name: %s"), named.String())
 	}
 	return dockerCli.Client().PluginSet(context.Background(), ref.String(), args)
 }
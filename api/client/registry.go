@@ -4,6 +4,7 @@ import (
 	"bufio"
 	"encoding/base64"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"os"
@@ -12,6 +13,7 @@ import (
 
 	"golang.org/x/net/context"
 
+	"github.com/docker/docker/cli/i18n"
 	"github.com/docker/docker/pkg/term"
 	"github.com/docker/docker/reference"
 	"github.com/docker/docker/registry"
@@ -27,7 +29,7 @@ func (cli *DockerCli) ElectAuthServer(ctx context.Context) string {
 	// the default registry URL might be Windows specific.
 	serverAddress := registry.IndexServer
 	if info, err := cli.client.Info(ctx); err != nil {
-		fmt.Fprintf(cli.out, "Warning: failed to get default registry endpoint from daemon (%v). Using system default: %s\n", err, serverAddress)
+		fmt.Fprintf(cli.out, i18n.T("Warning: failed to get default registry endpoint from daemon (%v). Using system default: %s\n"), err, serverAddress)
 	} else {
 		serverAddress = info.IndexServerAddress
 	}
@@ -104,7 +106,7 @@ func (cli *DockerCli) ConfigureAuth(flUser, flPassword, serverAddress string, is
 	// will hit this if you attempt docker login from mintty where stdin
 	// is a pipe, not a character based console.
 	if flPassword == "" && !cli.isTerminalIn {
-		return authconfig, fmt.Errorf("Error: Cannot perform an interactive login from a non TTY device")
+		return authconfig, errors.New(i18n.T("Error: Cannot perform an interactive login from a non TTY device"))
 	}
 
 	authconfig.Username = strings.TrimSpace(authconfig.Username)
@@ -112,7 +114,7 @@ func (cli *DockerCli) ConfigureAuth(flUser, flPassword, serverAddress string, is
 	if flUser = strings.TrimSpace(flUser); flUser == "" {
 		if isDefaultRegistry {
 			// if this is a default registry (docker hub), then display the following message.
-			fmt.Fprintln(cli.out, "Login with your Docker ID to push and pull images from Docker Hub. If you don't have a Docker ID, head over to https://hub.docker.com to create one.")
+			fmt.Fprintln(cli.out, i18n.T("Login with your Docker ID to push and pull images from Docker Hub. If you don't have a Docker ID, head over to https://hub.docker.com to create one."))
 		}
 		cli.promptWithDefault("Username", authconfig.Username)
 		flUser = readInput(cli.in, cli.out)
@@ -122,14 +124,14 @@ func (cli *DockerCli) ConfigureAuth(flUser, flPassword, serverAddress string, is
 		}
 	}
 	if flUser == "" {
-		return authconfig, fmt.Errorf("Error: Non-null Username Required")
+		return authconfig, errors.New(i18n.T("Error: Non-null Username Required"))
 	}
 	if flPassword == "" {
 		oldState, err := term.SaveState(cli.inFd)
 		if err != nil {
 			return authconfig, err
 		}
-		fmt.Fprintf(cli.out, "Password: ")
+		fmt.Fprint(cli.out, i18n.T("Password: "))
 		term.DisableEcho(cli.inFd, oldState)
 
 		flPassword = readInput(cli.in, cli.out)
@@ -137,7 +139,7 @@ func (cli *DockerCli) ConfigureAuth(flUser, flPassword, serverAddress string, is
 
 		term.RestoreTerminal(cli.inFd, oldState)
 		if flPassword == "" {
-			return authconfig, fmt.Errorf("Error: Password Required")
+			return authconfig, errors.New(i18n.T("Error: Password Required"))
 		}
 	}
 
@@ -7,6 +7,7 @@ import (
 
 	"github.com/docker/docker/api/client"
 	"github.com/docker/docker/cli"
+	"github.com/docker/docker/cli/i18n"
 	"github.com/spf13/cobra"
 )
 
@@ -23,8 +24,8 @@ func NewLoginCommand(dockerCli *client.DockerCli) *cobra.Command {
 
 	cmd := &cobra.Command{
 		Use:   "login [OPTIONS] [SERVER]",
-		Short: "登陆一个Docker镜像仓库.",
-		Long:  "登陆一个Docker镜像仓库.\n如果没有制定服务器, Docker引擎会采用默认地址.",
+		Short: i18n.T("Log in to a Docker registry."),
+		Long:  i18n.T("Log in to a Docker registry.\nIf no server is specified, the default is defined by the daemon."),
 		Args:  cli.RequiresMaxArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			if len(args) > 0 {
@@ -36,12 +37,12 @@ func NewLoginCommand(dockerCli *client.DockerCli) *cobra.Command {
 
 	flags := cmd.Flags()
 
-	flags.StringVarP(&opts.user, "username", "u", "", "Username")
-	flags.StringVarP(&opts.password, "password", "p", "", "Password")
+	flags.StringVarP(&opts.user, "username", "u", "", i18n.T("Username"))
+	flags.StringVarP(&opts.password, "password", "p", "", i18n.T("Password"))
 
 	// Deprecated in 1.11: Should be removed in docker 1.13
-	flags.StringVarP(&opts.email, "email", "e", "", "Email")
-	flags.MarkDeprecated("email", "will be removed in 1.13.")
+	flags.StringVarP(&opts.email, "email", "e", "", i18n.T("Email"))
+	flags.MarkDeprecated("email", i18n.T("will be removed in 1.13."))
 
 	return cmd
 }
@@ -71,7 +72,7 @@ func runLogin(dockerCli *client.DockerCli, opts loginOptions) error {
 		authConfig.IdentityToken = response.IdentityToken
 	}
 	if err := client.StoreCredentials(dockerCli.ConfigFile(), authConfig); err != nil {
-		return fmt.Errorf("保存认证信息失败: %v", err)
+		return fmt.Errorf(i18n.T("Error saving credentials: %v"), err)
 	}
 
 	if response.Status != "" {
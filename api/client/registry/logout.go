@@ -7,6 +7,7 @@ import (
 
 	"github.com/docker/docker/api/client"
 	"github.com/docker/docker/cli"
+	"github.com/docker/docker/cli/i18n"
 	"github.com/spf13/cobra"
 )
 
@@ -14,8 +15,8 @@ import (
 func NewLogoutCommand(dockerCli *client.DockerCli) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "logout [SERVER]",
-		Short: "登出Docker镜像仓库.",
-		Long:  "登出Docker镜像仓库.\n如果没有制定服务端，Docker引擎会采用默认地址.",
+		Short: i18n.T("Log out from a Docker registry."),
+		Long:  i18n.T("Log out from a Docker registry.\nIf no server is specified, the default is defined by the daemon."),
 		Args:  cli.RequiresMaxArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			var serverAddress string
@@ -39,13 +40,13 @@ func runLogout(dockerCli *client.DockerCli, serverAddress string) error {
 	// check if we're logged in based on the records in the config file
 	// which means it couldn't have user/pass cause they may be in the creds store
 	if _, ok := dockerCli.ConfigFile().AuthConfigs[serverAddress]; !ok {
-		fmt.Fprintf(dockerCli.Out(), "不能登陆地址 %s\n", serverAddress)
+		fmt.Fprintf(dockerCli.Out(), i18n.T("Not logged in to %s\n"), serverAddress)
 		return nil
 	}
 
-	fmt.Fprintf(dockerCli.Out(), "为Docker镜像仓库 %s 删除登陆认证信息\n", serverAddress)
+	fmt.Fprintf(dockerCli.Out(), i18n.T("Removing login credentials for %s\n"), serverAddress)
 	if err := client.EraseCredentials(dockerCli.ConfigFile(), serverAddress); err != nil {
-		fmt.Fprintf(dockerCli.Err(), "警告: 清理认证信息失败: %v\n", err)
+		fmt.Fprintf(dockerCli.Err(), i18n.T("WARNING: could not erase credentials: %v\n"), err)
 	}
 
 	return nil
@@ -0,0 +1,78 @@
+package secret
+
+import (
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+
+	"golang.org/x/net/context"
+
+	"github.com/docker/docker/api/client"
+	"github.com/docker/docker/cli"
+	"github.com/docker/docker/cli/i18n"
+	runconfigopts "github.com/docker/docker/runconfig/opts"
+	"github.com/docker/engine-api/types/swarm"
+	"github.com/spf13/cobra"
+)
+
+type createOptions struct {
+	name   string
+	file   string
+	labels []string
+}
+
+func newCreateCommand(dockerCli *client.DockerCli) *cobra.Command {
+	opts := createOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "create [OPTIONS] SECRET FILE|-",
+		Short: i18n.T("Create a secret from a file or STDIN as content"),
+		Args:  cli.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.name = args[0]
+			opts.file = args[1]
+			return runCreate(dockerCli, opts)
+		},
+	}
+	flags := cmd.Flags()
+	flags.StringSliceVarP(&opts.labels, "label", "l", []string{}, i18n.T("Set metadata for a secret"))
+
+	return cmd
+}
+
+func runCreate(dockerCli *client.DockerCli, opts createOptions) error {
+	client := dockerCli.Client()
+	ctx := context.Background()
+
+	var in io.Reader = dockerCli.In()
+	if opts.file != "-" {
+		file, err := os.Open(opts.file)
+		if err != nil {
+			return err
+		}
+		defer file.Close()
+		in = file
+	}
+
+	data, err := ioutil.ReadAll(in)
+	if err != nil {
+		return fmt.Errorf(i18n.T("Error reading content from %q: %v"), opts.file, err)
+	}
+
+	spec := swarm.SecretSpec{
+		Annotations: swarm.Annotations{
+			Name:   opts.name,
+			Labels: runconfigopts.ConvertKVStringsToMap(opts.labels),
+		},
+		Data: data,
+	}
+
+	r, err := client.SecretCreate(ctx, spec)
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintln(dockerCli.Out(), r.ID)
+	return nil
+}
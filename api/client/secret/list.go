@@ -0,0 +1,89 @@
+package secret
+
+import (
+	"fmt"
+	"io"
+	"text/tabwriter"
+	"time"
+
+	"golang.org/x/net/context"
+
+	"github.com/docker/docker/api/client"
+	"github.com/docker/docker/cli"
+	"github.com/docker/docker/cli/i18n"
+	"github.com/docker/docker/opts"
+	"github.com/docker/engine-api/types"
+	"github.com/docker/engine-api/types/swarm"
+	"github.com/docker/go-units"
+	"github.com/spf13/cobra"
+)
+
+const (
+	listItemFmt = "%s\t%s\t%s\t%s\n"
+)
+
+type listOptions struct {
+	quiet  bool
+	filter opts.FilterOpt
+}
+
+func newListCommand(dockerCli *client.DockerCli) *cobra.Command {
+	opts := listOptions{filter: opts.NewFilterOpt()}
+
+	cmd := &cobra.Command{
+		Use:     "ls [OPTIONS]",
+		Aliases: []string{"list"},
+		Short:   i18n.T("List secrets"),
+		Args:    cli.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runList(dockerCli, opts)
+		},
+	}
+	flags := cmd.Flags()
+	flags.BoolVarP(&opts.quiet, "quiet", "q", false, i18n.T("Only display IDs"))
+	flags.VarP(&opts.filter, "filter", "f", i18n.T("Filter output based on conditions provided"))
+
+	return cmd
+}
+
+func runList(dockerCli *client.DockerCli, opts listOptions) error {
+	client := dockerCli.Client()
+	ctx := context.Background()
+
+	secrets, err := client.SecretList(ctx, types.SecretListOptions{Filter: opts.filter.Value()})
+	if err != nil {
+		return err
+	}
+
+	out := dockerCli.Out()
+	if opts.quiet {
+		printQuiet(out, secrets)
+	} else {
+		printTable(out, secrets)
+	}
+	return nil
+}
+
+func printTable(out io.Writer, secrets []swarm.Secret) {
+	writer := tabwriter.NewWriter(out, 0, 4, 2, ' ', 0)
+
+	// Ignore flushing errors
+	defer writer.Flush()
+
+	fmt.Fprintf(writer, listItemFmt, "ID", i18n.T("NAME"), i18n.T("CREATED"), i18n.T("UPDATED"))
+	for _, secret := range secrets {
+		fmt.Fprintf(
+			writer,
+			listItemFmt,
+			secret.ID,
+			secret.Spec.Name,
+			units.HumanDuration(time.Now().UTC().Sub(secret.CreatedAt))+" ago",
+			units.HumanDuration(time.Now().UTC().Sub(secret.UpdatedAt))+" ago")
+	}
+}
+
+func printQuiet(out io.Writer, secrets []swarm.Secret) {
+	for _, secret := range secrets {
+		fmt.Fprintln(out, secret.ID)
+	}
+}
@@ -7,13 +7,14 @@ import (
 
 	"github.com/docker/docker/api/client"
 	"github.com/docker/docker/cli"
+	"github.com/docker/docker/cli/i18n"
 )
 
 // NewServiceCommand returns a cobra command for `service` subcommands
 func NewServiceCommand(dockerCli *client.DockerCli) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "service",
-		Short: "管理 Docker 服务",
+		Short: i18n.T("Manage Docker services"),
 		Args:  cli.NoArgs,
 		Run: func(cmd *cobra.Command, args []string) {
 			fmt.Fprintf(dockerCli.Err(), "\n"+cmd.UsageString())
@@ -24,7 +25,9 @@ func NewServiceCommand(dockerCli *client.DockerCli) *cobra.Command {
 		newInspectCommand(dockerCli),
 		newPSCommand(dockerCli),
 		newListCommand(dockerCli),
+		newLogsCommand(dockerCli),
 		newRemoveCommand(dockerCli),
+		newRollbackCommand(dockerCli),
 		newScaleCommand(dockerCli),
 		newUpdateCommand(dockerCli),
 	)
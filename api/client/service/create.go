@@ -5,6 +5,7 @@ import (
 
 	"github.com/docker/docker/api/client"
 	"github.com/docker/docker/cli"
+	"github.com/docker/docker/cli/i18n"
 	"github.com/docker/engine-api/types"
 	"github.com/spf13/cobra"
 	"golang.org/x/net/context"
@@ -15,7 +16,7 @@ func newCreateCommand(dockerCli *client.DockerCli) *cobra.Command {
 
 	cmd := &cobra.Command{
 		Use:   "create [OPTIONS] IMAGE [COMMAND] [ARG...]",
-		Short: "创建一个新的服务",
+		Short: i18n.T("Create a new service"),
 		Args:  cli.RequiresMinArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			opts.image = args[0]
@@ -26,16 +27,17 @@ func newCreateCommand(dockerCli *client.DockerCli) *cobra.Command {
 		},
 	}
 	flags := cmd.Flags()
-	flags.StringVar(&opts.mode, flagMode, "replicated", "服务类型: 副本(replicated)/全局(global)")
+	flags.StringVar(&opts.mode, flagMode, "replicated", i18n.T("Service mode (replicated or global)"))
 	addServiceFlags(cmd, opts)
 
-	flags.VarP(&opts.labels, flagLabel, "l", "服务自身标签")
-	flags.Var(&opts.containerLabels, flagContainerLabel, "服务中容器的标签")
-	flags.VarP(&opts.env, flagEnv, "e", "设置服务环境变量")
-	flags.Var(&opts.mounts, flagMount, "为服务添加一个挂载项")
-	flags.StringSliceVar(&opts.constraints, flagConstraint, []string{}, "服务节点安放的限制条件")
-	flags.StringSliceVar(&opts.networks, flagNetwork, []string{}, "网络附加信息")
-	flags.VarP(&opts.endpoint.ports, flagPublish, "p", "将服务的一个端口暴露为一个节点端口")
+	flags.VarP(&opts.labels, flagLabel, "l", i18n.T("Service labels"))
+	flags.Var(&opts.containerLabels, flagContainerLabel, i18n.T("Container labels"))
+	flags.VarP(&opts.env, flagEnv, "e", i18n.T("Set environment variables"))
+	flags.Var(&opts.mounts, flagMount, i18n.T("Attach a mount to the service"))
+	flags.StringSliceVar(&opts.constraints, flagConstraint, []string{}, i18n.T("Placement constraints"))
+	flags.StringSliceVar(&opts.networks, flagNetwork, []string{}, i18n.T("Network attachments"))
+	flags.StringSliceVar(&opts.secrets, flagSecret, []string{}, i18n.T("Specify secrets to expose to the service"))
+	flags.VarP(&opts.endpoint.ports, flagPublish, "p", i18n.T("Publish a port as a node port"))
 
 	flags.SetInterspersed(false)
 	return cmd
@@ -1,6 +1,7 @@
 package service
 
 import (
+	"errors"
 	"fmt"
 	"io"
 	"strings"
@@ -11,6 +12,7 @@ import (
 	"github.com/docker/docker/api/client"
 	"github.com/docker/docker/api/client/inspect"
 	"github.com/docker/docker/cli"
+	"github.com/docker/docker/cli/i18n"
 	"github.com/docker/docker/pkg/ioutils"
 	apiclient "github.com/docker/engine-api/client"
 	"github.com/docker/engine-api/types/swarm"
@@ -29,21 +31,21 @@ func newInspectCommand(dockerCli *client.DockerCli) *cobra.Command {
 
 	cmd := &cobra.Command{
 		Use:   "inspect [OPTIONS] SERVICE [SERVICE...]",
-		Short: "显示一个或多个服务的详细信息",
+		Short: i18n.T("Display detailed information on one or more services"),
 		Args:  cli.RequiresMinArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			opts.refs = args
 
 			if opts.pretty && len(opts.format) > 0 {
-				return fmt.Errorf("--format 参数和人工可读格式不兼容")
+				return errors.New(i18n.T("--format is incompatible with human friendly format"))
 			}
 			return runInspect(dockerCli, opts)
 		},
 	}
 
 	flags := cmd.Flags()
-	flags.StringVarP(&opts.format, "format", "f", "", "通过指定的Go语言模板格式化命令输出内容。")
-	flags.BoolVar(&opts.pretty, "pretty", false, "通过人工可读的格式输出命令信息。")
+	flags.StringVarP(&opts.format, "format", "f", "", i18n.T("Format the output using the given go template"))
+	flags.BoolVar(&opts.pretty, "pretty", false, i18n.T("Print the information in a human friendly format."))
 	return cmd
 }
 
@@ -56,7 +58,7 @@ func runInspect(dockerCli *client.DockerCli, opts inspectOptions) error {
 		if err == nil || !apiclient.IsErrServiceNotFound(err) {
 			return service, nil, err
 		}
-		return nil, nil, fmt.Errorf("错误: 没有该服务 %s", ref)
+		return nil, nil, fmt.Errorf(i18n.T("Error: no such service: %s"), ref)
 	}
 
 	if !opts.pretty {
@@ -86,69 +88,73 @@ func printHumanFriendly(out io.Writer, refs []string, getRef inspect.GetRefFunc)
 // TODO: use a template
 func printService(out io.Writer, service swarm.Service) {
 	fmt.Fprintf(out, "ID:\t\t%s\n", service.ID)
-	fmt.Fprintf(out, "名称:\t\t%s\n", service.Spec.Name)
+	fmt.Fprintf(out, i18n.T("Name:\t\t%s\n"), service.Spec.Name)
 	if service.Spec.Labels != nil {
-		fmt.Fprintln(out, "标签:")
+		fmt.Fprintln(out, i18n.T("Labels:"))
 		for k, v := range service.Spec.Labels {
 			fmt.Fprintf(out, " - %s=%s\n", k, v)
 		}
 	}
 
 	if service.Spec.Mode.Global != nil {
-		fmt.Fprintln(out, "模式:\t\t全局")
+		fmt.Fprintln(out, i18n.T("Mode:\t\tGlobal"))
 	} else {
-		fmt.Fprintln(out, "模式:\t\t副本")
+		fmt.Fprintln(out, i18n.T("Mode:\t\tReplicated"))
 		if service.Spec.Mode.Replicated.Replicas != nil {
-			fmt.Fprintf(out, " 副本个数:\t%d\n", *service.Spec.Mode.Replicated.Replicas)
+			fmt.Fprintf(out, i18n.T(" Replicas:\t%d\n"), *service.Spec.Mode.Replicated.Replicas)
 		}
 	}
 
 	if service.UpdateStatus.State != "" {
-		fmt.Fprintln(out, "更新状态:")
-		fmt.Fprintf(out, " 状态:\t\t%s\n", service.UpdateStatus.State)
-		fmt.Fprintf(out, " 启动时间:\t%s 之前\n", strings.ToLower(units.HumanDuration(time.Since(service.UpdateStatus.StartedAt))))
+		fmt.Fprintln(out, i18n.T("Update status:"))
+		fmt.Fprintf(out, i18n.T(" State:\t\t%s\n"), service.UpdateStatus.State)
+		fmt.Fprintf(out, i18n.T(" Started:\t%s ago\n"), strings.ToLower(units.HumanDuration(time.Since(service.UpdateStatus.StartedAt))))
 		if service.UpdateStatus.State == swarm.UpdateStateCompleted {
-			fmt.Fprintf(out, " 完成时间:\t%s 之前\n", strings.ToLower(units.HumanDuration(time.Since(service.UpdateStatus.CompletedAt))))
+			fmt.Fprintf(out, i18n.T(" Completed:\t%s ago\n"), strings.ToLower(units.HumanDuration(time.Since(service.UpdateStatus.CompletedAt))))
 		}
-		fmt.Fprintf(out, " 更新消息:\t%s\n", service.UpdateStatus.Message)
+		fmt.Fprintf(out, i18n.T(" Message:\t%s\n"), service.UpdateStatus.Message)
 	}
 
-	fmt.Fprintln(out, "放置策略:")
+	fmt.Fprintln(out, i18n.T("Placement:"))
 	if service.Spec.TaskTemplate.Placement != nil && len(service.Spec.TaskTemplate.Placement.Constraints) > 0 {
-		ioutils.FprintfIfNotEmpty(out, " 限制\t: %s\n", strings.Join(service.Spec.TaskTemplate.Placement.Constraints, ", "))
+		ioutils.FprintfIfNotEmpty(out, i18n.T(" Constraints\t: %s\n"), strings.Join(service.Spec.TaskTemplate.Placement.Constraints, ", "))
 	}
 	if service.Spec.UpdateConfig != nil {
-		fmt.Fprintf(out, "更新配置:\n")
-		fmt.Fprintf(out, " 并行数:\t%d\n", service.Spec.UpdateConfig.Parallelism)
+		fmt.Fprint(out, i18n.T("UpdateConfig:\n"))
+		fmt.Fprintf(out, i18n.T(" Parallelism:\t%d\n"), service.Spec.UpdateConfig.Parallelism)
 		if service.Spec.UpdateConfig.Delay.Nanoseconds() > 0 {
-			fmt.Fprintf(out, " 更新延迟:\t\t%s\n", service.Spec.UpdateConfig.Delay)
+			fmt.Fprintf(out, i18n.T(" Delay:\t\t%s\n"), service.Spec.UpdateConfig.Delay)
 		}
-		fmt.Fprintf(out, " 出错重启策略:\t%s\n", service.Spec.UpdateConfig.FailureAction)
+		fmt.Fprintf(out, i18n.T(" On failure:\t%s\n"), service.Spec.UpdateConfig.FailureAction)
+		if service.Spec.UpdateConfig.Monitor.Nanoseconds() > 0 {
+			fmt.Fprintf(out, i18n.T(" Monitoring Period:\t%s\n"), service.Spec.UpdateConfig.Monitor)
+		}
+		fmt.Fprintf(out, i18n.T(" Max failure ratio:\t%g\n"), service.Spec.UpdateConfig.MaxFailureRatio)
 	}
 
-	fmt.Fprintf(out, "容器配置:\n")
+	fmt.Fprint(out, i18n.T("ContainerSpec:\n"))
 	printContainerSpec(out, service.Spec.TaskTemplate.ContainerSpec)
 
 	resources := service.Spec.TaskTemplate.Resources
 	if resources != nil {
-		fmt.Fprintln(out, "资源:")
+		fmt.Fprintln(out, i18n.T("Resources:"))
 		printResources := func(out io.Writer, requirement string, r *swarm.Resources) {
 			if r == nil || (r.MemoryBytes == 0 && r.NanoCPUs == 0) {
 				return
 			}
 			fmt.Fprintf(out, " %s:\n", requirement)
 			if r.NanoCPUs != 0 {
-				fmt.Fprintf(out, "  CPU资源:\t\t%g\n", float64(r.NanoCPUs)/1e9)
+				fmt.Fprintf(out, i18n.T("  CPU:\t\t%g\n"), float64(r.NanoCPUs)/1e9)
 			}
 			if r.MemoryBytes != 0 {
-				fmt.Fprintf(out, "  内存资源:\t%s\n", units.BytesSize(float64(r.MemoryBytes)))
+				fmt.Fprintf(out, i18n.T("  Memory:\t%s\n"), units.BytesSize(float64(r.MemoryBytes)))
 			}
 		}
-		printResources(out, "资源预留", resources.Reservations)
-		printResources(out, "资源限制", resources.Limits)
+		printResources(out, i18n.T("Reservations"), resources.Reservations)
+		printResources(out, i18n.T("Limits"), resources.Limits)
 	}
 	if len(service.Spec.Networks) > 0 {
-		fmt.Fprintf(out, "网络:")
+		fmt.Fprint(out, i18n.T("Networks:"))
 		for _, n := range service.Spec.Networks {
 			fmt.Fprintf(out, " %s", n.Target)
 		}
@@ -156,33 +162,58 @@ func printService(out io.Writer, service swarm.Service) {
 	}
 
 	if len(service.Endpoint.Ports) > 0 {
-		fmt.Fprintln(out, "端口:")
+		fmt.Fprintln(out, i18n.T("Ports:"))
 		for _, port := range service.Endpoint.Ports {
-			ioutils.FprintfIfNotEmpty(out, " 名称 = %s\n", port.Name)
-			fmt.Fprintf(out, " 协议 = %s\n", port.Protocol)
-			fmt.Fprintf(out, " 目标端口 = %d\n", port.TargetPort)
-			fmt.Fprintf(out, " 暴露端口 = %d\n", port.PublishedPort)
+			ioutils.FprintfIfNotEmpty(out, i18n.T(" Name = %s\n"), port.Name)
+			fmt.Fprintf(out, i18n.T(" Protocol = %s\n"), port.Protocol)
+			fmt.Fprintf(out, i18n.T(" TargetPort = %d\n"), port.TargetPort)
+			fmt.Fprintf(out, i18n.T(" PublishedPort = %d\n"), port.PublishedPort)
 		}
 	}
 }
 
 func printContainerSpec(out io.Writer, containerSpec swarm.ContainerSpec) {
-	fmt.Fprintf(out, " 镜像:\t\t%s\n", containerSpec.Image)
+	fmt.Fprintf(out, i18n.T(" Image:\t\t%s\n"), containerSpec.Image)
 	if len(containerSpec.Args) > 0 {
-		fmt.Fprintf(out, " 参数:\t\t%s\n", strings.Join(containerSpec.Args, " "))
+		fmt.Fprintf(out, i18n.T(" Args:\t\t%s\n"), strings.Join(containerSpec.Args, " "))
 	}
 	if len(containerSpec.Env) > 0 {
-		fmt.Fprintf(out, " 环境变量:\t\t%s\n", strings.Join(containerSpec.Env, " "))
+		fmt.Fprintf(out, i18n.T(" Env:\t\t%s\n"), strings.Join(containerSpec.Env, " "))
 	}
-	ioutils.FprintfIfNotEmpty(out, " 目录\t\t%s\n", containerSpec.Dir)
-	ioutils.FprintfIfNotEmpty(out, " 用户\t\t%s\n", containerSpec.User)
+	ioutils.FprintfIfNotEmpty(out, i18n.T(" Dir\t\t%s\n"), containerSpec.Dir)
+	ioutils.FprintfIfNotEmpty(out, i18n.T(" User\t\t%s\n"), containerSpec.User)
 	if len(containerSpec.Mounts) > 0 {
-		fmt.Fprintln(out, " 挂载:")
+		fmt.Fprintln(out, i18n.T(" Mounts:"))
 		for _, v := range containerSpec.Mounts {
-			fmt.Fprintf(out, "  目标地址 = %s\n", v.Target)
-			fmt.Fprintf(out, "  源地址 = %s\n", v.Source)
-			fmt.Fprintf(out, "  只读 = %v\n", v.ReadOnly)
-			fmt.Fprintf(out, "  挂载类型 = %v\n", v.Type)
+			fmt.Fprintf(out, i18n.T("  Target = %s\n"), v.Target)
+			fmt.Fprintf(out, i18n.T("  Source = %s\n"), v.Source)
+			fmt.Fprintf(out, i18n.T("  ReadOnly = %v\n"), v.ReadOnly)
+			fmt.Fprintf(out, i18n.T("  Type = %v\n"), v.Type)
+		}
+	}
+	if len(containerSpec.Secrets) > 0 {
+		fmt.Fprintln(out, i18n.T(" Secrets:"))
+		for _, s := range containerSpec.Secrets {
+			fmt.Fprintf(out, "  %s (%s)\n", s.SecretName, s.SecretID)
+		}
+	}
+	if hc := containerSpec.Healthcheck; hc != nil {
+		fmt.Fprintln(out, i18n.T(" Healthcheck:"))
+		if len(hc.Test) > 0 && hc.Test[0] == "NONE" {
+			fmt.Fprintln(out, i18n.T("  Disabled"))
+			return
+		}
+		if len(hc.Test) > 1 {
+			fmt.Fprintf(out, i18n.T("  Test = %s\n"), strings.Join(hc.Test[1:], " "))
+		}
+		if hc.Interval > 0 {
+			fmt.Fprintf(out, i18n.T("  Interval = %s\n"), hc.Interval)
+		}
+		if hc.Timeout > 0 {
+			fmt.Fprintf(out, i18n.T("  Timeout = %s\n"), hc.Timeout)
+		}
+		if hc.Retries > 0 {
+			fmt.Fprintf(out, i18n.T("  Retries = %d\n"), hc.Retries)
 		}
 	}
 }
@@ -8,6 +8,7 @@ import (
 
 	"github.com/docker/docker/api/client"
 	"github.com/docker/docker/cli"
+	"github.com/docker/docker/cli/i18n"
 	"github.com/docker/docker/opts"
 	"github.com/docker/docker/pkg/stringid"
 	"github.com/docker/engine-api/types"
@@ -32,7 +33,7 @@ func newListCommand(dockerCli *client.DockerCli) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:     "ls [OPTIONS]",
 		Aliases: []string{"list"},
-		Short:   "罗列所有服务",
+		Short:   i18n.T("List services"),
 		Args:    cli.NoArgs,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			return runList(dockerCli, opts)
@@ -40,8 +41,8 @@ func newListCommand(dockerCli *client.DockerCli) *cobra.Command {
 	}
 
 	flags := cmd.Flags()
-	flags.BoolVarP(&opts.quiet, "quiet", "q", false, "仅显示ID")
-	flags.VarP(&opts.filter, "filter", "f", "根据指定条件过滤命令输出内容")
+	flags.BoolVarP(&opts.quiet, "quiet", "q", false, i18n.T("Only display IDs"))
+	flags.VarP(&opts.filter, "filter", "f", i18n.T("Filter output based on conditions provided"))
 
 	return cmd
 }
@@ -98,7 +99,7 @@ func printTable(out io.Writer, services []swarm.Service, running map[string]int)
 	// Ignore flushing errors
 	defer writer.Flush()
 
-	fmt.Fprintf(writer, listItemFmt, "ID", "命令", "副本数", "镜像", "启动命令")
+	fmt.Fprintf(writer, listItemFmt, "ID", i18n.T("NAME"), i18n.T("REPLICAS"), i18n.T("IMAGE"), i18n.T("COMMAND"))
 	for _, service := range services {
 		replicas := ""
 		if service.Spec.Mode.Replicated != nil && service.Spec.Mode.Replicated.Replicas != nil {
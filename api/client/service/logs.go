@@ -0,0 +1,63 @@
+package service
+
+import (
+	"golang.org/x/net/context"
+
+	"github.com/docker/docker/api/client"
+	"github.com/docker/docker/cli"
+	"github.com/docker/docker/cli/i18n"
+	"github.com/docker/docker/pkg/stdcopy"
+	"github.com/docker/engine-api/types"
+	"github.com/spf13/cobra"
+)
+
+type logsOptions struct {
+	follow     bool
+	since      string
+	timestamps bool
+	tail       string
+
+	service string
+}
+
+func newLogsCommand(dockerCli *client.DockerCli) *cobra.Command {
+	var opts logsOptions
+
+	cmd := &cobra.Command{
+		Use:   "logs [OPTIONS] SERVICE",
+		Short: i18n.T("Fetch the logs of a service"),
+		Args:  cli.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.service = args[0]
+			return runLogs(dockerCli, &opts)
+		},
+	}
+
+	flags := cmd.Flags()
+	flags.BoolVarP(&opts.follow, "follow", "f", false, i18n.T("Follow log output"))
+	flags.StringVar(&opts.since, "since", "", i18n.T("Show logs since timestamp"))
+	flags.BoolVarP(&opts.timestamps, "timestamps", "t", false, i18n.T("Show timestamps"))
+	flags.StringVar(&opts.tail, "tail", "all", i18n.T("Number of lines to show from the end of the logs"))
+	return cmd
+}
+
+func runLogs(dockerCli *client.DockerCli, opts *logsOptions) error {
+	ctx := context.Background()
+
+	options := types.ContainerLogsOptions{
+		ShowStdout: true,
+		ShowStderr: true,
+		Since:      opts.since,
+		Timestamps: opts.timestamps,
+		Follow:     opts.follow,
+		Tail:       opts.tail,
+	}
+	responseBody, err := dockerCli.Client().ServiceLogs(ctx, opts.service, options)
+	if err != nil {
+		return err
+	}
+	defer responseBody.Close()
+
+	_, err = stdcopy.StdCopy(dockerCli.Out(), dockerCli.Err(), responseBody)
+	return err
+}
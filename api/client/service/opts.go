@@ -2,14 +2,17 @@ package service
 
 import (
 	"encoding/csv"
+	"errors"
 	"fmt"
 	"math/big"
 	"strconv"
 	"strings"
 	"time"
 
+	"github.com/docker/docker/cli/i18n"
 	"github.com/docker/docker/opts"
 	runconfigopts "github.com/docker/docker/runconfig/opts"
+	"github.com/docker/engine-api/types/container"
 	"github.com/docker/engine-api/types/swarm"
 	"github.com/docker/go-connections/nat"
 	units "github.com/docker/go-units"
@@ -49,11 +52,11 @@ func (c *nanoCPUs) String() string {
 func (c *nanoCPUs) Set(value string) error {
 	cpu, ok := new(big.Rat).SetString(value)
 	if !ok {
-		return fmt.Errorf("Failed to parse %v as a rational number", value)
+		return fmt.Errorf(i18n.T("Failed to parse %v as a rational number"), value)
 	}
 	nano := cpu.Mul(cpu, big.NewRat(1e9, 1))
 	if !nano.IsInt() {
-		return fmt.Errorf("value is too precise")
+		return errors.New(i18n.T("value is too precise"))
 	}
 	*c = nanoCPUs(nano.Num().Int64())
 	return nil
@@ -189,7 +192,7 @@ func (m *MountOpt) Set(value string) error {
 		}
 
 		if len(parts) != 2 {
-			return fmt.Errorf("invalid field '%s' must be a key=value pair", field)
+			return fmt.Errorf(i18n.T("invalid field '%s' must be a key=value pair"), field)
 		}
 
 		value := parts[1]
@@ -203,14 +206,14 @@ func (m *MountOpt) Set(value string) error {
 		case "readonly", "ro":
 			mount.ReadOnly, err = strconv.ParseBool(value)
 			if err != nil {
-				return fmt.Errorf("无效值 %s: %s", key, value)
+				return fmt.Errorf(i18n.T("invalid value for %s: %s"), key, value)
 			}
 		case "bind-propagation":
 			bindOptions().Propagation = swarm.MountPropagation(strings.ToLower(value))
 		case "volume-nocopy":
 			volumeOptions().NoCopy, err = strconv.ParseBool(value)
 			if err != nil {
-				return fmt.Errorf("invalid value for populate: %s", value)
+				return fmt.Errorf(i18n.T("invalid value for populate: %s"), value)
 			}
 		case "volume-label":
 			setValueOnMap(volumeOptions().Labels, value)
@@ -222,27 +225,27 @@ func (m *MountOpt) Set(value string) error {
 			}
 			setValueOnMap(volumeOptions().DriverConfig.Options, value)
 		default:
-			return fmt.Errorf("unexpected key '%s' in '%s'", key, field)
+			return fmt.Errorf(i18n.T("unexpected key '%s' in '%s'"), key, field)
 		}
 	}
 
 	if mount.Type == "" {
-		return fmt.Errorf("挂载类型不能为空")
+		return errors.New(i18n.T("type is required"))
 	}
 
 	if mount.Target == "" {
-		return fmt.Errorf("挂载目的地址不能为空")
+		return errors.New(i18n.T("target is required"))
 	}
 
 	if mount.VolumeOptions != nil && mount.Source == "" {
-		return fmt.Errorf("当指定选项 volume-* 时，挂载的源地址不能为空")
+		return errors.New(i18n.T("source is required when specifying volume-* options"))
 	}
 
 	if mount.Type == swarm.MountTypeBind && mount.VolumeOptions != nil {
-		return fmt.Errorf("不能将 'volume-*' 选项和挂载类型 '%s' 混用", swarm.MountTypeBind)
+		return fmt.Errorf(i18n.T("cannot mix 'volume-*' options with mount type '%s'"), swarm.MountTypeBind)
 	}
 	if mount.Type == swarm.MountTypeVolume && mount.BindOptions != nil {
-		return fmt.Errorf("不能将 'bind-*' 选项和挂载类型 '%s' 混用", swarm.MountTypeVolume)
+		return fmt.Errorf(i18n.T("cannot mix 'bind-*' options with mount type '%s'"), swarm.MountTypeVolume)
 	}
 
 	m.values = append(m.values, mount)
@@ -270,9 +273,11 @@ func (m *MountOpt) Value() []swarm.Mount {
 }
 
 type updateOptions struct {
-	parallelism uint64
-	delay       time.Duration
-	onFailure   string
+	parallelism     uint64
+	delay           time.Duration
+	monitor         time.Duration
+	onFailure       string
+	maxFailureRatio float32
 }
 
 type resourceOptions struct {
@@ -319,6 +324,64 @@ func convertNetworks(networks []string) []swarm.NetworkAttachmentConfig {
 	return nets
 }
 
+func convertSecrets(secrets []string) []*swarm.SecretReference {
+	refs := []*swarm.SecretReference{}
+	for _, secret := range secrets {
+		refs = append(refs, &swarm.SecretReference{SecretName: secret})
+	}
+	return refs
+}
+
+type healthCheckOptions struct {
+	cmd           string
+	interval      DurationOpt
+	timeout       DurationOpt
+	retries       int
+	noHealthcheck bool
+}
+
+func (opts *healthCheckOptions) toHealthConfig() (*container.HealthConfig, error) {
+	var healthConfig *container.HealthConfig
+	haveHealthSettings := opts.cmd != "" ||
+		opts.interval.Value() != nil ||
+		opts.timeout.Value() != nil ||
+		opts.retries != 0
+	if opts.noHealthcheck {
+		if haveHealthSettings {
+			return nil, fmt.Errorf(i18n.T("--%s conflicts with --health-* options"), flagNoHealthcheck)
+		}
+		healthConfig = &container.HealthConfig{Test: []string{"NONE"}}
+	} else if haveHealthSettings {
+		var test []string
+		if opts.cmd != "" {
+			test = []string{"CMD-SHELL", opts.cmd}
+		}
+		var interval, timeout time.Duration
+		if ptr := opts.interval.Value(); ptr != nil {
+			interval = *ptr
+		}
+		if ptr := opts.timeout.Value(); ptr != nil {
+			timeout = *ptr
+		}
+		if interval < 0 {
+			return nil, fmt.Errorf(i18n.T("--%s cannot be negative"), flagHealthInterval)
+		}
+		if timeout < 0 {
+			return nil, fmt.Errorf(i18n.T("--%s cannot be negative"), flagHealthTimeout)
+		}
+		if opts.retries < 0 {
+			return nil, fmt.Errorf(i18n.T("--%s cannot be negative"), flagHealthRetries)
+		}
+		healthConfig = &container.HealthConfig{
+			Test:     test,
+			Interval: interval,
+			Timeout:  timeout,
+			Retries:  opts.retries,
+		}
+	}
+	return healthConfig, nil
+}
+
 type endpointOptions struct {
 	mode  string
 	ports opts.ListOpts
@@ -383,7 +446,7 @@ func ValidatePort(value string) (string, error) {
 	portMappings, err := nat.ParsePortSpec(value)
 	for _, portMapping := range portMappings {
 		if portMapping.Binding.HostIP != "" {
-			return "", fmt.Errorf("服务不支持宿主机IP.")
+			return "", errors.New(i18n.T("HostIP is not supported by a service."))
 		}
 	}
 	return value, err
@@ -410,11 +473,14 @@ type serviceOptions struct {
 	constraints   []string
 	update        updateOptions
 	networks      []string
+	secrets       []string
 	endpoint      endpointOptions
 
 	registryAuth bool
 
 	logDriver logDriverOptions
+
+	healthcheck healthCheckOptions
 }
 
 func newServiceOptions() *serviceOptions {
@@ -432,6 +498,11 @@ func newServiceOptions() *serviceOptions {
 func (opts *serviceOptions) ToService() (swarm.ServiceSpec, error) {
 	var service swarm.ServiceSpec
 
+	healthConfig, err := opts.healthcheck.toHealthConfig()
+	if err != nil {
+		return service, err
+	}
+
 	service = swarm.ServiceSpec{
 		Annotations: swarm.Annotations{
 			Name:   opts.name,
@@ -447,6 +518,8 @@ func (opts *serviceOptions) ToService() (swarm.ServiceSpec, error) {
 				User:            opts.user,
 				Mounts:          opts.mounts.Value(),
 				StopGracePeriod: opts.stopGrace.Value(),
+				Healthcheck:     healthConfig,
+				Secrets:         convertSecrets(opts.secrets),
 			},
 			Resources:     opts.resources.ToResourceRequirements(),
 			RestartPolicy: opts.restartPolicy.ToRestartPolicy(),
@@ -457,9 +530,11 @@ func (opts *serviceOptions) ToService() (swarm.ServiceSpec, error) {
 		},
 		Mode: swarm.ServiceMode{},
 		UpdateConfig: &swarm.UpdateConfig{
-			Parallelism:   opts.update.parallelism,
-			Delay:         opts.update.delay,
-			FailureAction: opts.update.onFailure,
+			Parallelism:     opts.update.parallelism,
+			Delay:           opts.update.delay,
+			Monitor:         opts.update.monitor,
+			FailureAction:   opts.update.onFailure,
+			MaxFailureRatio: opts.update.maxFailureRatio,
 		},
 		Networks:     convertNetworks(opts.networks),
 		EndpointSpec: opts.endpoint.ToEndpointSpec(),
@@ -468,7 +543,7 @@ func (opts *serviceOptions) ToService() (swarm.ServiceSpec, error) {
 	switch opts.mode {
 	case "global":
 		if opts.replicas.Value() != nil {
-			return service, fmt.Errorf("副本数(replicas)只能被使用于副本(replicated)模式")
+			return service, errors.New(i18n.T("replicas can only be used with replicated mode"))
 		}
 
 		service.Mode.Global = &swarm.GlobalService{}
@@ -477,7 +552,7 @@ func (opts *serviceOptions) ToService() (swarm.ServiceSpec, error) {
 			Replicas: opts.replicas.Value(),
 		}
 	default:
-		return service, fmt.Errorf("未知的服务模式: %s", opts.mode)
+		return service, fmt.Errorf(i18n.T("Unknown mode: %s"), opts.mode)
 	}
 	return service, nil
 }
@@ -486,74 +561,92 @@ func (opts *serviceOptions) ToService() (swarm.ServiceSpec, error) {
 // Any flags that are not common are added separately in the individual command
 func addServiceFlags(cmd *cobra.Command, opts *serviceOptions) {
 	flags := cmd.Flags()
-	flags.StringVar(&opts.name, flagName, "", "服务名")
+	flags.StringVar(&opts.name, flagName, "", i18n.T("Service name"))
+
+	flags.StringVarP(&opts.workdir, "workdir", "w", "", i18n.T("Working directory inside the container"))
+	flags.StringVarP(&opts.user, flagUser, "u", "", i18n.T("Username or UID (format: <name|uid>[:<group|gid>])"))
 
-	flags.StringVarP(&opts.workdir, "workdir", "w", "", "容器内部的工作目录")
-	flags.StringVarP(&opts.user, flagUser, "u", "", "用户名UID (格式: <用户名|用户名ID>[:<组|组ID>])")
+	flags.Var(&opts.resources.limitCPU, flagLimitCPU, i18n.T("Limit CPUs"))
+	flags.Var(&opts.resources.limitMemBytes, flagLimitMemory, i18n.T("Limit Memory"))
+	flags.Var(&opts.resources.resCPU, flagReserveCPU, i18n.T("Reserve CPUs"))
+	flags.Var(&opts.resources.resMemBytes, flagReserveMemory, i18n.T("Reserve Memory"))
+	flags.Var(&opts.stopGrace, flagStopGracePeriod, i18n.T("Time to wait before force killing a container"))
 
-	flags.Var(&opts.resources.limitCPU, flagLimitCPU, "限制CPU使用量")
-	flags.Var(&opts.resources.limitMemBytes, flagLimitMemory, "限制内存使用量")
-	flags.Var(&opts.resources.resCPU, flagReserveCPU, "预留CPU使用量")
-	flags.Var(&opts.resources.resMemBytes, flagReserveMemory, "预留内存使用量")
-	flags.Var(&opts.stopGrace, flagStopGracePeriod, "强制终止容器前的等待时间")
+	flags.Var(&opts.replicas, flagReplicas, i18n.T("Number of tasks"))
 
-	flags.Var(&opts.replicas, flagReplicas, "服务内任务数量")
+	flags.StringVar(&opts.restartPolicy.condition, flagRestartCondition, "", i18n.T("Restart when condition is met (none, on-failure, or any)"))
+	flags.Var(&opts.restartPolicy.delay, flagRestartDelay, i18n.T("Delay between restart attempts"))
+	flags.Var(&opts.restartPolicy.maxAttempts, flagRestartMaxAttempts, i18n.T("Maximum number of restarts before giving up"))
+	flags.Var(&opts.restartPolicy.window, flagRestartWindow, i18n.T("Window used to evaluate the restart policy"))
 
-	flags.StringVar(&opts.restartPolicy.condition, flagRestartCondition, "", "条件满足时的重启策略:none(无), on-failure(失败时重启), 或者其他)")
-	flags.Var(&opts.restartPolicy.delay, flagRestartDelay, "两次重启之间的延时")
-	flags.Var(&opts.restartPolicy.maxAttempts, flagRestartMaxAttempts, "放弃重启前的最大次数")
-	flags.Var(&opts.restartPolicy.window, flagRestartWindow, "评估重启策略的窗口时间")
+	flags.Uint64Var(&opts.update.parallelism, flagUpdateParallelism, 1, i18n.T("Maximum number of tasks updated simultaneously (0 to update all at once)"))
+	flags.DurationVar(&opts.update.delay, flagUpdateDelay, time.Duration(0), i18n.T("Delay between updates"))
+	flags.DurationVar(&opts.update.monitor, flagUpdateMonitor, time.Duration(0), i18n.T("Duration after each task update to monitor for failure"))
+	flags.StringVar(&opts.update.onFailure, flagUpdateFailureAction, "pause", i18n.T("Action on update failure (pause|continue|rollback)"))
+	flags.Float32Var(&opts.update.maxFailureRatio, flagUpdateMaxFailureRatio, 0, i18n.T("Failure rate to tolerate during an update"))
 
-	flags.Uint64Var(&opts.update.parallelism, flagUpdateParallelism, 1, "并发更新任务时的最大数量(0代表立即更新所有任务)")
-	flags.DurationVar(&opts.update.delay, flagUpdateDelay, time.Duration(0), "两次更新之间的延时")
-	flags.StringVar(&opts.update.onFailure, flagUpdateFailureAction, "pause", "更新失败时的策略: pause(暂停)|continue(继续)")
+	flags.StringVar(&opts.endpoint.mode, flagEndpointMode, "", i18n.T("Endpoint mode (vip or dnsrr)"))
 
-	flags.StringVar(&opts.endpoint.mode, flagEndpointMode, "", "网络模式: vip(虚拟IP)|dnsrr(DNS轮询)")
+	flags.BoolVar(&opts.registryAuth, flagRegistryAuth, false, i18n.T("Send registry authentication details to swarm agents"))
 
-	flags.BoolVar(&opts.registryAuth, flagRegistryAuth, false, "向Swarm集群中节点上的代理模块发送注册认证信息")
+	flags.StringVar(&opts.logDriver.name, flagLogDriver, "", i18n.T("Logging driver for service"))
+	flags.Var(&opts.logDriver.opts, flagLogOpt, i18n.T("Log driver options"))
 
-	flags.StringVar(&opts.logDriver.name, flagLogDriver, "", "服务的日志驱动")
-	flags.Var(&opts.logDriver.opts, flagLogOpt, "日志驱动选项")
+	flags.StringVar(&opts.healthcheck.cmd, flagHealthCmd, "", i18n.T("Command to run to check health"))
+	flags.Var(&opts.healthcheck.interval, flagHealthInterval, i18n.T("Time between running the check"))
+	flags.Var(&opts.healthcheck.timeout, flagHealthTimeout, i18n.T("Maximum time to allow one check to run"))
+	flags.IntVar(&opts.healthcheck.retries, flagHealthRetries, 0, i18n.T("Consecutive failures needed to report unhealthy"))
+	flags.BoolVar(&opts.healthcheck.noHealthcheck, flagNoHealthcheck, false, i18n.T("Disable any container-specified HEALTHCHECK"))
 }
 
 const (
-	flagConstraint           = "constraint"
-	flagConstraintRemove     = "constraint-rm"
-	flagConstraintAdd        = "constraint-add"
-	flagContainerLabel       = "container-label"
-	flagContainerLabelRemove = "container-label-rm"
-	flagContainerLabelAdd    = "container-label-add"
-	flagEndpointMode         = "endpoint-mode"
-	flagEnv                  = "env"
-	flagEnvRemove            = "env-rm"
-	flagEnvAdd               = "env-add"
-	flagLabel                = "label"
-	flagLabelRemove          = "label-rm"
-	flagLabelAdd             = "label-add"
-	flagLimitCPU             = "limit-cpu"
-	flagLimitMemory          = "limit-memory"
-	flagMode                 = "mode"
-	flagMount                = "mount"
-	flagMountRemove          = "mount-rm"
-	flagMountAdd             = "mount-add"
-	flagName                 = "name"
-	flagNetwork              = "network"
-	flagPublish              = "publish"
-	flagPublishRemove        = "publish-rm"
-	flagPublishAdd           = "publish-add"
-	flagReplicas             = "replicas"
-	flagReserveCPU           = "reserve-cpu"
-	flagReserveMemory        = "reserve-memory"
-	flagRestartCondition     = "restart-condition"
-	flagRestartDelay         = "restart-delay"
-	flagRestartMaxAttempts   = "restart-max-attempts"
-	flagRestartWindow        = "restart-window"
-	flagStopGracePeriod      = "stop-grace-period"
-	flagUpdateDelay          = "update-delay"
-	flagUpdateFailureAction  = "update-failure-action"
-	flagUpdateParallelism    = "update-parallelism"
-	flagUser                 = "user"
-	flagRegistryAuth         = "with-registry-auth"
-	flagLogDriver            = "log-driver"
-	flagLogOpt               = "log-opt"
+	flagConstraint            = "constraint"
+	flagConstraintRemove      = "constraint-rm"
+	flagConstraintAdd         = "constraint-add"
+	flagContainerLabel        = "container-label"
+	flagContainerLabelRemove  = "container-label-rm"
+	flagContainerLabelAdd     = "container-label-add"
+	flagEndpointMode          = "endpoint-mode"
+	flagEnv                   = "env"
+	flagEnvRemove             = "env-rm"
+	flagEnvAdd                = "env-add"
+	flagHealthCmd             = "health-cmd"
+	flagHealthInterval        = "health-interval"
+	flagHealthRetries         = "health-retries"
+	flagHealthTimeout         = "health-timeout"
+	flagLabel                 = "label"
+	flagLabelRemove           = "label-rm"
+	flagLabelAdd              = "label-add"
+	flagLimitCPU              = "limit-cpu"
+	flagLimitMemory           = "limit-memory"
+	flagMode                  = "mode"
+	flagMount                 = "mount"
+	flagMountRemove           = "mount-rm"
+	flagMountAdd              = "mount-add"
+	flagName                  = "name"
+	flagNetwork               = "network"
+	flagNoHealthcheck         = "no-healthcheck"
+	flagPublish               = "publish"
+	flagPublishRemove         = "publish-rm"
+	flagPublishAdd            = "publish-add"
+	flagReplicas              = "replicas"
+	flagReserveCPU            = "reserve-cpu"
+	flagReserveMemory         = "reserve-memory"
+	flagSecret                = "secret"
+	flagSecretAdd             = "secret-add"
+	flagSecretRemove          = "secret-rm"
+	flagRestartCondition      = "restart-condition"
+	flagRestartDelay          = "restart-delay"
+	flagRestartMaxAttempts    = "restart-max-attempts"
+	flagRestartWindow         = "restart-window"
+	flagStopGracePeriod       = "stop-grace-period"
+	flagUpdateDelay           = "update-delay"
+	flagUpdateFailureAction   = "update-failure-action"
+	flagUpdateMaxFailureRatio = "update-max-failure-ratio"
+	flagUpdateMonitor         = "update-monitor"
+	flagUpdateParallelism     = "update-parallelism"
+	flagUser                  = "user"
+	flagRegistryAuth          = "with-registry-auth"
+	flagLogDriver             = "log-driver"
+	flagLogOpt                = "log-opt"
 )
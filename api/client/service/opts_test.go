@@ -4,6 +4,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/docker/docker/cli/i18n"
 	"github.com/docker/docker/pkg/testutil/assert"
 	"github.com/docker/engine-api/types/swarm"
 )
@@ -107,21 +108,33 @@ func TestMountOptDefaultType(t *testing.T) {
 }
 
 func TestMountOptSetErrorNoTarget(t *testing.T) {
+	i18n.SetLocale(i18n.SourceLocale)
+	defer i18n.SetLocale("")
+
 	var mount MountOpt
 	assert.Error(t, mount.Set("type=volume,source=/foo"), "target is required")
 }
 
 func TestMountOptSetErrorInvalidKey(t *testing.T) {
+	i18n.SetLocale(i18n.SourceLocale)
+	defer i18n.SetLocale("")
+
 	var mount MountOpt
 	assert.Error(t, mount.Set("type=volume,bogus=foo"), "unexpected key 'bogus'")
 }
 
 func TestMountOptSetErrorInvalidField(t *testing.T) {
+	i18n.SetLocale(i18n.SourceLocale)
+	defer i18n.SetLocale("")
+
 	var mount MountOpt
 	assert.Error(t, mount.Set("type=volume,bogus"), "invalid field 'bogus'")
 }
 
 func TestMountOptSetErrorInvalidReadOnly(t *testing.T) {
+	i18n.SetLocale(i18n.SourceLocale)
+	defer i18n.SetLocale("")
+
 	var mount MountOpt
 	assert.Error(t, mount.Set("type=volume,readonly=no"), "invalid value for readonly: no")
 	assert.Error(t, mount.Set("type=volume,readonly=invalid"), "invalid value for readonly: invalid")
@@ -146,6 +159,9 @@ func TestMountOptDefaultEnableReadOnly(t *testing.T) {
 }
 
 func TestMountOptVolumeNoCopy(t *testing.T) {
+	i18n.SetLocale(i18n.SourceLocale)
+	defer i18n.SetLocale("")
+
 	var m MountOpt
 	assert.Error(t, m.Set("type=volume,target=/foo,volume-nocopy"), "source is required")
 
@@ -170,7 +186,47 @@ func TestMountOptVolumeNoCopy(t *testing.T) {
 }
 
 func TestMountOptTypeConflict(t *testing.T) {
+	i18n.SetLocale(i18n.SourceLocale)
+	defer i18n.SetLocale("")
+
 	var m MountOpt
 	assert.Error(t, m.Set("type=bind,target=/foo,source=/foo,volume-nocopy=true"), "cannot mix")
 	assert.Error(t, m.Set("type=volume,target=/foo,source=/foo,bind-propagation=rprivate"), "cannot mix")
 }
+
+func TestHealthCheckOptionsToHealthConfig(t *testing.T) {
+	dur := time.Second
+	opt := healthCheckOptions{
+		cmd:      "curl",
+		interval: DurationOpt{value: &dur},
+		timeout:  DurationOpt{value: &dur},
+		retries:  10,
+	}
+	config, err := opt.toHealthConfig()
+	assert.NilError(t, err)
+	assert.EqualStringSlice(t, config.Test, []string{"CMD-SHELL", "curl"})
+	assert.Equal(t, config.Interval, time.Second)
+	assert.Equal(t, config.Timeout, time.Second)
+	assert.Equal(t, config.Retries, 10)
+}
+
+func TestHealthCheckOptionsToHealthConfigNoHealthcheck(t *testing.T) {
+	opt := healthCheckOptions{
+		noHealthcheck: true,
+	}
+	config, err := opt.toHealthConfig()
+	assert.NilError(t, err)
+	assert.EqualStringSlice(t, config.Test, []string{"NONE"})
+}
+
+func TestHealthCheckOptionsToHealthConfigConflict(t *testing.T) {
+	opt := healthCheckOptions{
+		cmd:           "curl",
+		noHealthcheck: true,
+	}
+	i18n.SetLocale(i18n.SourceLocale)
+	defer i18n.SetLocale("")
+
+	_, err := opt.toHealthConfig()
+	assert.Error(t, err, "--no-healthcheck conflicts with --health-* options")
+}
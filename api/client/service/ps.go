@@ -8,6 +8,7 @@ import (
 	"github.com/docker/docker/api/client/node"
 	"github.com/docker/docker/api/client/task"
 	"github.com/docker/docker/cli"
+	"github.com/docker/docker/cli/i18n"
 	"github.com/docker/docker/opts"
 	"github.com/docker/engine-api/types"
 	"github.com/spf13/cobra"
@@ -24,7 +25,7 @@ func newPSCommand(dockerCli *client.DockerCli) *cobra.Command {
 
 	cmd := &cobra.Command{
 		Use:   "ps [OPTIONS] SERVICE",
-		Short: "罗列一个服务内的所有任务",
+		Short: i18n.T("List the tasks of a service"),
 		Args:  cli.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			opts.serviceID = args[0]
@@ -32,8 +33,8 @@ func newPSCommand(dockerCli *client.DockerCli) *cobra.Command {
 		},
 	}
 	flags := cmd.Flags()
-	flags.BoolVar(&opts.noResolve, "no-resolve", false, "不将ID解析成名称")
-	flags.VarP(&opts.filter, "filter", "f", "基于指定条件过滤命令输出内容。")
+	flags.BoolVar(&opts.noResolve, "no-resolve", false, i18n.T("Do not map IDs to Names"))
+	flags.VarP(&opts.filter, "filter", "f", i18n.T("Filter output based on conditions provided"))
 
 	return cmd
 }
@@ -0,0 +1,43 @@
+package service
+
+import (
+	"fmt"
+
+	"golang.org/x/net/context"
+
+	"github.com/docker/docker/api/client"
+	"github.com/docker/docker/cli"
+	"github.com/docker/docker/cli/i18n"
+	"github.com/docker/engine-api/types"
+	"github.com/spf13/cobra"
+)
+
+func newRollbackCommand(dockerCli *client.DockerCli) *cobra.Command {
+	return &cobra.Command{
+		Use:   "rollback SERVICE",
+		Short: i18n.T("Revert a service to its previous specification"),
+		Args:  cli.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runRollback(dockerCli, args[0])
+		},
+	}
+}
+
+func runRollback(dockerCli *client.DockerCli, serviceID string) error {
+	apiClient := dockerCli.Client()
+	ctx := context.Background()
+
+	service, _, err := apiClient.ServiceInspectWithRaw(ctx, serviceID)
+	if err != nil {
+		return err
+	}
+
+	// The spec sent along is ignored, the daemon restores the previous one.
+	updateOpts := types.ServiceUpdateOptions{Rollback: "previous"}
+	if err := apiClient.ServiceUpdate(ctx, service.ID, service.Version, service.Spec, updateOpts); err != nil {
+		return err
+	}
+
+	fmt.Fprintf(dockerCli.Out(), "%s\n", serviceID)
+	return nil
+}
@@ -1,6 +1,7 @@
 package service
 
 import (
+	"errors"
 	"fmt"
 	"sort"
 	"strings"
@@ -10,9 +11,11 @@ import (
 
 	"github.com/docker/docker/api/client"
 	"github.com/docker/docker/cli"
+	"github.com/docker/docker/cli/i18n"
 	"github.com/docker/docker/opts"
 	runconfigopts "github.com/docker/docker/runconfig/opts"
 	"github.com/docker/engine-api/types"
+	"github.com/docker/engine-api/types/container"
 	"github.com/docker/engine-api/types/swarm"
 	"github.com/docker/go-connections/nat"
 	shlex "github.com/flynn-archive/go-shlex"
@@ -25,7 +28,7 @@ func newUpdateCommand(dockerCli *client.DockerCli) *cobra.Command {
 
 	cmd := &cobra.Command{
 		Use:   "update [OPTIONS] SERVICE",
-		Short: "更新一个服务",
+		Short: i18n.T("Update a service"),
 		Args:  cli.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			return runUpdate(dockerCli, cmd.Flags(), args[0])
@@ -33,21 +36,23 @@ func newUpdateCommand(dockerCli *client.DockerCli) *cobra.Command {
 	}
 
 	flags := cmd.Flags()
-	flags.String("image", "", "服务镜像的标签")
-	flags.String("args", "", "服务的启动命令")
+	flags.String("image", "", i18n.T("Service image tag"))
+	flags.String("args", "", i18n.T("Service command args"))
 	addServiceFlags(cmd, opts)
-	flags.Var(newListOptsVar(), flagEnvRemove, "删除一个环境变量")
-	flags.Var(newListOptsVar(), flagLabelRemove, "通过键值删除一个标签")
-	flags.Var(newListOptsVar(), flagContainerLabelRemove, "通过键值删除一个容器的标签")
-	flags.Var(newListOptsVar(), flagMountRemove, "通过目标路径删除一个挂载")
-	flags.Var(newListOptsVar(), flagPublishRemove, "通过目标端口删除一个对外暴露的端口")
-	flags.Var(newListOptsVar(), flagConstraintRemove, "删除一条限制条件")
-	flags.Var(&opts.labels, flagLabelAdd, "添加或更新服务标签")
-	flags.Var(&opts.containerLabels, flagContainerLabelAdd, "添加或更新容器标签")
-	flags.Var(&opts.env, flagEnvAdd, "添加或更新环境变量")
-	flags.Var(&opts.mounts, flagMountAdd, "添加或更新一个服务的挂载项")
-	flags.StringSliceVar(&opts.constraints, flagConstraintAdd, []string{}, "添加或更新放置策略与限制")
-	flags.Var(&opts.endpoint.ports, flagPublishAdd, "添加或更新一个对外暴露的端口")
+	flags.Var(newListOptsVar(), flagEnvRemove, i18n.T("Remove an environment variable"))
+	flags.Var(newListOptsVar(), flagLabelRemove, i18n.T("Remove a label by its key"))
+	flags.Var(newListOptsVar(), flagContainerLabelRemove, i18n.T("Remove a container label by its key"))
+	flags.Var(newListOptsVar(), flagMountRemove, i18n.T("Remove a mount by its target path"))
+	flags.Var(newListOptsVar(), flagPublishRemove, i18n.T("Remove a published port by its target port"))
+	flags.Var(newListOptsVar(), flagConstraintRemove, i18n.T("Remove a constraint"))
+	flags.Var(newListOptsVar(), flagSecretRemove, i18n.T("Remove a secret by its name or ID"))
+	flags.Var(&opts.labels, flagLabelAdd, i18n.T("Add or update service labels"))
+	flags.Var(&opts.containerLabels, flagContainerLabelAdd, i18n.T("Add or update container labels"))
+	flags.Var(&opts.env, flagEnvAdd, i18n.T("Add or update environment variables"))
+	flags.Var(&opts.mounts, flagMountAdd, i18n.T("Add or update a mount on a service"))
+	flags.StringSliceVar(&opts.constraints, flagConstraintAdd, []string{}, i18n.T("Add or update placement constraints"))
+	flags.Var(&opts.endpoint.ports, flagPublishAdd, i18n.T("Add or update a published port"))
+	flags.StringSliceVar(&opts.secrets, flagSecretAdd, []string{}, i18n.T("Add a secret to the service"))
 	return cmd
 }
 
@@ -121,6 +126,12 @@ func updateService(flags *pflag.FlagSet, spec *swarm.ServiceSpec) error {
 		}
 	}
 
+	updateFloat32 := func(flag string, field *float32) {
+		if flags.Changed(flag) {
+			*field, _ = flags.GetFloat32(flag)
+		}
+	}
+
 	updateUint64 := func(flag string, field *uint64) {
 		if flags.Changed(flag) {
 			*field, _ = flags.GetUint64(flag)
@@ -153,6 +164,7 @@ func updateService(flags *pflag.FlagSet, spec *swarm.ServiceSpec) error {
 	updateString("workdir", &cspec.Dir)
 	updateString(flagUser, &cspec.User)
 	updateMounts(flags, &cspec.Mounts)
+	updateSecrets(flags, &cspec.Secrets)
 
 	if flags.Changed(flagLimitCPU) || flags.Changed(flagLimitMemory) {
 		taskResources().Limits = &swarm.Resources{}
@@ -192,13 +204,15 @@ func updateService(flags *pflag.FlagSet, spec *swarm.ServiceSpec) error {
 		return err
 	}
 
-	if anyChanged(flags, flagUpdateParallelism, flagUpdateDelay, flagUpdateFailureAction) {
+	if anyChanged(flags, flagUpdateParallelism, flagUpdateDelay, flagUpdateMonitor, flagUpdateFailureAction, flagUpdateMaxFailureRatio) {
 		if spec.UpdateConfig == nil {
 			spec.UpdateConfig = &swarm.UpdateConfig{}
 		}
 		updateUint64(flagUpdateParallelism, &spec.UpdateConfig.Parallelism)
 		updateDuration(flagUpdateDelay, &spec.UpdateConfig.Delay)
+		updateDuration(flagUpdateMonitor, &spec.UpdateConfig.Monitor)
 		updateString(flagUpdateFailureAction, &spec.UpdateConfig.FailureAction)
+		updateFloat32(flagUpdateMaxFailureRatio, &spec.UpdateConfig.MaxFailureRatio)
 	}
 
 	if flags.Changed(flagEndpointMode) {
@@ -222,6 +236,10 @@ func updateService(flags *pflag.FlagSet, spec *swarm.ServiceSpec) error {
 		return err
 	}
 
+	if err := updateHealthcheck(flags, cspec); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -368,6 +386,35 @@ func updateMounts(flags *pflag.FlagSet, mounts *[]swarm.Mount) {
 	*mounts = newMounts
 }
 
+func updateSecrets(flags *pflag.FlagSet, secrets *[]*swarm.SecretReference) {
+	if !anyChanged(flags, flagSecretAdd, flagSecretRemove) {
+		return
+	}
+	toRemove := buildToRemoveSet(flags, flagSecretRemove)
+
+	newSecrets := []*swarm.SecretReference{}
+	names := map[string]struct{}{}
+	for _, secret := range *secrets {
+		_, byName := toRemove[secret.SecretName]
+		_, byID := toRemove[secret.SecretID]
+		if !byName && !byID {
+			newSecrets = append(newSecrets, secret)
+			names[secret.SecretName] = struct{}{}
+		}
+	}
+
+	if flags.Changed(flagSecretAdd) {
+		values, _ := flags.GetStringSlice(flagSecretAdd)
+		for _, value := range values {
+			if _, exists := names[value]; !exists {
+				newSecrets = append(newSecrets, &swarm.SecretReference{SecretName: value})
+				names[value] = struct{}{}
+			}
+		}
+	}
+	*secrets = newSecrets
+}
+
 type byPortConfig []swarm.PortConfig
 
 func (r byPortConfig) Len() int      { return len(r) }
@@ -398,7 +445,7 @@ func updatePorts(flags *pflag.FlagSet, portConfig *[]swarm.PortConfig) error {
 			newConfigs := convertPortToPortConfig(port, portBindings)
 			for _, entry := range newConfigs {
 				if v, ok := portSet[portConfigToString(&entry)]; ok && v != entry {
-					return fmt.Errorf("conflicting port mapping between %v:%v/%s and %v:%v/%s", entry.PublishedPort, entry.TargetPort, entry.Protocol, v.PublishedPort, v.TargetPort, v.Protocol)
+					return fmt.Errorf(i18n.T("conflicting port mapping between %v:%v/%s and %v:%v/%s"), entry.PublishedPort, entry.TargetPort, entry.Protocol, v.PublishedPort, v.TargetPort, v.Protocol)
 				}
 				portSet[portConfigToString(&entry)] = entry
 			}
@@ -441,7 +488,7 @@ func updateReplicas(flags *pflag.FlagSet, serviceMode *swarm.ServiceMode) error
 	}
 
 	if serviceMode == nil || serviceMode.Replicated == nil {
-		return fmt.Errorf("replicas can only be used with replicated mode")
+		return errors.New(i18n.T("replicas can only be used with replicated mode"))
 	}
 	serviceMode.Replicated.Replicas = flags.Lookup(flagReplicas).Value.(*Uint64Opt).Value()
 	return nil
@@ -470,3 +517,61 @@ func updateLogDriver(flags *pflag.FlagSet, taskTemplate *swarm.TaskSpec) error {
 
 	return nil
 }
+
+// updateHealthcheck updates the healthcheck of the container only if one of
+// the healthcheck flags is set. The settings which are not set are kept.
+func updateHealthcheck(flags *pflag.FlagSet, containerSpec *swarm.ContainerSpec) error {
+	if !anyChanged(flags, flagNoHealthcheck, flagHealthCmd, flagHealthInterval, flagHealthRetries, flagHealthTimeout) {
+		return nil
+	}
+
+	noHealthcheck, err := flags.GetBool(flagNoHealthcheck)
+	if err != nil {
+		return err
+	}
+	if noHealthcheck {
+		if anyChanged(flags, flagHealthCmd, flagHealthInterval, flagHealthRetries, flagHealthTimeout) {
+			return fmt.Errorf(i18n.T("--%s conflicts with --health-* options"), flagNoHealthcheck)
+		}
+		containerSpec.Healthcheck = &container.HealthConfig{Test: []string{"NONE"}}
+		return nil
+	}
+
+	if containerSpec.Healthcheck == nil {
+		containerSpec.Healthcheck = &container.HealthConfig{}
+	}
+	healthcheck := containerSpec.Healthcheck
+	// The healthcheck was disabled, setting any of its flags enables it again.
+	if len(healthcheck.Test) > 0 && healthcheck.Test[0] == "NONE" {
+		healthcheck.Test = nil
+	}
+
+	if flags.Changed(flagHealthCmd) {
+		cmd, _ := flags.GetString(flagHealthCmd)
+		if cmd != "" {
+			healthcheck.Test = []string{"CMD-SHELL", cmd}
+		} else {
+			healthcheck.Test = nil
+		}
+	}
+	if flags.Changed(flagHealthInterval) {
+		healthcheck.Interval = *flags.Lookup(flagHealthInterval).Value.(*DurationOpt).Value()
+		if healthcheck.Interval < 0 {
+			return fmt.Errorf(i18n.T("--%s cannot be negative"), flagHealthInterval)
+		}
+	}
+	if flags.Changed(flagHealthTimeout) {
+		healthcheck.Timeout = *flags.Lookup(flagHealthTimeout).Value.(*DurationOpt).Value()
+		if healthcheck.Timeout < 0 {
+			return fmt.Errorf(i18n.T("--%s cannot be negative"), flagHealthTimeout)
+		}
+	}
+	if flags.Changed(flagHealthRetries) {
+		healthcheck.Retries, _ = flags.GetInt(flagHealthRetries)
+		if healthcheck.Retries < 0 {
+			return fmt.Errorf(i18n.T("--%s cannot be negative"), flagHealthRetries)
+		}
+	}
+
+	return nil
+}
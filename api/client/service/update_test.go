@@ -3,8 +3,11 @@ package service
 import (
 	"sort"
 	"testing"
+	"time"
 
+	"github.com/docker/docker/cli/i18n"
 	"github.com/docker/docker/pkg/testutil/assert"
+	"github.com/docker/engine-api/types/container"
 	"github.com/docker/engine-api/types/swarm"
 )
 
@@ -20,6 +23,23 @@ func TestUpdateServiceArgs(t *testing.T) {
 	assert.EqualStringSlice(t, cspec.Args, []string{"the", "new args"})
 }
 
+func TestUpdateServiceUpdateConfig(t *testing.T) {
+	flags := newUpdateCommand(nil).Flags()
+	flags.Set("update-monitor", "30s")
+	flags.Set("update-failure-action", "rollback")
+	flags.Set("update-max-failure-ratio", "0.25")
+
+	spec := &swarm.ServiceSpec{
+		UpdateConfig: &swarm.UpdateConfig{Parallelism: 2},
+	}
+
+	updateService(flags, spec)
+	assert.Equal(t, spec.UpdateConfig.Parallelism, uint64(2))
+	assert.Equal(t, spec.UpdateConfig.Monitor, 30*time.Second)
+	assert.Equal(t, spec.UpdateConfig.FailureAction, "rollback")
+	assert.Equal(t, spec.UpdateConfig.MaxFailureRatio, float32(0.25))
+}
+
 func TestUpdateLabels(t *testing.T) {
 	flags := newUpdateCommand(nil).Flags()
 	flags.Set("label-add", "toadd=newlabel")
@@ -115,6 +135,27 @@ func TestUpdateMounts(t *testing.T) {
 	assert.Equal(t, mounts[1].Target, "/toadd")
 }
 
+func TestUpdateSecrets(t *testing.T) {
+	flags := newUpdateCommand(nil).Flags()
+	flags.Set("secret-add", "toadd")
+	flags.Set("secret-add", "tokeep")
+	flags.Set("secret-rm", "toremove")
+	flags.Set("secret-rm", "id2")
+
+	secrets := []*swarm.SecretReference{
+		{SecretID: "id1", SecretName: "toremove"},
+		{SecretID: "id2", SecretName: "toremovebyid"},
+		{SecretID: "id3", SecretName: "tokeep"},
+	}
+
+	updateSecrets(flags, &secrets)
+	assert.Equal(t, len(secrets), 2)
+	assert.Equal(t, secrets[0].SecretName, "tokeep")
+	assert.Equal(t, secrets[0].SecretID, "id3")
+	assert.Equal(t, secrets[1].SecretName, "toadd")
+	assert.Equal(t, secrets[1].SecretID, "")
+}
+
 func TestUpdatePorts(t *testing.T) {
 	flags := newUpdateCommand(nil).Flags()
 	flags.Set("publish-add", "1000:1000")
@@ -166,6 +207,9 @@ func TestUpdatePortsDuplicateKeys(t *testing.T) {
 }
 
 func TestUpdatePortsConflictingFlags(t *testing.T) {
+	i18n.SetLocale(i18n.SourceLocale)
+	defer i18n.SetLocale("")
+
 	// Test case for #25375
 	flags := newUpdateCommand(nil).Flags()
 	flags.Set("publish-add", "80:80")
@@ -178,3 +222,51 @@ func TestUpdatePortsConflictingFlags(t *testing.T) {
 	err := updatePorts(flags, &portConfigs)
 	assert.Error(t, err, "conflicting port mapping")
 }
+
+func TestUpdateHealthcheck(t *testing.T) {
+	flags := newUpdateCommand(nil).Flags()
+	flags.Set("health-cmd", "cmd1")
+	flags.Set("health-retries", "3")
+
+	cspec := &swarm.ContainerSpec{
+		Healthcheck: &container.HealthConfig{
+			Test:     []string{"CMD-SHELL", "cmd0"},
+			Interval: time.Second,
+		},
+	}
+
+	err := updateHealthcheck(flags, cspec)
+	assert.NilError(t, err)
+	assert.EqualStringSlice(t, cspec.Healthcheck.Test, []string{"CMD-SHELL", "cmd1"})
+	assert.Equal(t, cspec.Healthcheck.Interval, time.Second)
+	assert.Equal(t, cspec.Healthcheck.Retries, 3)
+}
+
+func TestUpdateHealthcheckDisable(t *testing.T) {
+	flags := newUpdateCommand(nil).Flags()
+	flags.Set("no-healthcheck", "true")
+
+	cspec := &swarm.ContainerSpec{}
+	err := updateHealthcheck(flags, cspec)
+	assert.NilError(t, err)
+	assert.EqualStringSlice(t, cspec.Healthcheck.Test, []string{"NONE"})
+
+	flags = newUpdateCommand(nil).Flags()
+	flags.Set("health-timeout", "5s")
+	err = updateHealthcheck(flags, cspec)
+	assert.NilError(t, err)
+	assert.Equal(t, len(cspec.Healthcheck.Test), 0)
+	assert.Equal(t, cspec.Healthcheck.Timeout, 5*time.Second)
+}
+
+func TestUpdateHealthcheckConflict(t *testing.T) {
+	i18n.SetLocale(i18n.SourceLocale)
+	defer i18n.SetLocale("")
+
+	flags := newUpdateCommand(nil).Flags()
+	flags.Set("no-healthcheck", "true")
+	flags.Set("health-cmd", "cmd1")
+
+	err := updateHealthcheck(flags, &swarm.ContainerSpec{})
+	assert.Error(t, err, "conflicts with --health-* options")
+}
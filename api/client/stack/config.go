@@ -6,6 +6,7 @@ import (
 	"github.com/docker/docker/api/client"
 	"github.com/docker/docker/api/client/bundlefile"
 	"github.com/docker/docker/cli"
+	"github.com/docker/docker/cli/i18n"
 	"github.com/spf13/cobra"
 )
 
@@ -19,7 +20,7 @@ func newConfigCommand(dockerCli *client.DockerCli) *cobra.Command {
 
 	cmd := &cobra.Command{
 		Use:   "config [OPTIONS] STACK",
-		Short: "打印stack的配置信息",
+		Short: i18n.T("Print the stack configuration"),
 		Args:  cli.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			opts.namespace = args[0]
@@ -8,6 +8,7 @@ import (
 	"os"
 
 	"github.com/docker/docker/api/client/bundlefile"
+	"github.com/docker/docker/cli/i18n"
 	"github.com/spf13/pflag"
 )
 
@@ -19,7 +20,7 @@ func addBundlefileFlag(opt *string, flags *pflag.FlagSet) {
 }
 
 func addRegistryAuthFlag(opt *bool, flags *pflag.FlagSet) {
-	flags.BoolVar(opt, "with-registry-auth", false, "Send registry authentication details to Swarm agents")
+	flags.BoolVar(opt, "with-registry-auth", false, i18n.T("Send registry authentication details to Swarm agents"))
 }
 
 func loadBundlefile(stderr io.Writer, namespace string, path string) (*bundlefile.Bundlefile, error) {
@@ -34,7 +35,7 @@ func loadBundlefile(stderr io.Writer, namespace string, path string) (*bundlefil
 			path)
 	}
 
-	fmt.Fprintf(stderr, "Loading bundle from %s\n", path)
+	fmt.Fprintf(stderr, i18n.T("Loading bundle from %s\n"), path)
 	reader, err := os.Open(path)
 	if err != nil {
 		return nil, err
@@ -43,7 +44,7 @@ func loadBundlefile(stderr io.Writer, namespace string, path string) (*bundlefil
 
 	bundle, err := bundlefile.LoadFile(reader)
 	if err != nil {
-		return nil, fmt.Errorf("Error reading %s: %v\n", path, err)
+		return nil, fmt.Errorf(i18n.T("Error reading %s: %v\n"), path, err)
 	}
 	return bundle, err
 }
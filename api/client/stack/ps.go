@@ -11,6 +11,7 @@ import (
 	"github.com/docker/docker/api/client/idresolver"
 	"github.com/docker/docker/api/client/task"
 	"github.com/docker/docker/cli"
+	"github.com/docker/docker/cli/i18n"
 	"github.com/docker/docker/opts"
 	"github.com/docker/engine-api/types"
 	"github.com/docker/engine-api/types/swarm"
@@ -29,7 +30,7 @@ func newPSCommand(dockerCli *client.DockerCli) *cobra.Command {
 
 	cmd := &cobra.Command{
 		Use:   "ps [OPTIONS] STACK",
-		Short: "罗列一个stack中的所有运行任务",
+		Short: i18n.T("List the tasks in the stack"),
 		Args:  cli.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			opts.namespace = args[0]
@@ -37,9 +38,9 @@ func newPSCommand(dockerCli *client.DockerCli) *cobra.Command {
 		},
 	}
 	flags := cmd.Flags()
-	flags.BoolVarP(&opts.all, "all", "a", false, "显示所有任务")
-	flags.BoolVar(&opts.noResolve, "no-resolve", false, "不将ID映射成名称")
-	flags.VarP(&opts.filter, "filter", "f", "基于指定条件过滤命令输出内容")
+	flags.BoolVarP(&opts.all, "all", "a", false, i18n.T("Display all tasks"))
+	flags.BoolVar(&opts.noResolve, "no-resolve", false, i18n.T("Do not map IDs to Names"))
+	flags.VarP(&opts.filter, "filter", "f", i18n.T("Filter output based on conditions provided"))
 
 	return cmd
 }
@@ -62,7 +63,7 @@ func runPS(dockerCli *client.DockerCli, opts psOptions) error {
 	}
 
 	if len(tasks) == 0 {
-		fmt.Fprintf(dockerCli.Out(), "在stack中没有找到任何内容: %s\n", namespace)
+		fmt.Fprintf(dockerCli.Out(), i18n.T("Nothing found in stack: %s\n"), namespace)
 		return nil
 	}
 
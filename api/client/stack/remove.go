@@ -3,12 +3,14 @@
 package stack
 
 import (
+	"errors"
 	"fmt"
 
 	"golang.org/x/net/context"
 
 	"github.com/docker/docker/api/client"
 	"github.com/docker/docker/cli"
+	"github.com/docker/docker/cli/i18n"
 	"github.com/spf13/cobra"
 )
 
@@ -22,7 +24,7 @@ func newRemoveCommand(dockerCli *client.DockerCli) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:     "rm STACK",
 		Aliases: []string{"remove", "down"},
-		Short:   "删除stack",
+		Short:   i18n.T("Remove the stack"),
 		Args:    cli.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			opts.namespace = args[0]
@@ -44,10 +46,10 @@ func runRemove(dockerCli *client.DockerCli, opts removeOptions) error {
 		return err
 	}
 	for _, service := range services {
-		fmt.Fprintf(stderr, "删除服务 %s\n", service.Spec.Name)
+		fmt.Fprintf(stderr, i18n.T("Removing service %s\n"), service.Spec.Name)
 		if err := client.ServiceRemove(ctx, service.ID); err != nil {
 			hasError = true
-			fmt.Fprintf(stderr, "删除服务 %s 失败: %s", service.ID, err)
+			fmt.Fprintf(stderr, i18n.T("Failed to remove service %s: %s"), service.ID, err)
 		}
 	}
 
@@ -56,20 +58,20 @@ func runRemove(dockerCli *client.DockerCli, opts removeOptions) error {
 		return err
 	}
 	for _, network := range networks {
-		fmt.Fprintf(stderr, "删除网络 %s\n", network.Name)
+		fmt.Fprintf(stderr, i18n.T("Removing network %s\n"), network.Name)
 		if err := client.NetworkRemove(ctx, network.ID); err != nil {
 			hasError = true
-			fmt.Fprintf(stderr, "删除网络 %s 失败: %s", network.ID, err)
+			fmt.Fprintf(stderr, i18n.T("Failed to remove network %s: %s"), network.ID, err)
 		}
 	}
 
 	if len(services) == 0 && len(networks) == 0 {
-		fmt.Fprintf(dockerCli.Out(), "Nothing found in stack: %s\n", namespace)
+		fmt.Fprintf(dockerCli.Out(), i18n.T("Nothing found in stack: %s\n"), namespace)
 		return nil
 	}
 
 	if hasError {
-		return fmt.Errorf("删除部分资源失败")
+		return errors.New(i18n.T("Failed to remove some resources"))
 	}
 	return nil
 }
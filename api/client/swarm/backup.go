@@ -0,0 +1,98 @@
+package swarm
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+
+	"golang.org/x/net/context"
+
+	"github.com/docker/docker/api/client"
+	"github.com/docker/docker/cli"
+	"github.com/docker/docker/cli/i18n"
+	"github.com/docker/docker/pkg/term"
+	"github.com/docker/engine-api/types/swarm"
+	"github.com/spf13/cobra"
+)
+
+func newBackupCommand(dockerCli *client.DockerCli) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "backup FILE",
+		Short: i18n.T("Back up the state of the swarm to an encrypted file"),
+		Args:  cli.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runBackup(dockerCli, args[0])
+		},
+	}
+	return cmd
+}
+
+func runBackup(dockerCli *client.DockerCli, file string) error {
+	passphrase, err := readPassphrase(dockerCli, true)
+	if err != nil {
+		return err
+	}
+
+	backup, err := dockerCli.Client().SwarmBackup(context.Background(), swarm.BackupRequest{Passphrase: passphrase})
+	if err != nil {
+		return err
+	}
+	defer backup.Close()
+
+	if err := client.CopyToFile(file, backup); err != nil {
+		return err
+	}
+
+	fmt.Fprintf(dockerCli.Out(), i18n.T("Swarm backed up to %s.\n"), file)
+	return nil
+}
+
+// readPassphrase prompts for the passphrase of a backup when stdin is a
+// terminal, and reads it from the first line of stdin otherwise.
+func readPassphrase(dockerCli *client.DockerCli, confirm bool) (string, error) {
+	if !dockerCli.IsTerminalIn() {
+		line, _, err := bufio.NewReader(dockerCli.In()).ReadLine()
+		if err != nil {
+			return "", fmt.Errorf(i18n.T("error reading passphrase: %v"), err)
+		}
+		if len(line) == 0 {
+			return "", errors.New(i18n.T("a passphrase is required"))
+		}
+		return string(line), nil
+	}
+
+	passphrase, err := promptPassphrase(dockerCli, i18n.T("Passphrase: "))
+	if err != nil {
+		return "", err
+	}
+	if passphrase == "" {
+		return "", errors.New(i18n.T("a passphrase is required"))
+	}
+	if confirm {
+		again, err := promptPassphrase(dockerCli, i18n.T("Confirm passphrase: "))
+		if err != nil {
+			return "", err
+		}
+		if again != passphrase {
+			return "", errors.New(i18n.T("passphrases do not match"))
+		}
+	}
+	return passphrase, nil
+}
+
+func promptPassphrase(dockerCli *client.DockerCli, prompt string) (string, error) {
+	oldState, err := term.SaveState(dockerCli.InFd())
+	if err != nil {
+		return "", err
+	}
+	fmt.Fprint(dockerCli.Out(), prompt)
+	term.DisableEcho(dockerCli.InFd(), oldState)
+	defer term.RestoreTerminal(dockerCli.InFd(), oldState)
+
+	line, _, err := bufio.NewReader(dockerCli.In()).ReadLine()
+	fmt.Fprint(dockerCli.Out(), "\n")
+	if err != nil {
+		return "", err
+	}
+	return string(line), nil
+}
@@ -7,13 +7,14 @@ import (
 
 	"github.com/docker/docker/api/client"
 	"github.com/docker/docker/cli"
+	"github.com/docker/docker/cli/i18n"
 )
 
 // NewSwarmCommand returns a cobra command for `swarm` subcommands
 func NewSwarmCommand(dockerCli *client.DockerCli) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "swarm",
-		Short: "管理Swarm集群",
+		Short: i18n.T("Manage Docker Swarm"),
 		Args:  cli.NoArgs,
 		Run: func(cmd *cobra.Command, args []string) {
 			fmt.Fprintf(dockerCli.Err(), "\n"+cmd.UsageString())
@@ -25,6 +26,8 @@ func NewSwarmCommand(dockerCli *client.DockerCli) *cobra.Command {
 		newJoinTokenCommand(dockerCli),
 		newUpdateCommand(dockerCli),
 		newLeaveCommand(dockerCli),
+		newBackupCommand(dockerCli),
+		newRestoreCommand(dockerCli),
 	)
 	return cmd
 }
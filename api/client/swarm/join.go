@@ -6,6 +6,7 @@ import (
 
 	"github.com/docker/docker/api/client"
 	"github.com/docker/docker/cli"
+	"github.com/docker/docker/cli/i18n"
 	"github.com/docker/engine-api/types/swarm"
 	"github.com/spf13/cobra"
 	"golang.org/x/net/context"
@@ -26,7 +27,7 @@ func newJoinCommand(dockerCli *client.DockerCli) *cobra.Command {
 
 	cmd := &cobra.Command{
 		Use:   "join [OPTIONS] HOST:PORT",
-		Short: "加入作为工作节点(worker)或者管理节点(manager)加入一个Swarm集群",
+		Short: i18n.T("Join a swarm as a node and/or manager"),
 		Args:  cli.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			opts.remote = args[0]
@@ -35,9 +36,9 @@ func newJoinCommand(dockerCli *client.DockerCli) *cobra.Command {
 	}
 
 	flags := cmd.Flags()
-	flags.Var(&opts.listenAddr, flagListenAddr, "监听地址 (格式: <IP地址|网卡>[:端口])")
-	flags.StringVar(&opts.advertiseAddr, flagAdvertiseAddr, "", "广播地址 (格式: <IP地址|网卡>[:端口])")
-	flags.StringVar(&opts.token, flagToken, "", "进入此Swarm集群所需的令牌")
+	flags.Var(&opts.listenAddr, flagListenAddr, i18n.T("Listen address (format: <ip|interface>[:port])"))
+	flags.StringVar(&opts.advertiseAddr, flagAdvertiseAddr, "", i18n.T("Advertised address (format: <ip|interface>[:port])"))
+	flags.StringVar(&opts.token, flagToken, "", i18n.T("Token for entry into the swarm"))
 	return cmd
 }
 
@@ -65,10 +66,10 @@ func runJoin(dockerCli *client.DockerCli, opts joinOptions) error {
 	if err != nil {
 		// TODO(aaronl): is there a better way to do this?
 		if strings.Contains(err.Error(), "This node is not a swarm manager.") {
-			fmt.Fprintln(dockerCli.Out(), "此节点已经作为一个工作节点(worker)加入指定的Swarm集群。")
+			fmt.Fprintln(dockerCli.Out(), i18n.T("This node joined a swarm as a worker."))
 		}
 	} else {
-		fmt.Fprintln(dockerCli.Out(), "此节点已经作为一个管理节点(manager)加入指定的Swarm集群。")
+		fmt.Fprintln(dockerCli.Out(), i18n.T("This node joined a swarm as a manager."))
 	}
 
 	return nil
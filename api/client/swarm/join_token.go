@@ -1,13 +1,13 @@
 package swarm
 
 import (
-	"errors"
 	"fmt"
 
 	"github.com/spf13/cobra"
 
 	"github.com/docker/docker/api/client"
 	"github.com/docker/docker/cli"
+	"github.com/docker/docker/cli/i18n"
 	"github.com/docker/engine-api/types/swarm"
 	"golang.org/x/net/context"
 )
@@ -17,14 +17,14 @@ func newJoinTokenCommand(dockerCli *client.DockerCli) *cobra.Command {
 
 	cmd := &cobra.Command{
 		Use:   "join-token [-q] [--rotate] (worker|manager)",
-		Short: "Manage join tokens",
+		Short: i18n.T("Manage join tokens"),
 		Args:  cli.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			worker := args[0] == "worker"
 			manager := args[0] == "manager"
 
 			if !worker && !manager {
-				return errors.New("unknown role " + args[0])
+				return fmt.Errorf(i18n.T("unknown role %s"), args[0])
 			}
 
 			client := dockerCli.Client()
@@ -46,7 +46,7 @@ func newJoinTokenCommand(dockerCli *client.DockerCli) *cobra.Command {
 					return err
 				}
 				if !quiet {
-					fmt.Fprintf(dockerCli.Out(), "Succesfully rotated %s join token.\n\n", args[0])
+					fmt.Fprintf(dockerCli.Out(), i18n.T("Succesfully rotated %s join token.\n\n"), args[0])
 				}
 			}
 
@@ -73,8 +73,8 @@ func newJoinTokenCommand(dockerCli *client.DockerCli) *cobra.Command {
 	}
 
 	flags := cmd.Flags()
-	flags.BoolVar(&rotate, flagRotate, false, "Rotate join token")
-	flags.BoolVarP(&quiet, flagQuiet, "q", false, "Only display token")
+	flags.BoolVar(&rotate, flagRotate, false, i18n.T("Rotate join token"))
+	flags.BoolVarP(&quiet, flagQuiet, "q", false, i18n.T("Only display token"))
 
 	return cmd
 }
@@ -94,10 +94,10 @@ func printJoinCommand(ctx context.Context, dockerCli *client.DockerCli, nodeID s
 
 	if node.ManagerStatus != nil {
 		if worker {
-			fmt.Fprintf(dockerCli.Out(), "To add a worker to this swarm, run the following command:\n\n    docker swarm join \\\n    --token %s \\\n    %s\n\n", swarm.JoinTokens.Worker, node.ManagerStatus.Addr)
+			fmt.Fprintf(dockerCli.Out(), i18n.T("To add a worker to this swarm, run the following command:\n\n    docker swarm join \\\n    --token %s \\\n    %s\n\n"), swarm.JoinTokens.Worker, node.ManagerStatus.Addr)
 		}
 		if manager {
-			fmt.Fprintf(dockerCli.Out(), "To add a manager to this swarm, run the following command:\n\n    docker swarm join \\\n    --token %s \\\n    %s\n\n", swarm.JoinTokens.Manager, node.ManagerStatus.Addr)
+			fmt.Fprintf(dockerCli.Out(), i18n.T("To add a manager to this swarm, run the following command:\n\n    docker swarm join \\\n    --token %s \\\n    %s\n\n"), swarm.JoinTokens.Manager, node.ManagerStatus.Addr)
 		}
 	}
 
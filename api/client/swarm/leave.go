@@ -7,6 +7,7 @@ import (
 
 	"github.com/docker/docker/api/client"
 	"github.com/docker/docker/cli"
+	"github.com/docker/docker/cli/i18n"
 	"github.com/spf13/cobra"
 )
 
@@ -19,7 +20,7 @@ func newLeaveCommand(dockerCli *client.DockerCli) *cobra.Command {
 
 	cmd := &cobra.Command{
 		Use:   "leave [OPTIONS]",
-		Short: "脱离Swarm集群",
+		Short: i18n.T("Leave a swarm"),
 		Args:  cli.NoArgs,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			return runLeave(dockerCli, opts)
@@ -28,7 +29,7 @@ func newLeaveCommand(dockerCli *client.DockerCli) *cobra.Command {
 
 	flags := cmd.Flags()
 
-	flags.BoolVar(&opts.force, "force", false, "强制脱离Swarm集群，忽略所有警告。")
+	flags.BoolVar(&opts.force, "force", false, i18n.T("Force leave ignoring warnings."))
 
 	return cmd
 }
@@ -41,6 +42,6 @@ func runLeave(dockerCli *client.DockerCli, opts leaveOptions) error {
 		return err
 	}
 
-	fmt.Fprintln(dockerCli.Out(), "节点成功脱离Swarm集群")
+	fmt.Fprintln(dockerCli.Out(), i18n.T("Node left the swarm."))
 	return nil
 }
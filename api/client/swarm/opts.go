@@ -7,6 +7,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/docker/docker/cli/i18n"
 	"github.com/docker/docker/opts"
 	"github.com/docker/engine-api/types/swarm"
 	"github.com/spf13/pflag"
@@ -131,7 +132,7 @@ func parseExternalCA(caSpec string) (*swarm.ExternalCA, error) {
 		parts := strings.SplitN(field, "=", 2)
 
 		if len(parts) != 2 {
-			return nil, fmt.Errorf("无效的属性 '%s' 必须是一个键值对", field)
+			return nil, fmt.Errorf(i18n.T("invalid field '%s' must be a key=value pair"), field)
 		}
 
 		key, value := parts[0], parts[1]
@@ -142,7 +143,7 @@ func parseExternalCA(caSpec string) (*swarm.ExternalCA, error) {
 			if strings.ToLower(value) == string(swarm.ExternalCAProtocolCFSSL) {
 				externalCA.Protocol = swarm.ExternalCAProtocolCFSSL
 			} else {
-				return nil, fmt.Errorf("外部CA %s 协议识别失败", value)
+				return nil, fmt.Errorf(i18n.T("unrecognized external CA protocol %s"), value)
 			}
 		case "url":
 			hasURL = true
@@ -153,20 +154,20 @@ func parseExternalCA(caSpec string) (*swarm.ExternalCA, error) {
 	}
 
 	if !hasProtocol {
-		return nil, errors.New("外部CA选项必须拥有一个协议参数 protocol= ")
+		return nil, errors.New(i18n.T("the external-ca option needs a protocol= parameter"))
 	}
 	if !hasURL {
-		return nil, errors.New("外部CA选项必须拥有一个URL参数 url= ")
+		return nil, errors.New(i18n.T("the external-ca option needs a url= parameter"))
 	}
 
 	return &externalCA, nil
 }
 
 func addSwarmFlags(flags *pflag.FlagSet, opts *swarmOptions) {
-	flags.Int64Var(&opts.taskHistoryLimit, flagTaskHistoryLimit, 5, "任务历史保留数量限制")
-	flags.DurationVar(&opts.dispatcherHeartbeat, flagDispatcherHeartbeat, time.Duration(5*time.Second), "分发器的心跳周期")
-	flags.DurationVar(&opts.nodeCertExpiry, flagCertExpiry, time.Duration(90*24*time.Hour), "节点证书的验证周期")
-	flags.Var(&opts.externalCA, flagExternalCA, "一个或多个认证签名节点的详细说明")
+	flags.Int64Var(&opts.taskHistoryLimit, flagTaskHistoryLimit, 5, i18n.T("Task history retention limit"))
+	flags.DurationVar(&opts.dispatcherHeartbeat, flagDispatcherHeartbeat, time.Duration(5*time.Second), i18n.T("Dispatcher heartbeat period"))
+	flags.DurationVar(&opts.nodeCertExpiry, flagCertExpiry, time.Duration(90*24*time.Hour), i18n.T("Validity period for node certificates"))
+	flags.Var(&opts.externalCA, flagExternalCA, i18n.T("Specifications of one or more certificate signing endpoints"))
 }
 
 func (opts *swarmOptions) ToSpec() swarm.Spec {
@@ -0,0 +1,73 @@
+package swarm
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"strings"
+
+	"golang.org/x/net/context"
+
+	"github.com/docker/docker/api/client"
+	"github.com/docker/docker/cli"
+	"github.com/docker/docker/cli/i18n"
+	"github.com/docker/engine-api/types/swarm"
+	"github.com/spf13/cobra"
+)
+
+type restoreOptions struct {
+	listenAddr NodeAddrOption
+	// Not a NodeAddrOption because it has no default port.
+	advertiseAddr string
+}
+
+func newRestoreCommand(dockerCli *client.DockerCli) *cobra.Command {
+	opts := restoreOptions{
+		listenAddr: NewListenAddrOption(),
+	}
+
+	cmd := &cobra.Command{
+		Use:   "restore [OPTIONS] FILE",
+		Short: i18n.T("Restore a swarm from a backup, with this node as its only manager"),
+		Args:  cli.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runRestore(dockerCli, args[0], opts)
+		},
+	}
+
+	flags := cmd.Flags()
+	flags.Var(&opts.listenAddr, flagListenAddr, i18n.T("Listen address (format: <ip|interface>[:port])"))
+	flags.StringVar(&opts.advertiseAddr, flagAdvertiseAddr, "", i18n.T("Advertised address (format: <ip|interface>[:port])"))
+	return cmd
+}
+
+func runRestore(dockerCli *client.DockerCli, file string, opts restoreOptions) error {
+	backup, err := ioutil.ReadFile(file)
+	if err != nil {
+		return err
+	}
+
+	passphrase, err := readPassphrase(dockerCli, false)
+	if err != nil {
+		return err
+	}
+
+	req := swarm.RestoreRequest{
+		ListenAddr:    opts.listenAddr.String(),
+		AdvertiseAddr: opts.advertiseAddr,
+		Passphrase:    passphrase,
+		Backup:        backup,
+	}
+
+	nodeID, err := dockerCli.Client().SwarmRestore(context.Background(), req)
+	if err != nil {
+		if strings.Contains(err.Error(), "could not choose an IP address to advertise") || strings.Contains(err.Error(), "could not find the system's IP address") {
+			return errors.New(err.Error() + " - specify one with --advertise-addr")
+		}
+		return err
+	}
+
+	fmt.Fprintf(dockerCli.Out(), i18n.T("Swarm restored: current node (%s) is now a manager.\n\n"), nodeID)
+	fmt.Fprint(dockerCli.Out(), i18n.T("Other nodes have to join the restored swarm again. Run 'docker swarm join-token worker' or 'docker swarm join-token manager' and follow the instructions.\n\n"))
+	return nil
+}
@@ -0,0 +1,196 @@
+package system
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"golang.org/x/net/context"
+
+	"github.com/docker/docker/api/client"
+	"github.com/docker/docker/cli"
+	"github.com/docker/docker/cli/i18n"
+	"github.com/docker/docker/pkg/stringid"
+	"github.com/docker/engine-api/types"
+	"github.com/docker/go-units"
+	"github.com/spf13/cobra"
+)
+
+type diskUsageOptions struct {
+	verbose bool
+}
+
+// NewDiskUsageCommand creates a new cobra.Command for `docker system df`
+func NewDiskUsageCommand(dockerCli *client.DockerCli) *cobra.Command {
+	var opts diskUsageOptions
+
+	cmd := &cobra.Command{
+		Use:   "df [OPTIONS]",
+		Short: i18n.T("Show docker disk usage"),
+		Args:  cli.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runDiskUsage(dockerCli, opts)
+		},
+	}
+
+	flags := cmd.Flags()
+
+	flags.BoolVarP(&opts.verbose, "verbose", "v", false, i18n.T("Show detailed information on space usage"))
+
+	return cmd
+}
+
+func runDiskUsage(dockerCli *client.DockerCli, opts diskUsageOptions) error {
+	du, err := dockerCli.Client().DiskUsage(context.Background())
+	if err != nil {
+		return err
+	}
+
+	out := dockerCli.Out()
+	if opts.verbose {
+		printImagesUsage(out, du.Images)
+		fmt.Fprintln(out)
+		printContainersUsage(out, du.Containers)
+		fmt.Fprintln(out)
+		printVolumesUsage(out, du.Volumes)
+		fmt.Fprintln(out)
+		fmt.Fprintf(out, i18n.T("Build cache usage: %s")+"\n", humanSize(du.BuildCacheSize))
+		return nil
+	}
+
+	w := tabwriter.NewWriter(out, 20, 1, 3, ' ', 0)
+	fmt.Fprintln(w, i18n.T("TYPE\tTOTAL\tACTIVE\tSIZE\tRECLAIMABLE"))
+	for _, u := range summarizeDiskUsage(du) {
+		fmt.Fprintf(w, "%s\t%d\t%d\t%s\t%s\n", u.name, u.total, u.active, humanSize(u.size), reclaimable(u.reclaimable, u.size))
+	}
+	w.Flush()
+	return nil
+}
+
+// usageSummary is the disk usage of a type of object.
+type usageSummary struct {
+	name          string
+	total, active int
+	// size is the space used by the objects, and reclaimable the part of
+	// it used only by inactive objects.
+	size, reclaimable int64
+}
+
+func summarizeDiskUsage(du types.DiskUsage) []usageSummary {
+	images := usageSummary{name: i18n.T("Images"), total: len(du.Images), size: du.LayersSize}
+	for _, img := range du.Images {
+		if img.Containers > 0 {
+			images.active++
+		} else {
+			images.reclaimable += img.Size - img.SharedSize
+		}
+	}
+
+	containers := usageSummary{name: i18n.T("Containers"), total: len(du.Containers)}
+	for _, c := range du.Containers {
+		containers.size += c.SizeRw
+		if c.State == "running" {
+			containers.active++
+		} else {
+			containers.reclaimable += c.SizeRw
+		}
+	}
+
+	volumes := usageSummary{name: i18n.T("Local Volumes"), total: len(du.Volumes)}
+	for _, v := range du.Volumes {
+		if v.UsageData == nil {
+			continue
+		}
+		volumes.size += v.UsageData.Size
+		if v.UsageData.RefCount > 0 {
+			volumes.active++
+		} else {
+			volumes.reclaimable += v.UsageData.Size
+		}
+	}
+
+	// The build cache is never active: it only holds images not used by any
+	// container.
+	buildCache := usageSummary{name: i18n.T("Build Cache"), total: len(du.BuildCache), size: du.BuildCacheSize, reclaimable: du.BuildCacheSize}
+
+	return []usageSummary{images, containers, volumes, buildCache}
+}
+
+func printImagesUsage(out io.Writer, images []*types.Image) {
+	fmt.Fprint(out, i18n.T("Images space usage:")+"\n\n")
+	w := tabwriter.NewWriter(out, 20, 1, 3, ' ', 0)
+	fmt.Fprintln(w, i18n.T("REPOSITORY\tTAG\tIMAGE ID\tCREATED\tSIZE\tSHARED SIZE\tUNIQUE SIZE\tCONTAINERS"))
+	for _, img := range images {
+		repo, tag := "<none>", "<none>"
+		if len(img.RepoTags) > 0 && img.RepoTags[0] != "<none>:<none>" {
+			i := strings.LastIndex(img.RepoTags[0], ":")
+			repo, tag = img.RepoTags[0][:i], img.RepoTags[0][i+1:]
+		}
+		created := units.HumanDuration(time.Now().UTC().Sub(time.Unix(img.Created, 0)))
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\t%s\t%d\n",
+			repo,
+			tag,
+			stringid.TruncateID(img.ID),
+			fmt.Sprintf(i18n.T("%s ago"), created),
+			humanSize(img.Size),
+			humanSize(img.SharedSize),
+			humanSize(img.Size-img.SharedSize),
+			img.Containers)
+	}
+	w.Flush()
+}
+
+func printContainersUsage(out io.Writer, containers []*types.Container) {
+	fmt.Fprint(out, i18n.T("Containers space usage:")+"\n\n")
+	w := tabwriter.NewWriter(out, 20, 1, 3, ' ', 0)
+	fmt.Fprintln(w, i18n.T("CONTAINER ID\tIMAGE\tLOCAL VOLUMES\tSIZE\tCREATED\tSTATUS\tNAMES"))
+	for _, c := range containers {
+		localVolumes := 0
+		for _, m := range c.Mounts {
+			if m.Name != "" && m.Driver == "local" {
+				localVolumes++
+			}
+		}
+		var names []string
+		for _, name := range c.Names {
+			names = append(names, strings.TrimPrefix(name, "/"))
+		}
+		created := units.HumanDuration(time.Now().UTC().Sub(time.Unix(c.Created, 0)))
+		fmt.Fprintf(w, "%s\t%s\t%d\t%s\t%s\t%s\t%s\n",
+			stringid.TruncateID(c.ID),
+			c.Image,
+			localVolumes,
+			humanSize(c.SizeRw),
+			fmt.Sprintf(i18n.T("%s ago"), created),
+			c.Status,
+			strings.Join(names, ","))
+	}
+	w.Flush()
+}
+
+func printVolumesUsage(out io.Writer, volumes []*types.Volume) {
+	fmt.Fprint(out, i18n.T("Local Volumes space usage:")+"\n\n")
+	w := tabwriter.NewWriter(out, 20, 1, 3, ' ', 0)
+	fmt.Fprintln(w, i18n.T("VOLUME NAME\tLINKS\tSIZE"))
+	for _, v := range volumes {
+		if v.UsageData == nil {
+			continue
+		}
+		fmt.Fprintf(w, "%s\t%d\t%s\n", v.Name, v.UsageData.RefCount, humanSize(v.UsageData.Size))
+	}
+	w.Flush()
+}
+
+func humanSize(size int64) string {
+	return units.HumanSize(float64(size))
+}
+
+// reclaimable formats the reclaimable part of size with its percentage.
+func reclaimable(reclaimable, size int64) string {
+	if size == 0 {
+		return humanSize(reclaimable)
+	}
+	return fmt.Sprintf("%s (%d%%)", humanSize(reclaimable), reclaimable*100/size)
+}
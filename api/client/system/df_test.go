@@ -0,0 +1,52 @@
+package system
+
+import (
+	"testing"
+
+	"github.com/docker/engine-api/types"
+)
+
+func TestSummarizeDiskUsage(t *testing.T) {
+	du := types.DiskUsage{
+		LayersSize: 100,
+		Images: []*types.Image{
+			{ID: "used", Size: 60, SharedSize: 20, Containers: 1},
+			{ID: "unused", Size: 50, SharedSize: 20},
+		},
+		Containers: []*types.Container{
+			{ID: "running", State: "running", SizeRw: 5},
+			{ID: "exited", State: "exited", SizeRw: 7},
+		},
+		Volumes: []*types.Volume{
+			{Name: "used", UsageData: &types.VolumeUsageData{Size: 3, RefCount: 2}},
+			{Name: "unused", UsageData: &types.VolumeUsageData{Size: 4}},
+			{Name: "remote"},
+		},
+		BuildCache:     []*types.Image{{ID: "intermediate"}, {ID: "dangling"}},
+		BuildCacheSize: 15,
+	}
+
+	expected := []usageSummary{
+		{total: 2, active: 1, size: 100, reclaimable: 30},
+		{total: 2, active: 1, size: 12, reclaimable: 7},
+		{total: 3, active: 1, size: 7, reclaimable: 4},
+		{total: 2, active: 0, size: 15, reclaimable: 15},
+	}
+	summary := summarizeDiskUsage(du)
+	if len(summary) != len(expected) {
+		t.Fatalf("expected %d types, got %d", len(expected), len(summary))
+	}
+	for i, u := range summary {
+		u.name = ""
+		if u != expected[i] {
+			t.Fatalf("expected %+v, got %+v", expected[i], u)
+		}
+	}
+
+	if r := reclaimable(30, 100); r != "30 B (30%)" {
+		t.Fatalf("unexpected reclaimable size %q", r)
+	}
+	if r := reclaimable(0, 0); r != "0 B" {
+		t.Fatalf("unexpected reclaimable size %q", r)
+	}
+}
@@ -11,6 +11,7 @@ import (
 
 	"github.com/docker/docker/api/client"
 	"github.com/docker/docker/cli"
+	"github.com/docker/docker/cli/i18n"
 	"github.com/docker/docker/pkg/jsonlog"
 	"github.com/docker/engine-api/types"
 	eventtypes "github.com/docker/engine-api/types/events"
@@ -30,7 +31,7 @@ func NewEventsCommand(dockerCli *client.DockerCli) *cobra.Command {
 
 	cmd := &cobra.Command{
 		Use:   "events [OPTIONS]",
-		Short: "获取Docker引擎的实时事件",
+		Short: i18n.T("Get real time events from the server"),
 		Args:  cli.NoArgs,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			return runEvents(dockerCli, &opts)
@@ -38,9 +39,9 @@ func NewEventsCommand(dockerCli *client.DockerCli) *cobra.Command {
 	}
 
 	flags := cmd.Flags()
-	flags.StringVar(&opts.since, "since", "", "从指定时间戳开始打印所有的事件")
-	flags.StringVar(&opts.until, "until", "", "输出所有的事件直到指定时间戳为止")
-	flags.StringSliceVarP(&opts.filter, "filter", "f", []string{}, "基于指定条件过滤命令输出内容")
+	flags.StringVar(&opts.since, "since", "", i18n.T("Show all events created since timestamp"))
+	flags.StringVar(&opts.until, "until", "", i18n.T("Stream events until this timestamp"))
+	flags.StringSliceVarP(&opts.filter, "filter", "f", []string{}, i18n.T("Filter output based on conditions provided"))
 
 	return cmd
 }
@@ -75,7 +76,7 @@ func runEvents(dockerCli *client.DockerCli, opts *eventsOptions) error {
 
 // streamEvents decodes prints the incoming events in the provided output.
 func streamEvents(input io.Reader, output io.Writer) error {
-	return DecodeEvents(input, func(event eventtypes.Message, err error) error {
+	return client.DecodeEvents(input, func(event eventtypes.Message, err error) error {
 		if err != nil {
 			return err
 		}
@@ -84,8 +85,6 @@ func streamEvents(input io.Reader, output io.Writer) error {
 	})
 }
 
-type eventProcessor func(event eventtypes.Message, err error) error
-
 // printOutput prints all types of event information.
 // Each output includes the event type, actor id, name and action.
 // Actor attributes are printed at the end if the actor has any.
@@ -0,0 +1,126 @@
+package system
+
+import (
+	"fmt"
+
+	"github.com/docker/docker/api/client"
+	"github.com/docker/docker/api/client/container"
+	"github.com/docker/docker/api/client/image"
+	"github.com/docker/docker/api/client/network"
+	"github.com/docker/docker/api/client/volume"
+	"github.com/docker/docker/cli"
+	"github.com/docker/docker/cli/i18n"
+	"github.com/docker/docker/opts"
+	"github.com/docker/engine-api/types/filters"
+	"github.com/docker/go-units"
+	"github.com/spf13/cobra"
+)
+
+type pruneOptions struct {
+	force        bool
+	all          bool
+	pruneVolumes bool
+	filter       opts.FilterOpt
+}
+
+// NewPruneCommand creates a new cobra.Command for `docker system prune`
+func NewPruneCommand(dockerCli *client.DockerCli) *cobra.Command {
+	opts := pruneOptions{filter: opts.NewFilterOpt()}
+
+	cmd := &cobra.Command{
+		Use:   "prune [OPTIONS]",
+		Short: i18n.T("Remove unused data"),
+		Args:  cli.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runPrune(dockerCli, opts)
+		},
+	}
+
+	flags := cmd.Flags()
+	flags.BoolVarP(&opts.force, "force", "f", false, i18n.T("Do not prompt for confirmation"))
+	flags.BoolVarP(&opts.all, "all", "a", false, i18n.T("Remove all unused images, not just dangling ones"))
+	flags.BoolVar(&opts.pruneVolumes, "volumes", false, i18n.T("Prune volumes"))
+	flags.Var(&opts.filter, "filter", i18n.T("Provide filter values (e.g. 'label=<key>=<value>')"))
+
+	return cmd
+}
+
+// onlyLabelFilters returns whether pruneFilters filters on labels only, which
+// is all that pruning networks and volumes supports.
+func onlyLabelFilters(pruneFilters filters.Args) bool {
+	n := pruneFilters.Len()
+	if pruneFilters.Include("label") {
+		n--
+	}
+	return n == 0
+}
+
+func pruneWarning(all, pruneNetworks, pruneVolumes bool) string {
+	warning := i18n.T("WARNING! This will remove:") + "\n"
+	warning += "\t- " + i18n.T("all stopped containers") + "\n"
+	if pruneNetworks {
+		warning += "\t- " + i18n.T("all networks not used by at least one container") + "\n"
+	}
+	if pruneVolumes {
+		warning += "\t- " + i18n.T("all local volumes not used by at least one container") + "\n"
+	}
+	if all {
+		warning += "\t- " + i18n.T("all images without at least one container associated to them") + "\n"
+	} else {
+		warning += "\t- " + i18n.T("all dangling images") + "\n"
+	}
+	return warning + i18n.T("Are you sure you want to continue?")
+}
+
+func runPrune(dockerCli *client.DockerCli, opts pruneOptions) error {
+	// Volumes and networks can only be filtered by label. Rather than
+	// pruning all of them regardless of the other filters, they are left
+	// alone when such filters are given.
+	pruneFilters := opts.filter.Value()
+	pruneNetworks := onlyLabelFilters(pruneFilters)
+	pruneVolumes := opts.pruneVolumes && pruneNetworks
+
+	if !opts.force && !client.PromptForConfirmation(dockerCli.In(), dockerCli.Out(), pruneWarning(opts.all, pruneNetworks, pruneVolumes)) {
+		return nil
+	}
+	if !pruneNetworks {
+		fmt.Fprintln(dockerCli.Err(), i18n.T("WARNING: networks and volumes can only be filtered by label, they are not pruned"))
+	}
+
+	var spaceReclaimed uint64
+
+	spc, output, err := container.RunPrune(dockerCli, pruneFilters)
+	if err != nil {
+		return err
+	}
+	spaceReclaimed += spc
+	fmt.Fprint(dockerCli.Out(), output)
+
+	if pruneNetworks {
+		output, err = network.RunPrune(dockerCli, pruneFilters)
+		if err != nil {
+			return err
+		}
+		fmt.Fprint(dockerCli.Out(), output)
+	}
+
+	if pruneVolumes {
+		spc, output, err = volume.RunPrune(dockerCli, pruneFilters)
+		if err != nil {
+			return err
+		}
+		spaceReclaimed += spc
+		fmt.Fprint(dockerCli.Out(), output)
+	}
+
+	// Images are pruned last so that those of the removed containers can go.
+	spc, output, err = image.RunPrune(dockerCli, opts.all, pruneFilters)
+	if err != nil {
+		return err
+	}
+	spaceReclaimed += spc
+	fmt.Fprint(dockerCli.Out(), output)
+
+	fmt.Fprintln(dockerCli.Out(), i18n.T("Total reclaimed space:"), units.HumanSize(float64(spaceReclaimed)))
+	return nil
+}
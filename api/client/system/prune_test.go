@@ -0,0 +1,24 @@
+package system
+
+import (
+	"testing"
+
+	"github.com/docker/engine-api/types/filters"
+)
+
+func TestOnlyLabelFilters(t *testing.T) {
+	pruneFilters := filters.NewArgs()
+	if !onlyLabelFilters(pruneFilters) {
+		t.Fatal("expected no filters to be label only")
+	}
+
+	pruneFilters.Add("label", "foo=bar")
+	if !onlyLabelFilters(pruneFilters) {
+		t.Fatal("expected label filters to be label only")
+	}
+
+	pruneFilters.Add("until", "24h")
+	if onlyLabelFilters(pruneFilters) {
+		t.Fatal("expected until filter not to be label only")
+	}
+}
@@ -11,6 +11,7 @@ import (
 
 	"github.com/docker/docker/api/client"
 	"github.com/docker/docker/api/client/idresolver"
+	"github.com/docker/docker/cli/i18n"
 	"github.com/docker/engine-api/types/swarm"
 	"github.com/docker/go-units"
 )
@@ -48,7 +49,7 @@ func Print(dockerCli *client.DockerCli, ctx context.Context, tasks []swarm.Task,
 
 	// Ignore flushing errors
 	defer writer.Flush()
-	fmt.Fprintln(writer, strings.Join([]string{"ID", "名称", "镜像", "节点", "预期状态", "实际状态", "错误"}, "\t"))
+	fmt.Fprintln(writer, strings.Join([]string{"ID", i18n.T("NAME"), i18n.T("IMAGE"), i18n.T("NODE"), i18n.T("DESIRED STATE"), i18n.T("CURRENT STATE"), i18n.T("ERROR")}, "\t"))
 
 	prevName := ""
 	for _, task := range tasks {
@@ -22,6 +22,7 @@ import (
 	"github.com/docker/distribution/digest"
 	"github.com/docker/distribution/registry/client/auth"
 	"github.com/docker/distribution/registry/client/transport"
+	"github.com/docker/docker/cli/i18n"
 	"github.com/docker/docker/cliconfig"
 	"github.com/docker/docker/distribution"
 	"github.com/docker/docker/pkg/jsonmessage"
@@ -68,7 +69,7 @@ func setupTrustedFlag(verify bool) (bool, string) {
 	}
 	message := "Skip image signing"
 	if verify {
-		message = "跳过镜像验证"
+		message = i18n.T("Skip image verification")
 	}
 	return trusted, message
 }
@@ -104,7 +105,7 @@ func trustServer(index *registrytypes.IndexInfo) (string, error) {
 	if s := os.Getenv("DOCKER_CONTENT_TRUST_SERVER"); s != "" {
 		urlObj, err := url.Parse(s)
 		if err != nil || urlObj.Scheme != "https" {
-			return "", fmt.Errorf("valid https URL required for trust server, got %s", s)
+			return "", fmt.Errorf(i18n.T("valid https URL required for trust server, got %s"), s)
 		}
 
 		return s, nil
@@ -208,7 +209,7 @@ func (cli *DockerCli) getNotaryRepository(repoInfo *registry.RepositoryInfo, aut
 func convertTarget(t client.Target) (target, error) {
 	h, ok := t.Hashes["sha256"]
 	if !ok {
-		return target{}, errors.New("no valid hash, expecting sha256")
+		return target{}, errors.New(i18n.T("no valid hash, expecting sha256"))
 	}
 	return target{
 		reference: registry.ParseReference(t.Name),
@@ -256,7 +257,7 @@ func (cli *DockerCli) TrustedReference(ctx context.Context, ref reference.NamedT
 
 	notaryRepo, err := cli.getNotaryRepository(repoInfo, authConfig, "pull")
 	if err != nil {
-		fmt.Fprintf(cli.out, "Error establishing connection to trust repository: %s\n", err)
+		fmt.Fprintf(cli.out, i18n.T("Error establishing connection to trust repository: %s\n"), err)
 		return nil, err
 	}
 
@@ -267,7 +268,7 @@ func (cli *DockerCli) TrustedReference(ctx context.Context, ref reference.NamedT
 	// Only list tags in the top level targets role or the releases delegation role - ignore
 	// all other delegation roles
 	if t.Role != releasesRole && t.Role != data.CanonicalTargetsRole {
-		return nil, notaryError(repoInfo.FullName(), fmt.Errorf("No trust data for %s", ref.Tag()))
+		return nil, notaryError(repoInfo.FullName(), fmt.Errorf(i18n.T("No trust data for %s"), ref.Tag()))
 	}
 	r, err := convertTarget(t.Target)
 	if err != nil {
@@ -280,7 +281,7 @@ func (cli *DockerCli) TrustedReference(ctx context.Context, ref reference.NamedT
 
 // TagTrusted tags a trusted ref
 func (cli *DockerCli) TagTrusted(ctx context.Context, trustedRef reference.Canonical, ref reference.NamedTagged) error {
-	fmt.Fprintf(cli.out, "Tagging %s as %s\n", trustedRef.String(), ref.String())
+	fmt.Fprintf(cli.out, i18n.T("Tagging %s as %s\n"), trustedRef.String(), ref.String())
 
 	return cli.client.ImageTag(ctx, trustedRef.String(), ref.String())
 }
@@ -289,27 +290,27 @@ func notaryError(repoName string, err error) error {
 	switch err.(type) {
 	case *json.SyntaxError:
 		logrus.Debugf("Notary syntax error: %s", err)
-		return fmt.Errorf("Error: no trust data available for remote repository %s. Try running notary server and setting DOCKER_CONTENT_TRUST_SERVER to its HTTPS address?", repoName)
+		return fmt.Errorf(i18n.T("Error: no trust data available for remote repository %s. Try running notary server and setting DOCKER_CONTENT_TRUST_SERVER to its HTTPS address?"), repoName)
 	case signed.ErrExpired:
-		return fmt.Errorf("Error: remote repository %s out-of-date: %v", repoName, err)
+		return fmt.Errorf(i18n.T("Error: remote repository %s out-of-date: %v"), repoName, err)
 	case trustmanager.ErrKeyNotFound:
-		return fmt.Errorf("Error: signing keys for remote repository %s not found: %v", repoName, err)
+		return fmt.Errorf(i18n.T("Error: signing keys for remote repository %s not found: %v"), repoName, err)
 	case *net.OpError:
-		return fmt.Errorf("Error: error contacting notary server: %v", err)
+		return fmt.Errorf(i18n.T("Error: error contacting notary server: %v"), err)
 	case store.ErrMetaNotFound:
-		return fmt.Errorf("Error: trust data missing for remote repository %s or remote repository not found: %v", repoName, err)
+		return fmt.Errorf(i18n.T("Error: trust data missing for remote repository %s or remote repository not found: %v"), repoName, err)
 	case signed.ErrInvalidKeyType:
-		return fmt.Errorf("Warning: potential malicious behavior - trust data mismatch for remote repository %s: %v", repoName, err)
+		return fmt.Errorf(i18n.T("Warning: potential malicious behavior - trust data mismatch for remote repository %s: %v"), repoName, err)
 	case signed.ErrNoKeys:
-		return fmt.Errorf("Error: could not find signing keys for remote repository %s, or could not decrypt signing key: %v", repoName, err)
+		return fmt.Errorf(i18n.T("Error: could not find signing keys for remote repository %s, or could not decrypt signing key: %v"), repoName, err)
 	case signed.ErrLowVersion:
-		return fmt.Errorf("Warning: potential malicious behavior - trust data version is lower than expected for remote repository %s: %v", repoName, err)
+		return fmt.Errorf(i18n.T("Warning: potential malicious behavior - trust data version is lower than expected for remote repository %s: %v"), repoName, err)
 	case signed.ErrRoleThreshold:
-		return fmt.Errorf("Warning: potential malicious behavior - trust data has insufficient signatures for remote repository %s: %v", repoName, err)
+		return fmt.Errorf(i18n.T("Warning: potential malicious behavior - trust data has insufficient signatures for remote repository %s: %v"), repoName, err)
 	case client.ErrRepositoryNotExist:
-		return fmt.Errorf("Error: remote trust data does not exist for %s: %v", repoName, err)
+		return fmt.Errorf(i18n.T("Error: remote trust data does not exist for %s: %v"), repoName, err)
 	case signed.ErrInsufficientSignatures:
-		return fmt.Errorf("Error: could not produce valid signature for %s.  If Yubikey was used, was touch input provided?: %v", repoName, err)
+		return fmt.Errorf(i18n.T("Error: could not produce valid signature for %s.  If Yubikey was used, was touch input provided?: %v"), repoName, err)
 	}
 
 	return err
@@ -321,7 +322,7 @@ func (cli *DockerCli) TrustedPull(ctx context.Context, repoInfo *registry.Reposi
 
 	notaryRepo, err := cli.getNotaryRepository(repoInfo, authConfig, "pull")
 	if err != nil {
-		fmt.Fprintf(cli.out, "Error establishing connection to trust repository: %s\n", err)
+		fmt.Fprintf(cli.out, i18n.T("Error establishing connection to trust repository: %s\n"), err)
 		return err
 	}
 
@@ -334,7 +335,7 @@ func (cli *DockerCli) TrustedPull(ctx context.Context, repoInfo *registry.Reposi
 		for _, tgt := range targets {
 			t, err := convertTarget(tgt.Target)
 			if err != nil {
-				fmt.Fprintf(cli.out, "Skipping target for %q\n", repoInfo.Name())
+				fmt.Fprintf(cli.out, i18n.T("Skipping target for %q\n"), repoInfo.Name())
 				continue
 			}
 			// Only list tags in the top level targets role or the releases delegation role - ignore
@@ -345,7 +346,7 @@ func (cli *DockerCli) TrustedPull(ctx context.Context, repoInfo *registry.Reposi
 			refs = append(refs, t)
 		}
 		if len(refs) == 0 {
-			return notaryError(repoInfo.FullName(), fmt.Errorf("No trusted tags for %s", repoInfo.FullName()))
+			return notaryError(repoInfo.FullName(), fmt.Errorf(i18n.T("No trusted tags for %s"), repoInfo.FullName()))
 		}
 	} else {
 		t, err := notaryRepo.GetTargetByName(ref.String(), releasesRole, data.CanonicalTargetsRole)
@@ -355,7 +356,7 @@ func (cli *DockerCli) TrustedPull(ctx context.Context, repoInfo *registry.Reposi
 		// Only get the tag if it's in the top level targets role or the releases delegation role
 		// ignore it if it's in any other delegation roles
 		if t.Role != releasesRole && t.Role != data.CanonicalTargetsRole {
-			return notaryError(repoInfo.FullName(), fmt.Errorf("No trust data for %s", ref.String()))
+			return notaryError(repoInfo.FullName(), fmt.Errorf(i18n.T("No trust data for %s"), ref.String()))
 		}
 
 		logrus.Debugf("retrieving target for %s role\n", t.Role)
@@ -372,7 +373,7 @@ func (cli *DockerCli) TrustedPull(ctx context.Context, repoInfo *registry.Reposi
 		if displayTag != "" {
 			displayTag = ":" + displayTag
 		}
-		fmt.Fprintf(cli.out, "Pull (%d of %d): %s%s@%s\n", i+1, len(refs), repoInfo.Name(), displayTag, r.digest)
+		fmt.Fprintf(cli.out, i18n.T("Pull (%d of %d): %s%s@%s\n"), i+1, len(refs), repoInfo.Name(), displayTag, r.digest)
 
 		ref, err := reference.WithDigest(repoInfo, r.digest)
 		if err != nil {
@@ -439,7 +440,7 @@ func (cli *DockerCli) TrustedPush(ctx context.Context, repoInfo *registry.Reposi
 	var tag string
 	switch x := ref.(type) {
 	case reference.Canonical:
-		return errors.New("cannot push a digest reference")
+		return errors.New(i18n.T("cannot push a digest reference"))
 	case reference.NamedTagged:
 		tag = x.Tag()
 	}
@@ -450,7 +451,7 @@ func (cli *DockerCli) TrustedPush(ctx context.Context, repoInfo *registry.Reposi
 		if err = jsonmessage.DisplayJSONMessagesStream(responseBody, cli.out, cli.outFd, cli.isTerminalOut, nil); err != nil {
 			return err
 		}
-		fmt.Fprintln(cli.out, "No tag specified, skipping trust metadata push")
+		fmt.Fprintln(cli.out, i18n.T("No tag specified, skipping trust metadata push"))
 		return nil
 	}
 
@@ -463,15 +464,15 @@ func (cli *DockerCli) TrustedPush(ctx context.Context, repoInfo *registry.Reposi
 	}
 
 	if target == nil {
-		fmt.Fprintln(cli.out, "No targets found, please provide a specific tag in order to sign it")
+		fmt.Fprintln(cli.out, i18n.T("No targets found, please provide a specific tag in order to sign it"))
 		return nil
 	}
 
-	fmt.Fprintln(cli.out, "Signing and pushing trust metadata")
+	fmt.Fprintln(cli.out, i18n.T("Signing and pushing trust metadata"))
 
 	repo, err := cli.getNotaryRepository(repoInfo, authConfig, "push", "pull")
 	if err != nil {
-		fmt.Fprintf(cli.out, "Error establishing connection to notary repository: %s\n", err)
+		fmt.Fprintf(cli.out, i18n.T("Error establishing connection to notary repository: %s\n"), err)
 		return err
 	}
 
@@ -498,7 +499,7 @@ func (cli *DockerCli) TrustedPush(ctx context.Context, repoInfo *registry.Reposi
 		if err := repo.Initialize(rootKeyID, data.CanonicalSnapshotRole); err != nil {
 			return notaryError(repoInfo.FullName(), err)
 		}
-		fmt.Fprintf(cli.out, "Finished initializing %q\n", repoInfo.FullName())
+		fmt.Fprintf(cli.out, i18n.T("Finished initializing %q\n"), repoInfo.FullName())
 		err = repo.AddTarget(target, data.CanonicalTargetsRole)
 	case nil:
 		// already initialized and we have successfully downloaded the latest metadata
@@ -512,11 +513,11 @@ func (cli *DockerCli) TrustedPush(ctx context.Context, repoInfo *registry.Reposi
 	}
 
 	if err != nil {
-		fmt.Fprintf(cli.out, "Failed to sign %q:%s - %s\n", repoInfo.FullName(), tag, err.Error())
+		fmt.Fprintf(cli.out, i18n.T("Failed to sign %q:%s - %s\n"), repoInfo.FullName(), tag, err.Error())
 		return notaryError(repoInfo.FullName(), err)
 	}
 
-	fmt.Fprintf(cli.out, "Successfully signed %q:%s\n", repoInfo.FullName(), tag)
+	fmt.Fprintf(cli.out, i18n.T("Successfully signed %q:%s\n"), repoInfo.FullName(), tag)
 	return nil
 }
 
@@ -562,7 +563,7 @@ func (cli *DockerCli) addTargetToAllSignableRoles(repo *client.NotaryRepository,
 	}
 
 	if len(signableRoles) == 0 {
-		return fmt.Errorf("no valid signing keys for delegation roles")
+		return errors.New(i18n.T("no valid signing keys for delegation roles"))
 	}
 
 	return repo.AddTarget(target, signableRoles...)
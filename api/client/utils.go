@@ -14,6 +14,7 @@ import (
 	"golang.org/x/net/context"
 
 	"github.com/Sirupsen/logrus"
+	"github.com/docker/docker/cli/i18n"
 	"github.com/docker/docker/pkg/signal"
 	"github.com/docker/docker/pkg/term"
 	"github.com/docker/engine-api/client"
@@ -153,7 +154,7 @@ func (cli *DockerCli) ForwardAllSignals(ctx context.Context, cid string) chan os
 				}
 			}
 			if sig == "" {
-				fmt.Fprintf(cli.err, "Unsupported signal: %v. Discarding.\n", s)
+				fmt.Fprintf(cli.err, i18n.T("Unsupported signal: %v. Discarding.\n"), s)
 				continue
 			}
 
@@ -188,3 +189,16 @@ func PrettyPrint(i interface{}) string {
 		return capitalizeFirst(fmt.Sprintf("%s", t))
 	}
 }
+
+// PromptForConfirmation displays message followed by " [y/N] " and returns
+// whether the user answered y or Y.
+func PromptForConfirmation(ins io.Reader, outs io.Writer, message string) bool {
+	fmt.Fprint(outs, message+" "+i18n.T("[y/N]")+" ")
+
+	answer := ""
+	n, _ := fmt.Fscan(ins, &answer)
+	if n != 1 || (answer != "y" && answer != "Y") {
+		return false
+	}
+	return true
+}
@@ -0,0 +1,30 @@
+package client
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestPromptForConfirmation(t *testing.T) {
+	cases := []struct {
+		input    string
+		expected bool
+	}{
+		{"y\n", true},
+		{"Y\n", true},
+		{"n\n", false},
+		{"yes\n", false},
+		{"\n", false},
+		{"", false},
+	}
+	for _, c := range cases {
+		out := &bytes.Buffer{}
+		if got := PromptForConfirmation(strings.NewReader(c.input), out, "Continue?"); got != c.expected {
+			t.Fatalf("expected %v for input %q, got %v", c.expected, c.input, got)
+		}
+		if !strings.HasPrefix(out.String(), "Continue? ") {
+			t.Fatalf("unexpected prompt %q", out.String())
+		}
+	}
+}
@@ -7,13 +7,14 @@ import (
 
 	"github.com/docker/docker/api/client"
 	"github.com/docker/docker/cli"
+	"github.com/docker/docker/cli/i18n"
 )
 
 // NewVolumeCommand returns a cobra command for `volume` subcommands
 func NewVolumeCommand(dockerCli *client.DockerCli) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "volume COMMAND",
-		Short: "管理Docker存储卷",
+		Short: i18n.T("Manage Docker volumes"),
 		Long:  volumeDescription,
 		Args:  cli.NoArgs,
 		Run: func(cmd *cobra.Command, args []string) {
@@ -24,6 +25,7 @@ func NewVolumeCommand(dockerCli *client.DockerCli) *cobra.Command {
 		newCreateCommand(dockerCli),
 		newInspectCommand(dockerCli),
 		newListCommand(dockerCli),
+		newPruneCommand(dockerCli),
 		newRemoveCommand(dockerCli),
 	)
 	return cmd
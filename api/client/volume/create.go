@@ -7,6 +7,7 @@ import (
 
 	"github.com/docker/docker/api/client"
 	"github.com/docker/docker/cli"
+	"github.com/docker/docker/cli/i18n"
 	"github.com/docker/docker/opts"
 	runconfigopts "github.com/docker/docker/runconfig/opts"
 	"github.com/docker/engine-api/types"
@@ -27,7 +28,7 @@ func newCreateCommand(dockerCli *client.DockerCli) *cobra.Command {
 
 	cmd := &cobra.Command{
 		Use:   "create [OPTIONS]",
-		Short: "创建一个数据存储卷",
+		Short: i18n.T("Create a volume"),
 		Long:  createDescription,
 		Args:  cli.NoArgs,
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -35,10 +36,10 @@ func newCreateCommand(dockerCli *client.DockerCli) *cobra.Command {
 		},
 	}
 	flags := cmd.Flags()
-	flags.StringVarP(&opts.driver, "driver", "d", "local", "指定存储驱动的名称")
-	flags.StringVar(&opts.name, "name", "", "指定存储卷的名称")
-	flags.VarP(&opts.driverOpts, "opt", "o", "设置驱动的指定参数")
-	flags.StringSliceVar(&opts.labels, "label", []string{}, "为一个存储卷设置元数据")
+	flags.StringVarP(&opts.driver, "driver", "d", "local", i18n.T("Specify volume driver name"))
+	flags.StringVar(&opts.name, "name", "", i18n.T("Specify volume name"))
+	flags.VarP(&opts.driverOpts, "opt", "o", i18n.T("Set driver specific options"))
+	flags.StringSliceVar(&opts.labels, "label", []string{}, i18n.T("Set metadata for a volume"))
 
 	return cmd
 }
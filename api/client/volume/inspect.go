@@ -6,6 +6,7 @@ import (
 	"github.com/docker/docker/api/client"
 	"github.com/docker/docker/api/client/inspect"
 	"github.com/docker/docker/cli"
+	"github.com/docker/docker/cli/i18n"
 	"github.com/spf13/cobra"
 )
 
@@ -19,7 +20,7 @@ func newInspectCommand(dockerCli *client.DockerCli) *cobra.Command {
 
 	cmd := &cobra.Command{
 		Use:   "inspect [OPTIONS] VOLUME [VOLUME...]",
-		Short: "显示一个或多个存储卷的详细信息",
+		Short: i18n.T("Display detailed information on one or more volumes"),
 		Long:  inspectDescription,
 		Args:  cli.RequiresMinArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -28,7 +29,7 @@ func newInspectCommand(dockerCli *client.DockerCli) *cobra.Command {
 		},
 	}
 
-	cmd.Flags().StringVarP(&opts.format, "format", "f", "", "基于指定的Go语言模板格式化命令输出内容")
+	cmd.Flags().StringVarP(&opts.format, "format", "f", "", i18n.T("Format the output using the given go template"))
 
 	return cmd
 }
@@ -9,6 +9,7 @@ import (
 
 	"github.com/docker/docker/api/client"
 	"github.com/docker/docker/cli"
+	"github.com/docker/docker/cli/i18n"
 	"github.com/docker/engine-api/types"
 	"github.com/docker/engine-api/types/filters"
 	"github.com/spf13/cobra"
@@ -33,7 +34,7 @@ func newListCommand(dockerCli *client.DockerCli) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:     "ls [OPTIONS]",
 		Aliases: []string{"list"},
-		Short:   "罗列所有存储卷",
+		Short:   i18n.T("List volumes"),
 		Long:    listDescription,
 		Args:    cli.NoArgs,
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -42,8 +43,8 @@ func newListCommand(dockerCli *client.DockerCli) *cobra.Command {
 	}
 
 	flags := cmd.Flags()
-	flags.BoolVarP(&opts.quiet, "quiet", "q", false, "仅显示存储卷名称")
-	flags.StringSliceVarP(&opts.filter, "filter", "f", []string{}, "提供过滤信息 (比如 'dangling=true')")
+	flags.BoolVarP(&opts.quiet, "quiet", "q", false, i18n.T("Only display volume names"))
+	flags.StringSliceVarP(&opts.filter, "filter", "f", []string{}, i18n.T("Provide filter values (i.e. 'dangling=true')"))
 
 	return cmd
 }
@@ -70,7 +71,7 @@ func runList(dockerCli *client.DockerCli, opts listOptions) error {
 		for _, warn := range volumes.Warnings {
 			fmt.Fprintln(dockerCli.Err(), warn)
 		}
-		fmt.Fprintf(w, "DRIVER \tVOLUME NAME")
+		fmt.Fprint(w, i18n.T("DRIVER \tVOLUME NAME"))
 		fmt.Fprintf(w, "\n")
 	}
 
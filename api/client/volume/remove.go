@@ -7,6 +7,7 @@ import (
 
 	"github.com/docker/docker/api/client"
 	"github.com/docker/docker/cli"
+	"github.com/docker/docker/cli/i18n"
 	"github.com/spf13/cobra"
 )
 
@@ -14,7 +15,7 @@ func newRemoveCommand(dockerCli *client.DockerCli) *cobra.Command {
 	return &cobra.Command{
 		Use:     "rm VOLUME [VOLUME...]",
 		Aliases: []string{"remove"},
-		Short:   "删除一个或多个存储卷",
+		Short:   i18n.T("Remove one or more volumes"),
 		Long:    removeDescription,
 		Example: removeExample,
 		Args:    cli.RequiresMinArgs(1),
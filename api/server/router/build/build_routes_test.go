@@ -0,0 +1,44 @@
+package build
+
+import (
+	"net/http"
+	"net/url"
+	"reflect"
+	"testing"
+
+	"golang.org/x/net/context"
+)
+
+func TestNewImageBuildOptionsNetwork(t *testing.T) {
+	v := url.Values{}
+	v.Set("networkmode", "host")
+	v.Add("extrahosts", "foo:10.0.0.1")
+	v.Add("extrahosts", "bar:10.0.0.2")
+	r, _ := http.NewRequest("POST", "", nil)
+	r.Form = v
+
+	options, err := newImageBuildOptions(context.Background(), r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if options.NetworkMode != "host" {
+		t.Fatalf("expected network mode host, got %q", options.NetworkMode)
+	}
+	expected := []string{"foo:10.0.0.1", "bar:10.0.0.2"}
+	if !reflect.DeepEqual(options.ExtraHosts, expected) {
+		t.Fatalf("expected extra hosts %v, got %v", expected, options.ExtraHosts)
+	}
+}
+
+func TestNewImageBuildOptionsNetworkDefault(t *testing.T) {
+	r, _ := http.NewRequest("POST", "", nil)
+	r.Form = url.Values{}
+
+	options, err := newImageBuildOptions(context.Background(), r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if options.NetworkMode != "" || len(options.ExtraHosts) != 0 {
+		t.Fatalf("expected no network settings, got %q and %v", options.NetworkMode, options.ExtraHosts)
+	}
+}
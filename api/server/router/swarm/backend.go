@@ -1,8 +1,10 @@
 package swarm
 
 import (
+	"github.com/docker/docker/api/types/backend"
 	basictypes "github.com/docker/engine-api/types"
 	types "github.com/docker/engine-api/types/swarm"
+	"golang.org/x/net/context"
 )
 
 // Backend abstracts an swarm commands manager.
@@ -12,15 +14,22 @@ type Backend interface {
 	Leave(force bool) error
 	Inspect() (types.Swarm, error)
 	Update(uint64, types.Spec, types.UpdateFlags) error
+	Backup(req types.BackupRequest) ([]byte, error)
+	Restore(req types.RestoreRequest) (string, error)
 	GetServices(basictypes.ServiceListOptions) ([]types.Service, error)
 	GetService(string) (types.Service, error)
 	CreateService(types.ServiceSpec, string) (string, error)
-	UpdateService(string, uint64, types.ServiceSpec, string) error
+	UpdateService(string, uint64, types.ServiceSpec, string, string) error
 	RemoveService(string) error
+	ServiceLogs(context.Context, string, *backend.ContainerLogsConfig, chan struct{}) error
 	GetNodes(basictypes.NodeListOptions) ([]types.Node, error)
 	GetNode(string) (types.Node, error)
 	UpdateNode(string, uint64, types.NodeSpec) error
 	RemoveNode(string, bool) error
 	GetTasks(basictypes.TaskListOptions) ([]types.Task, error)
 	GetTask(string) (types.Task, error)
+	GetSecrets(basictypes.SecretListOptions) ([]types.Secret, error)
+	GetSecret(string) (types.Secret, error)
+	CreateSecret(types.SecretSpec) (string, error)
+	RemoveSecret(string) error
 }
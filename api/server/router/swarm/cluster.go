@@ -29,7 +29,10 @@ func (sr *swarmRouter) initRoutes() {
 		router.NewPostRoute("/swarm/leave", sr.leaveCluster),
 		router.NewGetRoute("/swarm", sr.inspectCluster),
 		router.NewPostRoute("/swarm/update", sr.updateCluster),
+		router.NewPostRoute("/swarm/backup", sr.backupCluster),
+		router.NewPostRoute("/swarm/restore", sr.restoreCluster),
 		router.NewGetRoute("/services", sr.getServices),
+		router.Cancellable(router.NewGetRoute("/services/{id:.*}/logs", sr.getServiceLogs)),
 		router.NewGetRoute("/services/{id:.*}", sr.getService),
 		router.NewPostRoute("/services/create", sr.createService),
 		router.NewPostRoute("/services/{id:.*}/update", sr.updateService),
@@ -40,5 +43,9 @@ func (sr *swarmRouter) initRoutes() {
 		router.NewPostRoute("/nodes/{id:.*}/update", sr.updateNode),
 		router.NewGetRoute("/tasks", sr.getTasks),
 		router.NewGetRoute("/tasks/{id:.*}", sr.getTask),
+		router.NewGetRoute("/secrets", sr.getSecrets),
+		router.NewPostRoute("/secrets/create", sr.createSecret),
+		router.NewGetRoute("/secrets/{id:.*}", sr.getSecret),
+		router.NewDeleteRoute("/secrets/{id:.*}", sr.removeSecret),
 	}
 }
@@ -6,6 +6,7 @@ package backend
 import (
 	"io"
 
+	"github.com/docker/docker/pkg/progress"
 	"github.com/docker/docker/pkg/streamformatter"
 	"github.com/docker/engine-api/types"
 )
@@ -82,4 +83,7 @@ type ProgressWriter struct {
 	StdoutFormatter    *streamformatter.StdoutFormatter
 	StderrFormatter    *streamformatter.StderrFormatter
 	ProgressReaderFunc func(io.ReadCloser) io.ReadCloser
+	// AuxOutput receives the structured progress events of a build, sent as
+	// auxiliary data. It may be nil.
+	AuxOutput progress.Output
 }
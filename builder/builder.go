@@ -137,7 +137,38 @@ type Backend interface {
 	// with Context.Walk
 	//ContainerCopy(name string, res string) (io.ReadCloser, error)
 	// TODO: use copyBackend api
-	CopyOnBuild(containerID string, destPath string, src FileInfo, decompress bool) error
+	// chown, if not empty, is the user[:group] owning the copied files.
+	CopyOnBuild(containerID string, destPath string, src FileInfo, decompress bool, chown string) error
+	// MountImageOnBuild mounts the filesystem of an image, to copy files out
+	// of it, and returns its path and a function unmounting it.
+	MountImageOnBuild(imageID string) (string, func() error, error)
+	// SquashImage creates an image with the layers added to parent by the
+	// image with the given ID merged into one, and returns its ID.
+	SquashImage(id, parent string) (string, error)
+	// LayerOnBuild returns the ID and size of the layer added by the image
+	// with the given ID on top of parent, or an empty ID if it added none.
+	LayerOnBuild(id, parent string) (string, int64, error)
+}
+
+// BuildStep describes a step of a build once it is done. It is sent as
+// auxiliary data in the progress stream of the build.
+type BuildStep struct {
+	// Step is the index of the step, starting at 1.
+	Step int `json:"step"`
+	// Instruction is the instruction of the step, as in the Dockerfile.
+	Instruction string `json:"instruction"`
+	// Cached is set when the result of the step was found in the cache.
+	Cached bool `json:"cached"`
+	// ImageID is the image resulting from the step.
+	ImageID string `json:"imageID,omitempty"`
+	// LayerID is the ID of the layer added by the step, if any.
+	LayerID string `json:"layerID,omitempty"`
+	// Duration is the time the step took, in nanoseconds.
+	Duration time.Duration `json:"duration"`
+	// Size is the number of bytes added by the step.
+	Size int64 `json:"size"`
+	// Error is set when the step failed.
+	Error string `json:"error,omitempty"`
 }
 
 // Image represents a Docker image used by the builder.
@@ -153,3 +184,11 @@ type ImageCache interface {
 	// and runconfig equals `cfg`. A cache miss is expected to return an empty ID and a nil error.
 	GetCachedImageOnBuild(parentID string, cfg *container.Config) (imageID string, err error)
 }
+
+// ImageCacheBuilder represents a generator for stateful image cache.
+type ImageCacheBuilder interface {
+	// MakeImageCache returns an image cache that also matches the layers
+	// and history of the given images, which can be pulled ones without
+	// any local build history.
+	MakeImageCache(cacheFrom []string) ImageCache
+}
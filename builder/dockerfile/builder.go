@@ -12,8 +12,10 @@ import (
 	"github.com/Sirupsen/logrus"
 	"github.com/docker/docker/api/types/backend"
 	"github.com/docker/docker/builder"
+	"github.com/docker/docker/builder/dockerfile/command"
 	"github.com/docker/docker/builder/dockerfile/parser"
 	"github.com/docker/docker/image"
+	"github.com/docker/docker/pkg/progress"
 	"github.com/docker/docker/pkg/stringid"
 	"github.com/docker/docker/reference"
 	"github.com/docker/engine-api/types"
@@ -35,16 +37,7 @@ var validCommitCommands = map[string]bool{
 }
 
 // BuiltinAllowedBuildArgs is list of built-in allowed build args
-var BuiltinAllowedBuildArgs = map[string]bool{
-	"HTTP_PROXY":  true,
-	"http_proxy":  true,
-	"HTTPS_PROXY": true,
-	"https_proxy": true,
-	"FTP_PROXY":   true,
-	"ftp_proxy":   true,
-	"NO_PROXY":    true,
-	"no_proxy":    true,
-}
+var BuiltinAllowedBuildArgs = command.BuiltinAllowedBuildArgs
 
 // Builder is a Dockerfile builder
 // It implements the builder.Backend interface.
@@ -70,13 +63,28 @@ type Builder struct {
 	cmdSet           bool
 	disableCommit    bool
 	cacheBusted      bool
-	allowedBuildArgs map[string]bool // list of build-time args that are allowed for expansion/substitution and passing to commands in 'run'.
+	allowedBuildArgs map[string]bool   // list of build-time args that are allowed for expansion/substitution and passing to commands in 'run'.
+	buildArgDefaults map[string]string // default values of the ARGs declared in the current stage
+	declaredArgs     map[string]bool   // build-time args declared by an ARG in any stage
 	directive        parser.Directive
+	stages           []buildStage      // stages of the build, started by each FROM
+	metaArgs         map[string]string // values of the ARGs declared before the first FROM
+	imageCache       builder.ImageCache
+	aux              progress.Output // receives a builder.BuildStep for every step, if not nil
+	cacheHit         bool            // whether the current step used the cache
 
 	// TODO: remove once docker.Commit can receive a tag
 	id string
 }
 
+// buildStage is a stage of a multi-stage build. It is named by the optional
+// AS clause of the FROM instruction starting it.
+type buildStage struct {
+	name  string
+	base  string // the image of the FROM instruction, empty for scratch
+	image string // the image built by the stage, once it is finished
+}
+
 // BuildManager implements builder.Backend and is shared across all Builder objects.
 type BuildManager struct {
 	backend builder.Backend
@@ -105,6 +113,7 @@ func (bm *BuildManager) BuildFromContext(ctx context.Context, src io.ReadCloser,
 	if err != nil {
 		return "", err
 	}
+	b.aux = pg.AuxOutput
 	return b.build(pg.StdoutFormatter, pg.StderrFormatter, pg.Output)
 }
 
@@ -131,6 +140,9 @@ func NewBuilder(clientCtx context.Context, config *types.ImageBuildOptions, back
 		tmpContainers:    map[string]struct{}{},
 		id:               stringid.GenerateNonCryptoID(),
 		allowedBuildArgs: make(map[string]bool),
+		buildArgDefaults: make(map[string]string),
+		declaredArgs:     make(map[string]bool),
+		metaArgs:         make(map[string]string),
 		directive: parser.Directive{
 			EscapeSeen:           false,
 			LookingForDirectives: true,
@@ -138,6 +150,12 @@ func NewBuilder(clientCtx context.Context, config *types.ImageBuildOptions, back
 	}
 	parser.SetEscapeToken(parser.DefaultEscapeToken, &b.directive) // Assume the default token for escape
 
+	if icb, ok := backend.(builder.ImageCacheBuilder); ok {
+		b.imageCache = icb.MakeImageCache(config.CacheFrom)
+	} else if c, ok := backend.(builder.ImageCache); ok {
+		b.imageCache = c
+	}
+
 	if dockerfile != nil {
 		b.dockerfile, err = parser.Parse(dockerfile, &b.directive)
 		if err != nil {
@@ -220,6 +238,12 @@ func (b *Builder) build(stdout io.Writer, stderr io.Writer, out io.Writer) (stri
 		return "", err
 	}
 
+	if b.options.Target != "" {
+		if err := b.truncateToTarget(); err != nil {
+			return "", err
+		}
+	}
+
 	if len(b.options.Labels) > 0 {
 		line := "LABEL "
 		for k, v := range b.options.Labels {
@@ -242,12 +266,15 @@ func (b *Builder) build(stdout io.Writer, stderr io.Writer, out io.Writer) (stri
 		default:
 			// Not cancelled yet, keep going...
 		}
+		step := b.startStep(i, n)
 		if err := b.dispatch(i, n); err != nil {
+			b.emitStep(step, err)
 			if b.options.ForceRemove {
 				b.clearTmp()
 			}
 			return "", err
 		}
+		b.emitStep(step, nil)
 
 		shortImgID = stringid.TruncateID(b.image)
 		fmt.Fprintf(b.Stdout, " ---> %s\n", shortImgID)
@@ -260,7 +287,7 @@ func (b *Builder) build(stdout io.Writer, stderr io.Writer, out io.Writer) (stri
 	// consumed during build. Return an error, if there are any.
 	leftoverArgs := []string{}
 	for arg := range b.options.BuildArgs {
-		if !b.isBuildArgAllowed(arg) {
+		if _, ok := b.metaArgs[arg]; !ok && !b.declaredArgs[arg] && !b.isBuildArgAllowed(arg) {
 			leftoverArgs = append(leftoverArgs, arg)
 		}
 	}
@@ -272,6 +299,13 @@ func (b *Builder) build(stdout io.Writer, stderr io.Writer, out io.Writer) (stri
 		return "", fmt.Errorf("No image was generated. Is your Dockerfile empty?")
 	}
 
+	if b.options.Squash {
+		if err := b.squash(); err != nil {
+			return "", err
+		}
+		shortImgID = stringid.TruncateID(b.image)
+	}
+
 	imageID := image.ID(b.image)
 	for _, rt := range repoAndTags {
 		if err := b.docker.TagImageWithReference(imageID, rt); err != nil {
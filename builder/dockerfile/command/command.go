@@ -44,3 +44,16 @@ var Commands = map[string]struct{}{
 	Volume:      {},
 	Workdir:     {},
 }
+
+// BuiltinAllowedBuildArgs is list of built-in allowed build args, which can
+// be passed to a build without being declared by an ARG instruction.
+var BuiltinAllowedBuildArgs = map[string]bool{
+	"HTTP_PROXY":  true,
+	"http_proxy":  true,
+	"HTTPS_PROXY": true,
+	"https_proxy": true,
+	"FTP_PROXY":   true,
+	"ftp_proxy":   true,
+	"NO_PROXY":    true,
+	"no_proxy":    true,
+}
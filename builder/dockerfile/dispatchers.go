@@ -142,48 +142,87 @@ func label(b *Builder, args []string, attributes map[string]bool, original strin
 //
 // Add the file 'foo' to '/path'. Tarball and Remote URL (git, http) handling
 // exist here. If you do not wish to have this automatic handling, use COPY.
+// With --chown=user:group, the added files are owned by the given user and
+// group instead of root.
 //
 func add(b *Builder, args []string, attributes map[string]bool, original string) error {
 	if len(args) < 2 {
 		return errAtLeastOneArgument("ADD")
 	}
 
+	flChown := b.flags.AddString("chown", "")
+
 	if err := b.flags.Parse(); err != nil {
 		return err
 	}
 
-	return b.runContextCommand(args, true, true, "ADD")
+	return b.runContextCommand(args, true, true, "ADD", b.context, flChown.Value)
 }
 
 // COPY foo /path
 //
-// Same as 'ADD' but without the tar and remote url handling.
+// Same as 'ADD' but without the tar and remote url handling. With --from,
+// the files are copied from a previous build stage or an image instead of
+// the build context. --chown is the same as for ADD.
 //
 func dispatchCopy(b *Builder, args []string, attributes map[string]bool, original string) error {
 	if len(args) < 2 {
 		return errAtLeastOneArgument("COPY")
 	}
 
+	flFrom := b.flags.AddString("from", "")
+	flChown := b.flags.AddString("chown", "")
+
 	if err := b.flags.Parse(); err != nil {
 		return err
 	}
 
-	return b.runContextCommand(args, false, false, "COPY")
+	if flFrom.Value == "" {
+		return b.runContextCommand(args, false, false, "COPY", b.context, flChown.Value)
+	}
+
+	ctx, err := b.imageContext(flFrom.Value)
+	if err != nil {
+		return err
+	}
+	defer ctx.Close()
+
+	return b.runContextCommand(args, false, false, "COPY", ctx, flChown.Value)
 }
 
-// FROM imagename
+// validStageName matches the names of build stages.
+var validStageName = regexp.MustCompile(`^[a-z][a-z0-9-_\.]*$`)
+
+// FROM imagename [AS name]
 //
-// This sets the image the dockerfile will build on top of.
+// This sets the image the dockerfile will build on top of, and starts a new
+// build stage. The stage can be named, for COPY --from and --target to refer
+// to it.
 //
 func from(b *Builder, args []string, attributes map[string]bool, original string) error {
-	if len(args) != 1 {
-		return errExactlyOneArgument("FROM")
+	var stage string
+	switch {
+	case len(args) == 1:
+	case len(args) == 3 && strings.EqualFold(args[1], "as"):
+		stage = strings.ToLower(args[2])
+		if !validStageName.MatchString(stage) {
+			return fmt.Errorf("invalid name for build stage: %q, name can't start with a number or contain symbols", args[2])
+		}
+		for _, s := range b.stages {
+			if s.name == stage {
+				return fmt.Errorf("duplicate name for build stage: %q", args[2])
+			}
+		}
+	default:
+		return fmt.Errorf("FROM requires either one or three arguments")
 	}
 
 	if err := b.flags.Parse(); err != nil {
 		return err
 	}
 
+	b.startStage(stage)
+
 	name := args[0]
 
 	var (
@@ -191,6 +230,12 @@ func from(b *Builder, args []string, attributes map[string]bool, original string
 		err   error
 	)
 
+	imageID, fromStage := b.stageImage(name)
+	if fromStage && imageID == "" {
+		// A previous stage without any instruction is still scratch.
+		name = api.NoBaseImageSpecifier
+	}
+
 	// Windows cannot support a container with no base image.
 	if name == api.NoBaseImageSpecifier {
 		if runtime.GOOS == "windows" {
@@ -198,6 +243,11 @@ func from(b *Builder, args []string, attributes map[string]bool, original string
 		}
 		b.image = ""
 		b.noBaseImage = true
+	} else if fromStage {
+		image, err = b.docker.GetImageOnBuild(imageID)
+		if err != nil {
+			return err
+		}
 	} else {
 		// TODO: don't use `name`, instead resolve it to a digest
 		if !b.options.PullParent {
@@ -212,6 +262,10 @@ func from(b *Builder, args []string, attributes map[string]bool, original string
 		}
 	}
 
+	if image != nil {
+		b.stages[len(b.stages)-1].base = image.ImageID()
+	}
+
 	return b.processImageFrom(image)
 }
 
@@ -327,7 +381,7 @@ func run(b *Builder, args []string, attributes map[string]bool, original string)
 	// lookup for same image built with same build time environment.
 	cmdBuildEnv := []string{}
 	configEnv := runconfigopts.ConvertKVStringsToMap(b.runConfig.Env)
-	for key, val := range b.options.BuildArgs {
+	for key, val := range b.buildArgs() {
 		if !b.isBuildArgAllowed(key) {
 			// skip build-args that are not in allowed list, meaning they have
 			// not been defined by an "ARG" Dockerfile command yet.
@@ -701,14 +755,34 @@ func arg(b *Builder, args []string, attributes map[string]bool, original string)
 		name = arg
 		hasDefault = false
 	}
+
+	if len(b.stages) == 0 {
+		// Before the first FROM, the arg can only be used in FROM
+		// instructions, and there is no image to commit yet.
+		if v, ok := b.options.BuildArgs[name]; ok {
+			b.metaArgs[name] = v
+		} else if hasDefault {
+			b.metaArgs[name] = value
+		}
+		return nil
+	}
+
+	// Declared again without a value, an arg declared before the first FROM
+	// keeps its value.
+	if v, ok := b.metaArgs[name]; ok && !hasDefault {
+		value = v
+		hasDefault = true
+	}
+
 	// add the arg to allowed list of build-time args from this step on.
 	b.allowedBuildArgs[name] = true
+	b.declaredArgs[name] = true
 
-	// If there is a default value associated with this arg then add it to the
-	// b.buildArgs if one is not already passed to the builder. The args passed
-	// to builder override the default value of 'arg'.
-	if _, ok := b.options.BuildArgs[name]; !ok && hasDefault {
-		b.options.BuildArgs[name] = value
+	// If there is a default value associated with this arg then record it for
+	// the rest of the stage. The args passed to builder override the default
+	// value of 'arg'.
+	if hasDefault {
+		b.buildArgDefaults[name] = value
 	}
 
 	return b.commit("", b.runConfig.Cmd, fmt.Sprintf("ARG %s", arg))
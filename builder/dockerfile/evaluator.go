@@ -39,6 +39,7 @@ var replaceEnvAllowed = map[string]bool{
 	command.User:       true,
 	command.StopSignal: true,
 	command.Arg:        true,
+	command.From:       true,
 }
 
 // Certain commands are allowed to have their args split into more
@@ -149,7 +150,7 @@ func (b *Builder) dispatch(stepN int, ast *parser.Node) error {
 	// a subsequent one. So, putting the buildArgs list after the Config.Env
 	// list, in 'envs', is safe.
 	envs := b.runConfig.Env
-	for key, val := range b.options.BuildArgs {
+	for key, val := range b.buildArgs() {
 		if !b.isBuildArgAllowed(key) {
 			// skip build-args that are not in allowed list, meaning they have
 			// not been defined by an "ARG" Dockerfile command yet.
@@ -160,6 +161,11 @@ func (b *Builder) dispatch(stepN int, ast *parser.Node) error {
 		}
 		envs = append(envs, fmt.Sprintf("%s=%s", key, val))
 	}
+	if cmd == command.From {
+		// The image of FROM can only refer to the ARGs declared before the
+		// first FROM, which are outside of any stage.
+		envs = b.metaArgsEnv()
+	}
 	for ast.Next != nil {
 		ast = ast.Next
 		var str string
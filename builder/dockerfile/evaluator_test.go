@@ -4,6 +4,7 @@ import (
 	"io/ioutil"
 	"os"
 	"path/filepath"
+	"runtime"
 	"strings"
 	"testing"
 
@@ -13,6 +14,7 @@ import (
 	"github.com/docker/docker/pkg/reexec"
 	"github.com/docker/engine-api/types"
 	"github.com/docker/engine-api/types/container"
+	"golang.org/x/net/context"
 )
 
 type dispatchTestCase struct {
@@ -122,6 +124,24 @@ func initDispatchTestCases() []dispatchTestCase {
 			expectedError: "Chaining ONBUILD via `ONBUILD ONBUILD` isn't allowed",
 			files:         nil,
 		},
+		{
+			name:          "FROM two arguments",
+			dockerfile:    `FROM busybox stage`,
+			expectedError: "FROM requires either one or three arguments",
+			files:         nil,
+		},
+		{
+			name:          "FROM invalid stage name",
+			dockerfile:    `FROM busybox AS 1st`,
+			expectedError: "invalid name for build stage",
+			files:         nil,
+		},
+		{
+			name:          "COPY from missing stage",
+			dockerfile:    `COPY --from=1 foo /`,
+			expectedError: "no build stage 1 before this one",
+			files:         nil,
+		},
 		{
 			name:          "Invalid instruction",
 			dockerfile:    `foo bar`,
@@ -229,3 +249,100 @@ func createTestTempFile(t *testing.T, dir, filename, contents string, perm os.Fi
 
 	return filePath
 }
+
+func TestDispatchMetaArgs(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("Windows does not support FROM scratch")
+	}
+
+	dockerfile := `ARG BASE=busybox
+ARG UNUSED
+FROM $BASE
+ARG BASE
+`
+	d := parser.Directive{}
+	parser.SetEscapeToken(parser.DefaultEscapeToken, &d)
+	n, err := parser.Parse(strings.NewReader(dockerfile), &d)
+	if err != nil {
+		t.Fatalf("Error when parsing Dockerfile: %s", err)
+	}
+
+	b, err := NewBuilder(context.Background(), &types.ImageBuildOptions{BuildArgs: map[string]string{"BASE": "scratch"}}, nil, nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	b.Stdout = ioutil.Discard
+	b.disableCommit = true
+
+	for i, n := range n.Children {
+		if err := b.dispatch(i, n); err != nil {
+			t.Fatalf("Error when dispatching %s: %s", n.Original, err)
+		}
+	}
+
+	if !b.noBaseImage {
+		t.Fatal("expected the base image to be expanded from the build arg")
+	}
+	if _, ok := b.metaArgs["UNUSED"]; ok {
+		t.Fatal("expected an arg without a value not to be set")
+	}
+	if !b.isBuildArgAllowed("BASE") {
+		t.Fatal("expected the arg declared again in the stage to be allowed")
+	}
+}
+
+func TestDispatchArgsPerStage(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("Windows does not support FROM scratch")
+	}
+
+	dockerfile := `FROM scratch AS first
+ARG V=1
+ARG ONLY=first
+FROM scratch
+ARG V=2
+`
+	d := parser.Directive{}
+	parser.SetEscapeToken(parser.DefaultEscapeToken, &d)
+	n, err := parser.Parse(strings.NewReader(dockerfile), &d)
+	if err != nil {
+		t.Fatalf("Error when parsing Dockerfile: %s", err)
+	}
+
+	options := &types.ImageBuildOptions{}
+	b, err := NewBuilder(context.Background(), options, nil, nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	b.Stdout = ioutil.Discard
+	b.disableCommit = true
+
+	expected := []map[string]string{
+		{},
+		{"V": "1"},
+		{"V": "1", "ONLY": "first"},
+		{},
+		{"V": "2"},
+	}
+	for i, n := range n.Children {
+		if err := b.dispatch(i, n); err != nil {
+			t.Fatalf("Error when dispatching %s: %s", n.Original, err)
+		}
+		args := b.buildArgs()
+		if len(args) != len(expected[i]) {
+			t.Fatalf("expected build args %v after %s, got %v", expected[i], n.Original, args)
+		}
+		for key, val := range expected[i] {
+			if args[key] != val || !b.isBuildArgAllowed(key) {
+				t.Fatalf("expected build args %v after %s, got %v", expected[i], n.Original, args)
+			}
+		}
+	}
+
+	if b.isBuildArgAllowed("ONLY") {
+		t.Fatal("expected an arg declared in a previous stage not to be allowed")
+	}
+	if len(options.BuildArgs) != 0 {
+		t.Fatalf("expected the build options not to be modified, got %v", options.BuildArgs)
+	}
+}
@@ -15,13 +15,16 @@ import (
 	"os"
 	"path/filepath"
 	"sort"
+	"strconv"
 	"strings"
 	"sync"
 	"time"
 
 	"github.com/Sirupsen/logrus"
+	"github.com/docker/docker/api"
 	"github.com/docker/docker/api/types/backend"
 	"github.com/docker/docker/builder"
+	"github.com/docker/docker/builder/dockerfile/command"
 	"github.com/docker/docker/builder/dockerfile/parser"
 	"github.com/docker/docker/pkg/archive"
 	"github.com/docker/docker/pkg/httputils"
@@ -92,8 +95,11 @@ type copyInfo struct {
 	decompress bool
 }
 
-func (b *Builder) runContextCommand(args []string, allowRemote bool, allowLocalDecompression bool, cmdName string) error {
-	if b.context == nil {
+// runContextCommand copies files from srcContext, which is either the build
+// context or the filesystem of an image, into a new layer. The files are
+// owned by chown, as user[:group], if it is not empty.
+func (b *Builder) runContextCommand(args []string, allowRemote bool, allowLocalDecompression bool, cmdName string, srcContext builder.Context, chown string) error {
+	if srcContext == nil {
 		return fmt.Errorf("No context given. Impossible to use %s", cmdName)
 	}
 
@@ -129,7 +135,7 @@ func (b *Builder) runContextCommand(args []string, allowRemote bool, allowLocalD
 			continue
 		}
 		// not a URL
-		subInfos, err := b.calcCopyInfo(cmdName, orig, allowLocalDecompression, true)
+		subInfos, err := calcCopyInfo(srcContext, cmdName, orig, allowLocalDecompression, true)
 		if err != nil {
 			return err
 		}
@@ -171,8 +177,13 @@ func (b *Builder) runContextCommand(args []string, allowRemote bool, allowLocalD
 		origPaths = strings.Join(origs, " ")
 	}
 
+	cmdFlags := ""
+	if chown != "" {
+		cmdFlags = fmt.Sprintf("--chown=%s ", chown)
+	}
+
 	cmd := b.runConfig.Cmd
-	b.runConfig.Cmd = strslice.StrSlice(append(getShell(b.runConfig), fmt.Sprintf("#(nop) %s %s in %s ", cmdName, srcHash, dest)))
+	b.runConfig.Cmd = strslice.StrSlice(append(getShell(b.runConfig), fmt.Sprintf("#(nop) %s %s%s in %s ", cmdName, cmdFlags, srcHash, dest)))
 	defer func(cmd strslice.StrSlice) { b.runConfig.Cmd = cmd }(cmd)
 
 	if hit, err := b.probeCache(); err != nil {
@@ -187,7 +198,7 @@ func (b *Builder) runContextCommand(args []string, allowRemote bool, allowLocalD
 	}
 	b.tmpContainers[container.ID] = struct{}{}
 
-	comment := fmt.Sprintf("%s %s in %s", cmdName, origPaths, dest)
+	comment := fmt.Sprintf("%s %s%s in %s", cmdName, cmdFlags, origPaths, dest)
 
 	// Twiddle the destination when its a relative path - meaning, make it
 	// relative to the WORKINGDIR
@@ -196,7 +207,7 @@ func (b *Builder) runContextCommand(args []string, allowRemote bool, allowLocalD
 	}
 
 	for _, info := range infos {
-		if err := b.docker.CopyOnBuild(container.ID, dest, info.FileInfo, info.decompress); err != nil {
+		if err := b.docker.CopyOnBuild(container.ID, dest, info.FileInfo, info.decompress, chown); err != nil {
 			return err
 		}
 	}
@@ -293,7 +304,7 @@ func (b *Builder) download(srcURL string) (fi builder.FileInfo, err error) {
 	return &builder.HashedFileInfo{FileInfo: builder.PathFileInfo{FileInfo: tmpFileSt, FilePath: tmpFileName}, FileHash: hash}, nil
 }
 
-func (b *Builder) calcCopyInfo(cmdName, origPath string, allowLocalDecompression, allowWildcards bool) ([]copyInfo, error) {
+func calcCopyInfo(srcContext builder.Context, cmdName, origPath string, allowLocalDecompression, allowWildcards bool) ([]copyInfo, error) {
 
 	// Work in daemon-specific OS filepath semantics
 	origPath = filepath.FromSlash(origPath)
@@ -306,7 +317,7 @@ func (b *Builder) calcCopyInfo(cmdName, origPath string, allowLocalDecompression
 	// Deal with wildcards
 	if allowWildcards && containsWildcards(origPath) {
 		var copyInfos []copyInfo
-		if err := b.context.Walk("", func(path string, info builder.FileInfo, err error) error {
+		if err := srcContext.Walk("", func(path string, info builder.FileInfo, err error) error {
 			if err != nil {
 				return err
 			}
@@ -320,7 +331,7 @@ func (b *Builder) calcCopyInfo(cmdName, origPath string, allowLocalDecompression
 
 			// Note we set allowWildcards to false in case the name has
 			// a * in it
-			subInfos, err := b.calcCopyInfo(cmdName, path, allowLocalDecompression, false)
+			subInfos, err := calcCopyInfo(srcContext, cmdName, path, allowLocalDecompression, false)
 			if err != nil {
 				return err
 			}
@@ -334,7 +345,7 @@ func (b *Builder) calcCopyInfo(cmdName, origPath string, allowLocalDecompression
 
 	// Must be a dir or a file
 
-	statPath, fi, err := b.context.Stat(origPath)
+	statPath, fi, err := srcContext.Stat(origPath)
 	if err != nil {
 		return nil, err
 	}
@@ -353,7 +364,7 @@ func (b *Builder) calcCopyInfo(cmdName, origPath string, allowLocalDecompression
 	}
 	// Must be a dir
 	var subfiles []string
-	err = b.context.Walk(statPath, func(path string, info builder.FileInfo, err error) error {
+	err = srcContext.Walk(statPath, func(path string, info builder.FileInfo, err error) error {
 		if err != nil {
 			return err
 		}
@@ -385,6 +396,200 @@ func containsWildcards(name string) bool {
 	return false
 }
 
+// startStage starts a new stage of the build, named name. The image built by
+// the previous stage is recorded, and the state of the build is reset.
+func (b *Builder) startStage(name string) {
+	if len(b.stages) == 0 {
+		b.stages = append(b.stages, buildStage{name: name})
+		return
+	}
+	b.stages[len(b.stages)-1].image = b.image
+	b.stages = append(b.stages, buildStage{name: name})
+
+	b.runConfig = new(container.Config)
+	b.image = ""
+	b.noBaseImage = false
+	b.maintainer = ""
+	b.cmdSet = false
+	b.cacheBusted = false
+	// ARGs are scoped to the stage declaring them.
+	b.allowedBuildArgs = make(map[string]bool)
+	b.buildArgDefaults = make(map[string]string)
+}
+
+// finishedStages returns the stages built before the current one.
+func (b *Builder) finishedStages() []buildStage {
+	if len(b.stages) == 0 {
+		return nil
+	}
+	return b.stages[:len(b.stages)-1]
+}
+
+// stageImage returns the image built by the finished stage with the given
+// name.
+func (b *Builder) stageImage(name string) (string, bool) {
+	name = strings.ToLower(name)
+	for _, stage := range b.finishedStages() {
+		if stage.name != "" && stage.name == name {
+			return stage.image, true
+		}
+	}
+	return "", false
+}
+
+// imageContext returns a build context over the filesystem of the stage or
+// image referred to by ref, for COPY --from. The context must be closed.
+func (b *Builder) imageContext(ref string) (builder.Context, error) {
+	imageID, ok := b.stageImage(ref)
+	if i, err := strconv.Atoi(ref); err == nil && !ok {
+		stages := b.finishedStages()
+		if i < 0 || i >= len(stages) {
+			return nil, fmt.Errorf("invalid from flag value %s: no build stage %d before this one", ref, i)
+		}
+		imageID, ok = stages[i].image, true
+	}
+	if !ok {
+		var (
+			image builder.Image
+			err   error
+		)
+		if !b.options.PullParent {
+			image, err = b.docker.GetImageOnBuild(ref)
+		}
+		if image == nil {
+			image, err = b.docker.PullOnBuild(b.clientCtx, ref, b.options.AuthConfigs, b.Output)
+			if err != nil {
+				return nil, err
+			}
+		}
+		imageID = image.ImageID()
+	}
+	if imageID == "" {
+		return nil, fmt.Errorf("stage %s has no files to copy from", ref)
+	}
+
+	root, release, err := b.docker.MountImageOnBuild(imageID)
+	if err != nil {
+		return nil, err
+	}
+	return builder.NewImageContext(root, imageID, release), nil
+}
+
+// squash replaces the image built by the last stage with one where the
+// layers added on top of its FROM image are merged into one.
+func (b *Builder) squash() error {
+	base := b.stages[len(b.stages)-1].base
+	if b.image == base {
+		// Nothing was added to the FROM image.
+		return nil
+	}
+	onto := api.NoBaseImageSpecifier
+	if base != "" {
+		onto = stringid.TruncateID(base)
+	}
+	fmt.Fprintf(b.Stdout, "Squashing layers onto %s\n", onto)
+	id, err := b.docker.SquashImage(b.image, base)
+	if err != nil {
+		return err
+	}
+	b.image = id
+	return nil
+}
+
+// stepProgress is a step of the build in progress.
+type stepProgress struct {
+	index       int
+	instruction string
+	from        bool
+	parent      string
+	start       time.Time
+}
+
+// startStep starts tracking the step of the build dispatching n.
+func (b *Builder) startStep(i int, n *parser.Node) *stepProgress {
+	b.cacheHit = false
+	return &stepProgress{
+		index:       i,
+		instruction: n.Original,
+		from:        n.Value == command.From,
+		parent:      b.image,
+		start:       time.Now(),
+	}
+}
+
+// emitStep sends the progress event of a finished step, which failed with
+// stepErr if it isn't nil.
+func (b *Builder) emitStep(s *stepProgress, stepErr error) {
+	if b.aux == nil {
+		return
+	}
+	ev := builder.BuildStep{
+		Step:        s.index + 1,
+		Instruction: s.instruction,
+		Cached:      b.cacheHit,
+		Duration:    time.Since(s.start),
+	}
+	if stepErr != nil {
+		ev.Error = stepErr.Error()
+	} else {
+		ev.ImageID = b.image
+		// The layers of the image of FROM aren't added by the build.
+		if !s.from && b.image != "" && b.image != s.parent {
+			layerID, size, err := b.docker.LayerOnBuild(b.image, s.parent)
+			if err != nil {
+				logrus.Debugf("[BUILDER] Failed to get the layer of step %d: %v", ev.Step, err)
+			} else {
+				ev.LayerID = layerID
+				ev.Size = size
+			}
+		}
+	}
+	progress.Aux(b.aux, ev)
+}
+
+// metaArgsEnv returns the ARGs declared before the first FROM, in the form of
+// environment variables.
+func (b *Builder) metaArgsEnv() []string {
+	var envs []string
+	for name, value := range b.metaArgs {
+		envs = append(envs, fmt.Sprintf("%s=%s", name, value))
+	}
+	return envs
+}
+
+// stageName returns the name given to the stage started by the FROM
+// instruction n, if any.
+func stageName(n *parser.Node) string {
+	if n.Next == nil || n.Next.Next == nil || n.Next.Next.Next == nil {
+		return ""
+	}
+	if !strings.EqualFold(n.Next.Next.Value, "as") {
+		return ""
+	}
+	return strings.ToLower(n.Next.Next.Next.Value)
+}
+
+// truncateToTarget removes the instructions following the stage named by
+// the Target build option from the Dockerfile.
+func (b *Builder) truncateToTarget() error {
+	target := strings.ToLower(b.options.Target)
+	found := false
+	for i, n := range b.dockerfile.Children {
+		if n.Value != command.From {
+			continue
+		}
+		if found {
+			b.dockerfile.Children = b.dockerfile.Children[:i]
+			return nil
+		}
+		found = stageName(n) == target
+	}
+	if !found {
+		return fmt.Errorf("failed to reach build target %s in Dockerfile", b.options.Target)
+	}
+	return nil
+}
+
 func (b *Builder) processImageFrom(img builder.Image) error {
 	if img != nil {
 		b.image = img.ImageID()
@@ -449,18 +654,17 @@ func (b *Builder) processImageFrom(img builder.Image) error {
 	return nil
 }
 
-// probeCache checks if `b.docker` implements builder.ImageCache and image-caching
-// is enabled (`b.UseCache`).
-// If so attempts to look up the current `b.image` and `b.runConfig` pair with `b.docker`.
+// probeCache checks if the builder has an image cache (`b.imageCache`) and
+// image-caching is enabled (`b.UseCache`).
+// If so attempts to look up the current `b.image` and `b.runConfig` pair in the cache.
 // If an image is found, probeCache returns `(true, nil)`.
 // If no image is found, it returns `(false, nil)`.
 // If there is any error, it returns `(false, err)`.
 func (b *Builder) probeCache() (bool, error) {
-	c, ok := b.docker.(builder.ImageCache)
-	if !ok || b.options.NoCache || b.cacheBusted {
+	if b.imageCache == nil || b.options.NoCache || b.cacheBusted {
 		return false, nil
 	}
-	cache, err := c.GetCachedImageOnBuild(b.image, b.runConfig)
+	cache, err := b.imageCache.GetCachedImageOnBuild(b.image, b.runConfig)
 	if err != nil {
 		return false, err
 	}
@@ -473,6 +677,7 @@ func (b *Builder) probeCache() (bool, error) {
 	fmt.Fprintf(b.Stdout, " ---> Using cache\n")
 	logrus.Debugf("[BUILDER] Use cached version: %s", b.runConfig.Cmd)
 	b.image = string(cache)
+	b.cacheHit = true
 
 	return true, nil
 }
@@ -497,9 +702,11 @@ func (b *Builder) create() (string, error) {
 
 	// TODO: why not embed a hostconfig in builder?
 	hostConfig := &container.HostConfig{
-		Isolation: b.options.Isolation,
-		ShmSize:   b.options.ShmSize,
-		Resources: resources,
+		Isolation:   b.options.Isolation,
+		ShmSize:     b.options.ShmSize,
+		Resources:   resources,
+		NetworkMode: container.NetworkMode(b.options.NetworkMode),
+		ExtraHosts:  b.options.ExtraHosts,
 	}
 
 	config := *b.runConfig
@@ -656,6 +863,19 @@ func (b *Builder) parseDockerfile() error {
 	return nil
 }
 
+// buildArgs returns the build-time args of the current stage, with the value
+// passed to the builder or else the default value of their ARG.
+func (b *Builder) buildArgs() map[string]string {
+	args := make(map[string]string, len(b.options.BuildArgs)+len(b.buildArgDefaults))
+	for key, val := range b.buildArgDefaults {
+		args[key] = val
+	}
+	for key, val := range b.options.BuildArgs {
+		args[key] = val
+	}
+	return args
+}
+
 // determine if build arg is part of built-in args or user
 // defined args in Dockerfile at any point in time.
 func (b *Builder) isBuildArgAllowed(arg string) bool {
@@ -1,12 +1,17 @@
 package dockerfile
 
 import (
+	"errors"
+	"io/ioutil"
 	"strings"
 	"testing"
 
 	"github.com/docker/docker/builder"
+	"github.com/docker/docker/builder/dockerfile/parser"
 	"github.com/docker/docker/pkg/archive"
+	"github.com/docker/docker/pkg/progress"
 	"github.com/docker/engine-api/types"
+	"github.com/docker/engine-api/types/container"
 )
 
 func TestEmptyDockerfile(t *testing.T) {
@@ -100,3 +105,126 @@ func TestDockerfileOutsideTheBuildContext(t *testing.T) {
 		t.Fatalf("Wrong error message. Should be \"%s\". Got \"%s\"", expectedError, err.Error())
 	}
 }
+
+func TestTruncateToTarget(t *testing.T) {
+	dockerfile := `FROM busybox AS build
+RUN make
+FROM busybox as Test
+RUN make test
+FROM scratch
+COPY --from=build /app /app
+`
+	for _, tc := range []struct {
+		target string
+		lines  int
+	}{
+		{"build", 2},
+		{"test", 4},
+		{"TEST", 4},
+	} {
+		d := parser.Directive{}
+		parser.SetEscapeToken(parser.DefaultEscapeToken, &d)
+		n, err := parser.Parse(strings.NewReader(dockerfile), &d)
+		if err != nil {
+			t.Fatal(err)
+		}
+		b := &Builder{options: &types.ImageBuildOptions{Target: tc.target}, dockerfile: n}
+		if err := b.truncateToTarget(); err != nil {
+			t.Fatal(err)
+		}
+		if len(n.Children) != tc.lines {
+			t.Fatalf("expected %d instructions for target %s, got %d", tc.lines, tc.target, len(n.Children))
+		}
+	}
+
+	d := parser.Directive{}
+	parser.SetEscapeToken(parser.DefaultEscapeToken, &d)
+	n, err := parser.Parse(strings.NewReader(dockerfile), &d)
+	if err != nil {
+		t.Fatal(err)
+	}
+	b := &Builder{options: &types.ImageBuildOptions{Target: "deploy"}, dockerfile: n}
+	if err := b.truncateToTarget(); err == nil || !strings.Contains(err.Error(), "failed to reach build target deploy") {
+		t.Fatalf("expected an error for a missing target, got %v", err)
+	}
+}
+
+type auxRecorder struct {
+	events []interface{}
+}
+
+func (r *auxRecorder) WriteProgress(p progress.Progress) error {
+	r.events = append(r.events, p.Aux)
+	return nil
+}
+
+func TestEmitStep(t *testing.T) {
+	aux := &auxRecorder{}
+	b := &Builder{aux: aux, image: "sha256:base"}
+
+	from := &parser.Node{Value: "from", Original: "FROM busybox"}
+	step := b.startStep(0, from)
+	b.emitStep(step, nil)
+
+	run := &parser.Node{Value: "run", Original: "RUN false"}
+	step = b.startStep(1, run)
+	b.cacheHit = true
+	b.emitStep(step, errors.New("returned a non-zero code: 1"))
+
+	if len(aux.events) != 2 {
+		t.Fatalf("expected 2 events, got %d", len(aux.events))
+	}
+	ev, ok := aux.events[0].(builder.BuildStep)
+	if !ok || ev.Step != 1 || ev.Instruction != "FROM busybox" || ev.ImageID != "sha256:base" || ev.LayerID != "" || ev.Error != "" {
+		t.Fatalf("unexpected event for FROM: %+v", aux.events[0])
+	}
+	ev, ok = aux.events[1].(builder.BuildStep)
+	if !ok || ev.Step != 2 || !ev.Cached || ev.ImageID != "" || ev.Error != "returned a non-zero code: 1" {
+		t.Fatalf("unexpected event for a failed step: %+v", aux.events[1])
+	}
+}
+
+// createRecorder is a builder backend recording the containers created.
+type createRecorder struct {
+	builder.Backend
+	configs []types.ContainerCreateConfig
+}
+
+func (r *createRecorder) ContainerCreate(config types.ContainerCreateConfig, validateHostname bool) (types.ContainerCreateResponse, error) {
+	r.configs = append(r.configs, config)
+	return types.ContainerCreateResponse{ID: "container"}, nil
+}
+
+func (r *createRecorder) ContainerUpdateCmdOnBuild(containerID string, cmd []string) error {
+	return nil
+}
+
+func TestCreateNetworkSettings(t *testing.T) {
+	backend := &createRecorder{}
+	options := &types.ImageBuildOptions{
+		NetworkMode: "host",
+		ExtraHosts:  []string{"foo:10.0.0.1"},
+	}
+	b := &Builder{
+		options:       options,
+		docker:        backend,
+		image:         "sha256:base",
+		runConfig:     &container.Config{},
+		tmpContainers: map[string]struct{}{},
+		Stdout:        ioutil.Discard,
+	}
+
+	if _, err := b.create(); err != nil {
+		t.Fatal(err)
+	}
+	if len(backend.configs) != 1 {
+		t.Fatalf("expected 1 container to be created, got %d", len(backend.configs))
+	}
+	hostConfig := backend.configs[0].HostConfig
+	if hostConfig.NetworkMode != "host" {
+		t.Fatalf("expected network mode host, got %q", hostConfig.NetworkMode)
+	}
+	if len(hostConfig.ExtraHosts) != 1 || hostConfig.ExtraHosts[0] != "foo:10.0.0.1" {
+		t.Fatalf("expected extra hosts [foo:10.0.0.1], got %v", hostConfig.ExtraHosts)
+	}
+}
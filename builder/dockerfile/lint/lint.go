@@ -0,0 +1,283 @@
+// Package lint checks Dockerfiles for common mistakes without building them.
+//
+// Each rule has an ID which can be used to suppress its findings for an
+// instruction with a comment on the lines preceding the instruction:
+//
+//	# lint:ignore=DF003,DF006
+//	ADD http://example.com/big.tar.xz /usr/src/things/
+package lint
+
+import (
+	"bufio"
+	"bytes"
+	"io"
+	"io/ioutil"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/docker/docker/builder/dockerfile/command"
+	"github.com/docker/docker/builder/dockerfile/parser"
+	"github.com/docker/docker/pkg/urlutil"
+)
+
+// Severity is how serious a finding is.
+type Severity string
+
+const (
+	// SeverityError is used for instructions the builder rejects or ignores.
+	SeverityError Severity = "error"
+	// SeverityWarning is used for instructions which work, but likely not
+	// as intended.
+	SeverityWarning Severity = "warning"
+	// SeverityInfo is used for suggestions.
+	SeverityInfo Severity = "info"
+)
+
+// Finding is a problem found in a Dockerfile.
+type Finding struct {
+	Rule     string   `json:"rule"`
+	Severity Severity `json:"severity"`
+	Line     int      `json:"line"`
+	Message  string   `json:"message"`
+}
+
+// Rule IDs.
+const (
+	UnknownInstruction   = "DF001"
+	DeprecatedMaintainer = "DF002"
+	AddURL               = "DF003"
+	MissingHealthcheck   = "DF004"
+	AptListsNotRemoved   = "DF005"
+	UnpinnedBaseImage    = "DF006"
+	UnusedArg            = "DF007"
+)
+
+var (
+	suppressComment = regexp.MustCompile(`^[ \t]*#[ \t]*lint:ignore=([A-Za-z0-9,]+)`)
+	aptGetInstall   = regexp.MustCompile(`\bapt-get\b[^;&|]*\binstall\b`)
+)
+
+// Lint parses the Dockerfile read from r and returns the findings of all the
+// rules, ordered by line. An error is only returned if the Dockerfile can't
+// be parsed.
+func Lint(r io.Reader) ([]Finding, error) {
+	content, err := ioutil.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+
+	d := parser.Directive{LookingForDirectives: true}
+	parser.SetEscapeToken(parser.DefaultEscapeToken, &d)
+	root, err := parser.Parse(bytes.NewReader(content), &d)
+	if err != nil {
+		return nil, err
+	}
+
+	l := &linter{}
+	l.check(root.Children)
+
+	ignored := suppressions(content, root.Children)
+	var findings []Finding
+	for _, f := range l.findings {
+		if !ignored[f.Line][f.Rule] {
+			findings = append(findings, f)
+		}
+	}
+	sort.Stable(byLine(findings))
+	return findings, nil
+}
+
+type byLine []Finding
+
+func (f byLine) Len() int           { return len(f) }
+func (f byLine) Less(i, j int) bool { return f[i].Line < f[j].Line }
+func (f byLine) Swap(i, j int)      { f[i], f[j] = f[j], f[i] }
+
+// suppressions returns the rules suppressed for each instruction, indexed by
+// the line the instruction starts at.
+func suppressions(content []byte, nodes []*parser.Node) map[int]map[string]bool {
+	comments := map[int][]string{}
+	scanner := bufio.NewScanner(bytes.NewReader(content))
+	for line := 1; scanner.Scan(); line++ {
+		if m := suppressComment.FindStringSubmatch(scanner.Text()); m != nil {
+			comments[line] = strings.Split(m[1], ",")
+		}
+	}
+
+	ignored := map[int]map[string]bool{}
+	prevEnd := 0
+	for _, n := range nodes {
+		for line := prevEnd + 1; line < n.StartLine; line++ {
+			for _, rule := range comments[line] {
+				if ignored[n.StartLine] == nil {
+					ignored[n.StartLine] = map[string]bool{}
+				}
+				ignored[n.StartLine][strings.ToUpper(rule)] = true
+			}
+		}
+		prevEnd = n.EndLine
+	}
+	return ignored
+}
+
+// declaredArg is an ARG instruction whose use is tracked.
+type declaredArg struct {
+	name string
+	line int
+	used bool
+	// ref matches the references to the argument.
+	ref *regexp.Regexp
+}
+
+type linter struct {
+	findings []Finding
+	stages   map[string]bool
+	// metaArgs are the ARG instructions before the first FROM, and args
+	// those of the current stage.
+	metaArgs []*declaredArg
+	args     []*declaredArg
+	// lastFrom is the line of the FROM of the current stage, and
+	// healthcheck whether the stage has a HEALTHCHECK.
+	lastFrom    int
+	healthcheck bool
+}
+
+func (l *linter) report(rule string, severity Severity, line int, msg string) {
+	l.findings = append(l.findings, Finding{Rule: rule, Severity: severity, Line: line, Message: msg})
+}
+
+func (l *linter) check(nodes []*parser.Node) {
+	l.stages = map[string]bool{}
+	for _, n := range nodes {
+		l.checkNode(n, n.StartLine)
+	}
+	l.endStage()
+	for _, arg := range l.metaArgs {
+		if !arg.used {
+			l.reportUnusedArg(arg)
+		}
+	}
+	if l.lastFrom > 0 && !l.healthcheck {
+		l.report(MissingHealthcheck, SeverityInfo, l.lastFrom, "the final stage has no HEALTHCHECK instruction")
+	}
+}
+
+func (l *linter) checkNode(n *parser.Node, line int) {
+	if _, ok := command.Commands[n.Value]; !ok {
+		l.report(UnknownInstruction, SeverityError, line, "unknown instruction: "+strings.ToUpper(n.Value))
+		return
+	}
+
+	if n.Value != command.From {
+		l.useArgs(l.args, n.Original)
+	}
+
+	switch n.Value {
+	case command.From:
+		l.checkFrom(n, line)
+	case command.Arg:
+		l.checkArg(n, line)
+	case command.Maintainer:
+		l.report(DeprecatedMaintainer, SeverityWarning, line, "MAINTAINER is deprecated, use LABEL maintainer=<name> instead")
+	case command.Add:
+		for a := n.Next; a != nil && a.Next != nil; a = a.Next {
+			if urlutil.IsURL(a.Value) {
+				l.report(AddURL, SeverityWarning, line, "ADD of a URL, use RUN with curl or wget to download and extract it in a single layer")
+				break
+			}
+		}
+	case command.Run:
+		var args []string
+		for a := n.Next; a != nil; a = a.Next {
+			args = append(args, a.Value)
+		}
+		cmd := strings.Join(args, " ")
+		if aptGetInstall.MatchString(cmd) && !strings.Contains(cmd, "/var/lib/apt/lists") {
+			l.report(AptListsNotRemoved, SeverityWarning, line, "apt-get install without removing /var/lib/apt/lists in the same RUN instruction")
+		}
+	case command.Healthcheck:
+		l.healthcheck = true
+	case command.Onbuild:
+		// The instruction runs in the builds using the image, only check
+		// that it exists.
+		if n.Next != nil && len(n.Next.Children) > 0 {
+			if sub := n.Next.Children[0]; sub != nil {
+				if _, ok := command.Commands[sub.Value]; !ok {
+					l.report(UnknownInstruction, SeverityError, line, "unknown instruction: "+strings.ToUpper(sub.Value))
+				}
+			}
+		}
+	}
+}
+
+func (l *linter) checkFrom(n *parser.Node, line int) {
+	l.endStage()
+	l.lastFrom = line
+	l.healthcheck = false
+
+	if n.Next == nil {
+		return
+	}
+	l.useArgs(l.metaArgs, n.Original)
+
+	image := n.Next.Value
+	if n.Next.Next != nil && n.Next.Next.Next != nil && strings.EqualFold(n.Next.Next.Value, "as") {
+		defer func() { l.stages[strings.ToLower(n.Next.Next.Next.Value)] = true }()
+	}
+	if image == "scratch" || l.stages[strings.ToLower(image)] || strings.ContainsAny(image, "$@") {
+		return
+	}
+	name := image[strings.LastIndex(image, "/")+1:]
+	if i := strings.Index(name, ":"); i < 0 || name[i+1:] == "latest" {
+		l.report(UnpinnedBaseImage, SeverityWarning, line, "base image "+image+" is not pinned to a version, use an explicit tag or digest")
+	}
+}
+
+func (l *linter) checkArg(n *parser.Node, line int) {
+	if n.Next == nil {
+		return
+	}
+	name := strings.SplitN(n.Next.Value, "=", 2)[0]
+	arg := &declaredArg{name: name, line: line, ref: regexp.MustCompile(`\$\{?` + regexp.QuoteMeta(name) + `\b`)}
+	if l.lastFrom == 0 {
+		l.useArgs(l.metaArgs, n.Original)
+		l.metaArgs = append(l.metaArgs, arg)
+		return
+	}
+	// Declaring a meta argument in a stage makes it available there.
+	for _, meta := range l.metaArgs {
+		if meta.name == name {
+			meta.used = true
+		}
+	}
+	l.args = append(l.args, arg)
+}
+
+// endStage reports the unused arguments of the current stage.
+func (l *linter) endStage() {
+	for _, arg := range l.args {
+		if !arg.used {
+			l.reportUnusedArg(arg)
+		}
+	}
+	l.args = nil
+}
+
+func (l *linter) reportUnusedArg(arg *declaredArg) {
+	// The proxy arguments are used by the RUN instructions through their
+	// environment.
+	if command.BuiltinAllowedBuildArgs[arg.name] {
+		return
+	}
+	l.report(UnusedArg, SeverityWarning, arg.line, "ARG "+arg.name+" is not referenced by a later instruction")
+}
+
+// useArgs marks the arguments referenced in the instruction as used.
+func (l *linter) useArgs(args []*declaredArg, original string) {
+	for _, arg := range args {
+		if !arg.used && arg.ref.MatchString(original) {
+			arg.used = true
+		}
+	}
+}
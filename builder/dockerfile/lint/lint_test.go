@@ -0,0 +1,114 @@
+package lint
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+type lintTestCase struct {
+	name       string
+	dockerfile string
+	expected   []Finding
+}
+
+func TestLint(t *testing.T) {
+	testCases := []lintTestCase{
+		{
+			name: "clean",
+			dockerfile: `FROM busybox:1.25
+ARG VERSION=1
+RUN echo $VERSION
+HEALTHCHECK CMD true`,
+		},
+		{
+			name: "unknown instruction",
+			dockerfile: `FROM busybox:1.25
+FOO bar
+ONBUILD BAR baz
+HEALTHCHECK CMD true`,
+			expected: []Finding{
+				{Rule: UnknownInstruction, Severity: SeverityError, Line: 2, Message: "unknown instruction: FOO"},
+				{Rule: UnknownInstruction, Severity: SeverityError, Line: 3, Message: "unknown instruction: BAR"},
+			},
+		},
+		{
+			name: "deprecated and remote instructions",
+			dockerfile: `FROM busybox:1.25
+MAINTAINER someone
+ADD https://example.com/archive.tgz /tmp/
+RUN apt-get update && apt-get install -y curl
+RUN apt-get update && apt-get install -y curl && rm -rf /var/lib/apt/lists/*
+HEALTHCHECK CMD true`,
+			expected: []Finding{
+				{Rule: DeprecatedMaintainer, Severity: SeverityWarning, Line: 2, Message: "MAINTAINER is deprecated, use LABEL maintainer=<name> instead"},
+				{Rule: AddURL, Severity: SeverityWarning, Line: 3, Message: "ADD of a URL, use RUN with curl or wget to download and extract it in a single layer"},
+				{Rule: AptListsNotRemoved, Severity: SeverityWarning, Line: 4, Message: "apt-get install without removing /var/lib/apt/lists in the same RUN instruction"},
+			},
+		},
+		{
+			name: "base images",
+			dockerfile: `ARG BASE=busybox
+FROM ubuntu AS build
+FROM localhost:5000/ubuntu:latest
+FROM build
+FROM $BASE
+FROM busybox@sha256:817a12c32a39bbe394944ba49de563e085f1d3c5266eb8e9723256bc4448680e
+FROM scratch`,
+			expected: []Finding{
+				{Rule: UnpinnedBaseImage, Severity: SeverityWarning, Line: 2, Message: "base image ubuntu is not pinned to a version, use an explicit tag or digest"},
+				{Rule: UnpinnedBaseImage, Severity: SeverityWarning, Line: 3, Message: "base image localhost:5000/ubuntu:latest is not pinned to a version, use an explicit tag or digest"},
+				{Rule: MissingHealthcheck, Severity: SeverityInfo, Line: 7, Message: "the final stage has no HEALTHCHECK instruction"},
+			},
+		},
+		{
+			name: "unused arguments",
+			dockerfile: `ARG UNUSED_META
+ARG TAG=1.25
+ARG SHARED
+FROM busybox:${TAG}
+ARG SHARED
+ARG USED
+ARG UNUSED
+ARG USEDX
+ARG http_proxy
+RUN echo $USED $USEDX
+FROM busybox:1.25
+RUN echo $UNUSED
+HEALTHCHECK CMD true`,
+			expected: []Finding{
+				{Rule: UnusedArg, Severity: SeverityWarning, Line: 1, Message: "ARG UNUSED_META is not referenced by a later instruction"},
+				{Rule: UnusedArg, Severity: SeverityWarning, Line: 5, Message: "ARG SHARED is not referenced by a later instruction"},
+				{Rule: UnusedArg, Severity: SeverityWarning, Line: 7, Message: "ARG UNUSED is not referenced by a later instruction"},
+			},
+		},
+		{
+			name: "suppressed",
+			dockerfile: `# lint:ignore=DF006,DF004
+FROM busybox
+# lint:ignore=df003
+ADD https://example.com/archive.tgz \
+    /tmp/
+MAINTAINER someone`,
+			expected: []Finding{
+				{Rule: DeprecatedMaintainer, Severity: SeverityWarning, Line: 6, Message: "MAINTAINER is deprecated, use LABEL maintainer=<name> instead"},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		findings, err := Lint(strings.NewReader(tc.dockerfile))
+		if err != nil {
+			t.Fatalf("%s: %v", tc.name, err)
+		}
+		if !reflect.DeepEqual(findings, tc.expected) {
+			t.Fatalf("%s: expected %+v, got %+v", tc.name, tc.expected, findings)
+		}
+	}
+}
+
+func TestLintParseError(t *testing.T) {
+	if _, err := Lint(strings.NewReader("# escape=x\nFROM busybox")); err == nil {
+		t.Fatal("expected an invalid Dockerfile to fail")
+	}
+}
@@ -0,0 +1,99 @@
+package builder
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/docker/docker/pkg/symlink"
+)
+
+// imageContext is a Context over the mounted filesystem of an image.
+type imageContext struct {
+	root    string
+	imageID string
+	release func() error
+}
+
+// NewImageContext returns a build Context over the filesystem of the image
+// with the given ID, mounted at root. release is called to unmount it when
+// the Context is closed.
+//
+// Images are immutable, so the hash of a file is derived from the ID of the
+// image and the path of the file rather than from its content.
+func NewImageContext(root, imageID string, release func() error) Context {
+	return &imageContext{root: root, imageID: imageID, release: release}
+}
+
+func (c *imageContext) Close() error {
+	return c.release()
+}
+
+func (c *imageContext) Open(path string) (io.ReadCloser, error) {
+	cleanpath, fullpath, err := c.normalize(path)
+	if err != nil {
+		return nil, err
+	}
+	r, err := os.Open(fullpath)
+	if err != nil {
+		return nil, convertPathError(err, cleanpath)
+	}
+	return r, nil
+}
+
+func (c *imageContext) Stat(path string) (string, FileInfo, error) {
+	cleanpath, fullpath, err := c.normalize(path)
+	if err != nil {
+		return "", nil, err
+	}
+
+	st, err := os.Lstat(fullpath)
+	if err != nil {
+		return "", nil, convertPathError(err, cleanpath)
+	}
+
+	rel, err := filepath.Rel(c.root, fullpath)
+	if err != nil {
+		return "", nil, convertPathError(err, cleanpath)
+	}
+
+	fi := &HashedFileInfo{PathFileInfo{st, fullpath, filepath.Base(cleanpath)}, c.hash(rel)}
+	return rel, fi, nil
+}
+
+func (c *imageContext) Walk(root string, walkFn WalkFunc) error {
+	root = filepath.Join(c.root, filepath.Join(string(filepath.Separator), root))
+	return filepath.Walk(root, func(fullpath string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(c.root, fullpath)
+		if err != nil {
+			return err
+		}
+		if rel == "." {
+			return nil
+		}
+
+		fi := &HashedFileInfo{PathFileInfo{FileInfo: info, FilePath: fullpath}, c.hash(rel)}
+		return walkFn(rel, fi, nil)
+	})
+}
+
+func (c *imageContext) hash(rel string) string {
+	return c.imageID + ":" + filepath.ToSlash(rel)
+}
+
+func (c *imageContext) normalize(path string) (cleanpath, fullpath string, err error) {
+	cleanpath = filepath.Clean(string(os.PathSeparator) + path)[1:]
+	fullpath, err = symlink.FollowSymlinkInScope(filepath.Join(c.root, path), c.root)
+	if err != nil {
+		return "", "", fmt.Errorf("Forbidden path outside the image: %s (%s)", path, fullpath)
+	}
+	_, err = os.Lstat(fullpath)
+	if err != nil {
+		return "", "", convertPathError(err, path)
+	}
+	return
+}
@@ -7,6 +7,7 @@ import (
 	"os"
 	"strings"
 
+	"github.com/docker/docker/cli/i18n"
 	flag "github.com/docker/docker/pkg/mflag"
 )
 
@@ -39,7 +40,7 @@ func New(handlers ...Handler) *Cli {
 	return cli
 }
 
-var errCommandNotFound = errors.New("命令没有找到")
+var errCommandNotFound = errors.New("command not found")
 
 func (cli *Cli) command(args ...string) (func(...string) error, error) {
 	for _, c := range cli.handlers {
@@ -87,7 +88,7 @@ func (cli *Cli) noSuchCommand(command string) {
 	if cli.Stderr == nil {
 		cli.Stderr = os.Stderr
 	}
-	fmt.Fprintf(cli.Stderr, "docker: '%s' 不是一个 docker 命令.\n查看 'docker --help'.\n", command)
+	fmt.Fprintf(cli.Stderr, i18n.T("docker: '%s' is not a docker command.\nSee 'docker --help'.\n"), command)
 	os.Exit(1)
 }
 
@@ -164,7 +165,7 @@ func Subcmd(name string, synopses []string, description string, exitOnError bool
 			lead := "\t"
 			if i == 0 {
 				// First line needs the word 'Usage'.
-				lead = "Usage:\t"
+				lead = i18n.T("Usage:\t")
 			}
 
 			if synopsis != "" {
@@ -174,7 +175,7 @@ func Subcmd(name string, synopses []string, description string, exitOnError bool
 			fmt.Fprintf(flags.Out(), "\n%sdocker %s%s", lead, name, synopsis)
 		}
 
-		fmt.Fprintf(flags.Out(), "\n\n%s\n", description)
+		fmt.Fprintf(flags.Out(), "\n\n%s\n", i18n.T(description))
 	}
 
 	return flags
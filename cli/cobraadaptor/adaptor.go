@@ -4,10 +4,12 @@ import (
 	"github.com/docker/docker/api/client"
 	"github.com/docker/docker/api/client/container"
 	"github.com/docker/docker/api/client/image"
+	"github.com/docker/docker/api/client/mirror"
 	"github.com/docker/docker/api/client/network"
 	"github.com/docker/docker/api/client/node"
 	"github.com/docker/docker/api/client/plugin"
 	"github.com/docker/docker/api/client/registry"
+	"github.com/docker/docker/api/client/secret"
 	"github.com/docker/docker/api/client/service"
 	"github.com/docker/docker/api/client/stack"
 	"github.com/docker/docker/api/client/swarm"
@@ -15,6 +17,7 @@ import (
 	"github.com/docker/docker/api/client/volume"
 	"github.com/docker/docker/cli"
 	cliflags "github.com/docker/docker/cli/flags"
+	"github.com/docker/docker/cli/i18n"
 	"github.com/docker/docker/pkg/term"
 	"github.com/spf13/cobra"
 )
@@ -33,20 +36,22 @@ func NewCobraAdaptor(clientFlags *cliflags.ClientFlags) CobraAdaptor {
 
 	var rootCmd = &cobra.Command{
 		Use:           "docker [OPTIONS]",
-		Short:         "一个为容器而生的运行时管理引擎",
+		Short:         i18n.T("A self-sufficient runtime for containers"),
 		SilenceUsage:  true,
 		SilenceErrors: true,
 	}
-	rootCmd.SetUsageTemplate(usageTemplate)
+	rootCmd.SetUsageTemplate(i18n.T(usageTemplate))
 	rootCmd.SetHelpTemplate(helpTemplate)
 	rootCmd.SetFlagErrorFunc(cli.FlagErrorFunc)
 	rootCmd.SetOutput(stdout)
 	rootCmd.AddCommand(
 		node.NewNodeCommand(dockerCli),
+		secret.NewSecretCommand(dockerCli),
 		service.NewServiceCommand(dockerCli),
 		stack.NewStackCommand(dockerCli),
 		stack.NewTopLevelDeployCommand(dockerCli),
 		swarm.NewSwarmCommand(dockerCli),
+		container.NewContainerCommand(dockerCli),
 		container.NewAttachCommand(dockerCli),
 		container.NewCommitCommand(dockerCli),
 		container.NewCopyCommand(dockerCli),
@@ -68,6 +73,7 @@ func NewCobraAdaptor(clientFlags *cliflags.ClientFlags) CobraAdaptor {
 		container.NewTopCommand(dockerCli),
 		container.NewUnpauseCommand(dockerCli),
 		container.NewWaitCommand(dockerCli),
+		image.NewImageCommand(dockerCli),
 		image.NewBuildCommand(dockerCli),
 		image.NewHistoryCommand(dockerCli),
 		image.NewImagesCommand(dockerCli),
@@ -79,17 +85,19 @@ func NewCobraAdaptor(clientFlags *cliflags.ClientFlags) CobraAdaptor {
 		image.NewSearchCommand(dockerCli),
 		image.NewImportCommand(dockerCli),
 		image.NewTagCommand(dockerCli),
+		mirror.NewMirrorCommand(dockerCli),
 		network.NewNetworkCommand(dockerCli),
 		system.NewEventsCommand(dockerCli),
 		registry.NewLoginCommand(dockerCli),
 		registry.NewLogoutCommand(dockerCli),
+		system.NewSystemCommand(dockerCli),
 		system.NewVersionCommand(dockerCli),
 		volume.NewVolumeCommand(dockerCli),
 	)
 	plugin.NewPluginCommand(rootCmd, dockerCli)
 
-	rootCmd.PersistentFlags().BoolP("help", "h", false, "打印用途")
-	rootCmd.PersistentFlags().MarkShorthandDeprecated("help", "请使用 --help")
+	rootCmd.PersistentFlags().BoolP("help", "h", false, i18n.T("Print usage"))
+	rootCmd.PersistentFlags().MarkShorthandDeprecated("help", i18n.T("please use --help"))
 
 	return CobraAdaptor{
 		rootCmd:   rootCmd,
@@ -136,23 +144,23 @@ func (c CobraAdaptor) GetRootCommand() *cobra.Command {
 	return c.rootCmd
 }
 
-var usageTemplate = `用途:	{{if not .HasSubCommands}}{{.UseLine}}{{end}}{{if .HasSubCommands}}{{ .CommandPath}} COMMAND{{end}}
+var usageTemplate = `Usage:	{{if not .HasSubCommands}}{{.UseLine}}{{end}}{{if .HasSubCommands}}{{ .CommandPath}} COMMAND{{end}}
 
 {{ .Short | trim }}{{if gt .Aliases 0}}
 
-别名:
+Aliases:
   {{.NameAndAliases}}{{end}}{{if .HasExample}}
 
-案例:
+Examples:
 {{ .Example }}{{end}}{{if .HasFlags}}
 
-选项:
+Options:
 {{.Flags.FlagUsages | trimRightSpace}}{{end}}{{ if .HasAvailableSubCommands}}
 
-命令:{{range .Commands}}{{if .IsAvailableCommand}}
+Commands:{{range .Commands}}{{if .IsAvailableCommand}}
   {{rpad .Name .NamePadding }} {{.Short}}{{end}}{{end}}{{end}}{{ if .HasSubCommands }}
 
-运行 '{{.CommandPath}} COMMAND --help' 来获取此命令的更多详细信息.{{end}}
+Run '{{.CommandPath}} COMMAND --help' for more information on a command.{{end}}
 `
 
 var helpTemplate = `
@@ -4,6 +4,8 @@ import (
 	"fmt"
 
 	"github.com/spf13/cobra"
+
+	"github.com/docker/docker/cli/i18n"
 )
 
 // FlagErrorFunc prints an error messages which matches the format of the
@@ -19,7 +21,7 @@ func FlagErrorFunc(cmd *cobra.Command, err error) error {
 	}
 
 	return StatusError{
-		Status:     fmt.Sprintf("%s\n查看 '%s --help'.%s", err, cmd.CommandPath(), usage),
+		Status:     fmt.Sprintf(i18n.T("%s\nSee '%s --help'.%s"), err, cmd.CommandPath(), usage),
 		StatusCode: 125,
 	}
 }
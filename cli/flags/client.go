@@ -9,4 +9,5 @@ type ClientFlags struct {
 	PostParse func()
 
 	ConfigDir string
+	Language  string
 }
@@ -6,6 +6,7 @@ import (
 	"path/filepath"
 
 	"github.com/Sirupsen/logrus"
+	"github.com/docker/docker/cli/i18n"
 	"github.com/docker/docker/cliconfig"
 	"github.com/docker/docker/opts"
 	flag "github.com/docker/docker/pkg/mflag"
@@ -56,20 +57,20 @@ func InitCommonFlags() *CommonFlags {
 
 	cmd := commonFlags.FlagSet
 
-	cmd.BoolVar(&commonFlags.Debug, []string{"D", "-debug"}, false, "开启调试模式")
-	cmd.StringVar(&commonFlags.LogLevel, []string{"l", "-log-level"}, "info", "设置日志级别")
-	cmd.BoolVar(&commonFlags.TLS, []string{"-tls"}, false, "使用TLS通过参数--tlsverify")
-	cmd.BoolVar(&commonFlags.TLSVerify, []string{"-tlsverify"}, dockerTLSVerify, "使用TLS来验证远程连接")
+	cmd.BoolVar(&commonFlags.Debug, []string{"D", "-debug"}, false, i18n.T("Enable debug mode"))
+	cmd.StringVar(&commonFlags.LogLevel, []string{"l", "-log-level"}, "info", i18n.T("Set the logging level"))
+	cmd.BoolVar(&commonFlags.TLS, []string{"-tls"}, false, i18n.T("Use TLS; implied by --tlsverify"))
+	cmd.BoolVar(&commonFlags.TLSVerify, []string{"-tlsverify"}, dockerTLSVerify, i18n.T("Use TLS and verify the remote"))
 
 	// TODO use flag flag.String([]string{"i", "-identity"}, "", "Path to libtrust key file")
 
 	var tlsOptions tlsconfig.Options
 	commonFlags.TLSOptions = &tlsOptions
-	cmd.StringVar(&tlsOptions.CAFile, []string{"-tlscacert"}, filepath.Join(dockerCertPath, DefaultCaFile), "仅被CA签名的受信certs路径信息")
-	cmd.StringVar(&tlsOptions.CertFile, []string{"-tlscert"}, filepath.Join(dockerCertPath, DefaultCertFile), "TLS 证书文件的路径信息")
-	cmd.StringVar(&tlsOptions.KeyFile, []string{"-tlskey"}, filepath.Join(dockerCertPath, DefaultKeyFile), "TLS 密钥文件路径信息")
+	cmd.StringVar(&tlsOptions.CAFile, []string{"-tlscacert"}, filepath.Join(dockerCertPath, DefaultCaFile), i18n.T("Trust certs signed only by this CA"))
+	cmd.StringVar(&tlsOptions.CertFile, []string{"-tlscert"}, filepath.Join(dockerCertPath, DefaultCertFile), i18n.T("Path to TLS certificate file"))
+	cmd.StringVar(&tlsOptions.KeyFile, []string{"-tlskey"}, filepath.Join(dockerCertPath, DefaultKeyFile), i18n.T("Path to TLS key file"))
 
-	cmd.Var(opts.NewNamedListOptsRef("hosts", &commonFlags.Hosts, opts.ValidateHost), []string{"H", "-host"}, "Docker引擎监听的套接字")
+	cmd.Var(opts.NewNamedListOptsRef("hosts", &commonFlags.Hosts, opts.ValidateHost), []string{"H", "-host"}, i18n.T("Daemon socket(s) to connect to"))
 	return commonFlags
 }
 
@@ -113,7 +114,7 @@ func SetDaemonLogLevel(logLevel string) {
 	if logLevel != "" {
 		lvl, err := logrus.ParseLevel(logLevel)
 		if err != nil {
-			fmt.Fprintf(os.Stderr, "无法解析日志级别: %s\n", logLevel)
+			fmt.Fprintf(os.Stderr, i18n.T("Unable to parse logging level: %s\n"), logLevel)
 			os.Exit(1)
 		}
 		logrus.SetLevel(lvl)
@@ -0,0 +1,9 @@
+package i18n
+
+// enUS holds the English messages. Keys are already written in English, so
+// the catalog only needs entries whose displayed text differs from the key.
+var enUS = map[string]string{}
+
+func init() {
+	Register("en_US", enUS)
+}
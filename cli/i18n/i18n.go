@@ -0,0 +1,181 @@
+// Package i18n provides the message catalogs used to localize the output of
+// the command line client.
+//
+// Messages are looked up by their English source text. This keeps call sites
+// readable and lets strings merged from upstream be used as keys unchanged.
+// When the selected locale has no translation for a key, the lookup falls
+// back to the other locales of the same language, then to the en_US catalog
+// and finally to the key itself.
+package i18n
+
+import (
+	"os"
+	"sort"
+	"strings"
+	"sync"
+)
+
+const (
+	// DefaultLocale is used when no language is requested through the
+	// command line, the configuration file or the environment.
+	DefaultLocale = "zh_CN"
+	// SourceLocale is the language the message keys are written in.
+	SourceLocale = "en_US"
+)
+
+var (
+	mu        sync.Mutex
+	catalogs  = make(map[string]map[string]string)
+	requested string
+	// chain is the list of locales consulted by T, resolved lazily so that
+	// catalogs registered after SetLocale are taken into account.
+	chain []string
+)
+
+// Register adds messages to the catalog of the given locale. Existing
+// messages with the same key are overwritten.
+func Register(locale string, messages map[string]string) {
+	mu.Lock()
+	defer mu.Unlock()
+
+	locale = Normalize(locale)
+	catalog, ok := catalogs[locale]
+	if !ok {
+		catalog = make(map[string]string, len(messages))
+		catalogs[locale] = catalog
+	}
+	for key, msg := range messages {
+		catalog[key] = msg
+	}
+	chain = nil
+}
+
+// SetLocale selects the locale used by T and returns the locale that is
+// effectively used after applying the fallback chain. An empty locale
+// selects the language from the environment.
+func SetLocale(locale string) string {
+	mu.Lock()
+	defer mu.Unlock()
+
+	requested = locale
+	chain = resolve(requested)
+	return chain[0]
+}
+
+// Locale returns the locale currently used by T.
+func Locale() string {
+	mu.Lock()
+	defer mu.Unlock()
+
+	if chain == nil {
+		chain = resolve(requested)
+	}
+	return chain[0]
+}
+
+// Locales returns the sorted list of locales with a registered catalog.
+func Locales() []string {
+	mu.Lock()
+	defer mu.Unlock()
+
+	return registered()
+}
+
+// T returns the translation of key in the current locale.
+func T(key string) string {
+	mu.Lock()
+	defer mu.Unlock()
+
+	if chain == nil {
+		chain = resolve(requested)
+	}
+	for _, locale := range chain {
+		if msg, ok := catalogs[locale][key]; ok {
+			return msg
+		}
+	}
+	return key
+}
+
+// FromEnv returns the locale requested by the environment, honouring the
+// usual precedence of LC_ALL, LC_MESSAGES and LANG. The "C" and "POSIX"
+// locales select the untranslated messages.
+func FromEnv() string {
+	for _, name := range []string{"LC_ALL", "LC_MESSAGES", "LANG"} {
+		value := os.Getenv(name)
+		if value == "" {
+			continue
+		}
+		if value == "C" || value == "POSIX" || strings.HasPrefix(value, "C.") {
+			return SourceLocale
+		}
+		return value
+	}
+	return ""
+}
+
+// Normalize converts locale names such as "zh_CN.UTF-8", "en-us" or
+// "zh_TW@hant" to the "language_TERRITORY" form used by the catalogs.
+func Normalize(locale string) string {
+	if i := strings.IndexAny(locale, ".@"); i >= 0 {
+		locale = locale[:i]
+	}
+	parts := strings.SplitN(strings.Replace(locale, "-", "_", -1), "_", 2)
+	lang := strings.ToLower(parts[0])
+	if len(parts) == 1 {
+		return lang
+	}
+	return lang + "_" + strings.ToUpper(parts[1])
+}
+
+// resolve builds the lookup chain for the requested locale. It must be called
+// with mu held.
+func resolve(locale string) []string {
+	if locale == "" {
+		locale = FromEnv()
+	}
+	if locale == "" {
+		locale = DefaultLocale
+	}
+	locale = Normalize(locale)
+
+	var locales []string
+	add := func(l string) {
+		if _, ok := catalogs[l]; !ok {
+			return
+		}
+		for _, existing := range locales {
+			if existing == l {
+				return
+			}
+		}
+		locales = append(locales, l)
+	}
+
+	add(locale)
+	lang := language(locale)
+	for _, l := range registered() {
+		if language(l) == lang {
+			add(l)
+		}
+	}
+	add(SourceLocale)
+
+	if len(locales) == 0 {
+		locales = []string{SourceLocale}
+	}
+	return locales
+}
+
+func registered() []string {
+	locales := make([]string, 0, len(catalogs))
+	for locale := range catalogs {
+		locales = append(locales, locale)
+	}
+	sort.Strings(locales)
+	return locales
+}
+
+func language(locale string) string {
+	return strings.SplitN(locale, "_", 2)[0]
+}
@@ -0,0 +1,151 @@
+package i18n
+
+import (
+	"os"
+	"regexp"
+	"sort"
+	"strings"
+	"testing"
+)
+
+func TestNormalize(t *testing.T) {
+	cases := map[string]string{
+		"zh_CN":       "zh_CN",
+		"zh_CN.UTF-8": "zh_CN",
+		"en-us":       "en_US",
+		"zh_TW@hant":  "zh_TW",
+		"EN":          "en",
+		"":            "",
+	}
+	for in, expected := range cases {
+		if out := Normalize(in); out != expected {
+			t.Errorf("Normalize(%q): expected %q, got %q", in, expected, out)
+		}
+	}
+}
+
+func TestFromEnv(t *testing.T) {
+	defer restoreEnv("LC_ALL", "LC_MESSAGES", "LANG")()
+
+	cases := []struct {
+		all, messages, lang string
+		expected            string
+	}{
+		{"", "", "", ""},
+		{"", "", "zh_CN.UTF-8", "zh_CN.UTF-8"},
+		{"", "en_US", "zh_CN", "en_US"},
+		{"zh_CN", "en_US", "en_US", "zh_CN"},
+		{"", "", "C", SourceLocale},
+		{"", "", "POSIX", SourceLocale},
+		{"C.UTF-8", "", "zh_CN", SourceLocale},
+	}
+	for _, c := range cases {
+		os.Setenv("LC_ALL", c.all)
+		os.Setenv("LC_MESSAGES", c.messages)
+		os.Setenv("LANG", c.lang)
+		if out := FromEnv(); out != c.expected {
+			t.Errorf("FromEnv(%+v): expected %q, got %q", c, c.expected, out)
+		}
+	}
+}
+
+func TestSetLocale(t *testing.T) {
+	defer restoreEnv("LC_ALL", "LC_MESSAGES", "LANG")()
+	defer SetLocale("")
+
+	os.Setenv("LC_ALL", "")
+	os.Setenv("LC_MESSAGES", "")
+	os.Setenv("LANG", "")
+
+	cases := map[string]string{
+		"":            DefaultLocale,
+		"en_US":       "en_US",
+		"zh_CN.UTF-8": "zh_CN",
+		"zh-cn":       "zh_CN",
+		"zh_TW":       "zh_CN",
+		"zh":          "zh_CN",
+		"fr_FR":       SourceLocale,
+	}
+	for in, expected := range cases {
+		if out := SetLocale(in); out != expected {
+			t.Errorf("SetLocale(%q): expected %q, got %q", in, expected, out)
+		}
+	}
+
+	os.Setenv("LANG", "C")
+	if out := SetLocale(""); out != SourceLocale {
+		t.Errorf("SetLocale with LANG=C: expected %q, got %q", SourceLocale, out)
+	}
+}
+
+func TestTranslate(t *testing.T) {
+	defer SetLocale("")
+
+	Register("xx_YY", map[string]string{"hello": "hello from xx_YY"})
+	Register("xx", map[string]string{"hello": "hello from xx", "world": "world from xx"})
+	Register(SourceLocale, map[string]string{"only in source": "source"})
+	defer func() {
+		delete(catalogs, "xx_YY")
+		delete(catalogs, "xx")
+		delete(catalogs[SourceLocale], "only in source")
+		chain = nil
+	}()
+
+	SetLocale("xx_YY")
+	for key, expected := range map[string]string{
+		"hello":          "hello from xx_YY",
+		"world":          "world from xx",
+		"only in source": "source",
+		"missing":        "missing",
+	} {
+		if out := T(key); out != expected {
+			t.Errorf("T(%q): expected %q, got %q", key, expected, out)
+		}
+	}
+
+	SetLocale("en_US")
+	if out := T("hello"); out != "hello" {
+		t.Errorf("T(%q) in en_US: expected the key, got %q", "hello", out)
+	}
+}
+
+var verbRegexp = regexp.MustCompile(`%(\[\d+\])?[-+# 0]*\d*(\.\d+)?[a-zA-Z%]`)
+
+// TestCatalogVerbs makes sure that translations keep the formatting verbs of
+// their keys, so that they can be used as format strings in place of the key.
+func TestCatalogVerbs(t *testing.T) {
+	for locale, catalog := range catalogs {
+		for key, msg := range catalog {
+			expected := verbs(key)
+			if out := verbs(msg); out != expected {
+				t.Errorf("%s: %q has verbs %q, expected %q", locale, msg, out, expected)
+			}
+		}
+	}
+}
+
+// verbs returns the sorted set of verbs used in format, ignoring explicit
+// argument indexes which translations may use to reorder arguments.
+func verbs(format string) string {
+	var found []string
+	for _, v := range verbRegexp.FindAllString(format, -1) {
+		if i := strings.Index(v, "]"); i >= 0 {
+			v = "%" + v[i+1:]
+		}
+		found = append(found, v)
+	}
+	sort.Strings(found)
+	return strings.Join(found, " ")
+}
+
+func restoreEnv(names ...string) func() {
+	saved := make(map[string]string)
+	for _, name := range names {
+		saved[name] = os.Getenv(name)
+	}
+	return func() {
+		for name, value := range saved {
+			os.Setenv(name, value)
+		}
+	}
+}
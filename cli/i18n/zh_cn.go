@@ -0,0 +1,853 @@
+package i18n
+
+// zhCN holds the Simplified Chinese translations of the client messages, keyed
+// by their English source text.
+var zhCN = map[string]string{
+	"  CPU:\t\t%g\n":                       "  CPU资源:\t\t%g\n",
+	"  Disabled":                           "  已禁用",
+	"  Election Tick: %d\n":                "  选举时钟: %d\n",
+	"  Error Rate: %.0f%%\n":               "  错误率: %.0f%%\n",
+	"  Expiry Duration: %s\n":              "  过期周期: %s\n",
+	"  External CAs:\n":                    "  外部CAs:\n",
+	"  Heartbeat Period: %s\n":             "  心跳周期: %s\n",
+	"  Heartbeat Tick: %d\n":               "  心跳时钟: %d\n",
+	"  Interval = %s\n":                    "  间隔 = %s\n",
+	"  Last Failure: %s ago, %s\n":         "  最近一次失败: %s 之前, %s\n",
+	"  Latency: %s\n":                      "  延迟: %s\n",
+	"  Memory:\t%s\n":                      "  内存资源:\t%s\n",
+	"  ReadOnly = %v\n":                    "  只读 = %v\n",
+	"  Retries = %d\n":                     "  重试次数 = %d\n",
+	"  Skipped For: %s\n":                  "  暂停使用: %s\n",
+	"  Snapshot Interval: %d\n":            "  快照间隔: %d\n",
+	"  Source = %s\n":                      "  源地址 = %s\n",
+	"  Target = %s\n":                      "  目标地址 = %s\n",
+	"  Task History Retention Limit: %d\n": "  历史任务保留上线: %d\n",
+	"  Test = %s\n":                        "  检查命令 = %s\n",
+	"  Timeout = %s\n":                     "  超时 = %s\n",
+	"  Type = %v\n":                        "  挂载类型 = %v\n",
+	" Address:\t\t%s\n":                    " 监听地址:\t\t%s\n",
+	" Architecture:\t\t%s\n":               " 机器架构:\t\t%s\n",
+	" Args:\t\t%s\n":                       " 参数:\t\t%s\n",
+	" Authorization:":                      " 认证:",
+	" Availability:\t\t%s\n":               " 可达状态:\t\t%s\n",
+	" CA Configuration:\n":                 " CA配置:\n",
+	" CPUs:\t\t\t%d\n":                     " CPU总数量:\t\t\t%d\n",
+	" ClusterID: %s\n":                     " 集群ID: %s\n",
+	" Completed:\t%s ago\n":                " 完成时间:\t%s 之前\n",
+	" Constraints\t: %s\n":                 " 限制\t: %s\n",
+	" Delay:\t\t%s\n":                      " 更新延迟:\t\t%s\n",
+	" Dir\t\t%s\n":                         " 目录\t\t%s\n",
+	" Dispatcher:\n":                       " 分发器:\n",
+	" Env:\t\t%s\n":                        " 环境变量:\t\t%s\n",
+	" Error: %v\n":                         " 错误: %v\n",
+	" EventsListeners: %d\n":               " 事件监听者总数: %d\n",
+	" File Descriptors: %d\n":              " 文件描述符个数: %d\n",
+	" Goroutines: %d\n":                    " Go协程综述: %d\n",
+	" Healthcheck:":                        " 健康检查:",
+	" Image:\t\t%s\n":                      " 镜像:\t\t%s\n",
+	" Is Manager: %v\n":                    " 是否是管理者: %v\n",
+	" Leader:\t\t%s\n":                     " 领导者:\t\t%s\n",
+	" Managers: %d\n":                      " 管理者数量: %d\n",
+	" Max failure ratio:\t%g\n":            " 最大失败率:\t%g\n",
+	" Memory:\t\t%s\n":                     " 总内存:\t\t%s\n",
+	" Message:\t%s\n":                      " 更新消息:\t%s\n",
+	" Message:\t\t%s\n":                    " 状态消息:\t\t%s\n",
+	" Monitoring Period:\t%s\n":            " 监控周期:\t%s\n",
+	" Mounts:":                             " 挂载:",
+	" Name = %s\n":                         " 名称 = %s\n",
+	" Network:":                            " 网络:",
+	" Node Address: %s\n":                  " 节点地址: %s\n",
+	" NodeID: %s\n":                        " 节点ID: %s\n",
+	" Nodes: %d\n":                         " 节点数: %d\n",
+	" On failure:\t%s\n":                   " 出错重启策略:\t%s\n",
+	" Operating System:\t%s\n":             " 操作系统:\t%s\n",
+	" Orchestration:\n":                    " 编排:\n",
+	" Parallelism:\t%d\n":                  " 并行数:\t%d\n",
+	" Paused: %d\n":                        " 暂停: %d\n",
+	" Protocol = %s\n":                     " 协议 = %s\n",
+	" PublishedPort = %d\n":                " 暴露端口 = %d\n",
+	" Raft Status:\t\t%s\n":                " Raft一致性状态:\t\t%s\n",
+	" Replicas:\t%d\n":                     " 副本个数:\t%d\n",
+	" Running: %d\n":                       " 运行: %d\n",
+	" Secrets:":                            " 密钥:",
+	" Started:\t%s ago\n":                  " 启动时间:\t%s 之前\n",
+	" State:\t\t%s\n":                      " 状态:\t\t%s\n",
+	" State:\t\t\t%s\n":                    " 状态:\t\t\t%s\n",
+	" Stopped: %d\n":                       " 停止: %d\n",
+	" System Time: %s\n":                   " 系统时间: %s\n",
+	" TargetPort = %d\n":                   " 目标端口 = %d\n",
+	" User\t\t%s\n":                        " 用户\t\t%s\n",
+	" Volume:":                             " 存储卷:",
+	" WARNING: Usage of loopback devices is strongly discouraged for production use. Use `--storage-opt dm.thinpooldev` to specify a custom block storage device.": " 警告: 环回设备loopback严重不建议在生产环境中使用。详见 `--storage-opt dm.thinpooldev` 来指定一个自定义的块存储设备。",
+	"%q is not a valid value for --type":     "对 --type 而言，%q 不是一个有效的值",
+	"%s ago":                                 "%s 之前",
+	"%s flag redefined: %s":                  "%s 参数重复定义: %s",
+	"%s scaled to %s\n":                      "%s 已经扩展至 %s\n",
+	"%s\nSee '%s --help'.%s":                 "%s\n查看 '%s --help'.%s",
+	"--%s cannot be negative":                "--%s 不能为负数",
+	"--%s conflicts with --health-* options": "--%s 与 --health-* 选项冲突",
+	"--check requires a local directory or a Git repository as context": "--check 需要本地目录或 Git 仓库作为上下文",
+	"--format is incompatible with human friendly format":               "--format 参数和人工可读格式不兼容",
+	"A self-sufficient runtime for containers":                          "一个为容器而生的运行时管理引擎",
+	"A self-sufficient runtime for containers.\n\nOptions:\n":           "一个为容器而生的运行时管理引擎.\n\n选项:\n",
+	"AVAILABILITY": "可达性",
+	"Action on update failure (pause|continue|rollback)":           "更新失败时的策略: pause(暂停)|continue(继续)|rollback(回滚)",
+	"Add Linux capabilities":                                       "添加 Linux 特权",
+	"Add a custom host-to-IP mapping (host:ip)":                    "为容器添加一个自定义的主机名到IP的映射(主机名:IP)",
+	"Add a host device to the container":                           "为容器添加一个宿主机设备",
+	"Add a link-local address for the container":                   "为容器添加一个当地链接的地址",
+	"Add a secret to the service":                                  "为服务添加密钥",
+	"Add additional groups to join":                                "添加容器加入的额外组",
+	"Add link to another container":                                "添加到另一个容器的连接",
+	"Add network-scoped alias for the container":                   "为容器添加网络范围内的别名",
+	"Add one or more registry mirrors":                             "添加一个或多个镜像加速器",
+	"Add or update a mount on a service":                           "添加或更新一个服务的挂载项",
+	"Add or update a node label (key=value)":                       "添加或更新一个标签信息（键＝值）(key=value)",
+	"Add or update a published port":                               "添加或更新一个对外暴露的端口",
+	"Add or update container labels":                               "添加或更新容器标签",
+	"Add or update environment variables":                          "添加或更新环境变量",
+	"Add or update placement constraints":                          "添加或更新放置策略与限制",
+	"Add or update service labels":                                 "添加或更新服务标签",
+	"Advertised address (format: <ip|interface>[:port])":           "广播地址 （格式: <IP地址|网卡>[:端口]）",
+	"Allocate a pseudo-TTY":                                        "分配一个伪终端",
+	"Allocate container ip from a sub-range":                       "从一个子网范围内分配容器IP",
+	"Always attempt to pull a newer version of the image":          "总是尝试下拉最新版本的镜像",
+	"Always follow symbol link in SRC_PATH":                        "允许在源地址(SRC_PATH)中遵循符号链接的规则",
+	"Always remove intermediate containers":                        "总是产出中间结果的容器",
+	"Apply Dockerfile instruction to the created image":            "应用Dockerfile中的指令到新创建出的镜像",
+	"Architecture: %s\n":                                           "机器架构: %s\n",
+	"Are you sure you want to continue?":                           "确定要继续吗?",
+	"Assign a name to the container":                               "为容器赋予一个名称",
+	"Attach STDOUT/STDERR and forward signals":                     "附件标准输出/标准错误，同时转发信号",
+	"Attach a mount to the service":                                "为服务添加一个挂载项",
+	"Attach container's STDIN":                                     "附加容器的标准输入",
+	"Attach to STDIN, STDOUT or STDERR":                            "附加标准输入、标准输出和标准错误",
+	"Attach to a running container":                                "附加到一个运行的容器，包含标准输入，标准输出，标准错误",
+	"Author (e.g., \"John Hannibal Smith <hannibal@a-team.com>\")": "执行提交操作的作者 (比如, \"张三 <hannibal@a-team.com>\")",
+	"Automatically remove the container when it exits":             "当容器退出时自动删除容器",
+	"Auxiliary IPv4 or IPv6 addresses used by Network driver":      "被网络驱动使用的辅助IPv4或IPv6地址",
+	"Availability of the node (active/pause/drain)":                "节点的可达状态（活跃/暂停/维护）(active/pause/drain)",
+	"Back up the state of the swarm to an encrypted file":          "将Swarm集群的状态备份到加密文件",
+	"Bind mount a volume":                                          "绑定挂载一个存储卷",
+	"Block IO (relative weight), between 10 and 1000":              "磁盘IO设置(相对值),从10到1000",
+	"Block IO weight (relative device weight)":                     "磁盘设备IO设置(相对值),从10到1000",
+	"Block until a container stops, then print its exit code":      "阻塞直到一个或多个容器停止运行，并打印它们的容器退出码",
+	"Build Cache":                      "构建缓存",
+	"Build an image from a Dockerfile": "从一个Dockerfile构建新的镜像",
+	"Build cache usage: %s":            "构建缓存使用情况：%s",
+	"COMMAND":                          "启动命令",
+	"CONTAINER ID	IMAGE	LOCAL VOLUMES	SIZE	CREATED	STATUS	NAMES":             "容器 ID	镜像	本地数据卷	大小	创建时间	状态	名称",
+	"CONTAINER\tCPU %\tMEM USAGE / LIMIT\tMEM %\tNET I/O\tBLOCK I/O\tPIDS\n": "容器\tCPU %\t内存使用量/限制\t内存 %\t网络I/O\t磁盘I/O\t进程号\n",
+	"CPU percent (Windows only)":                                             "CPU百分比(只支持Windows)",
+	"CPU shares (relative weight)":                                           "CPU计算资源的值(相对值)",
+	"CPUs in which to allow execution (0-3, 0,1)":                            "允许容器执行的CPU核指定(0-3,0,1): 0-3代表运行运行在0,1,2,3这4个核上",
+	"CPUs: %d\n":    "CPU数量: %d\n",
+	"CREATED":       "创建时间",
+	"CURRENT STATE": "实际状态",
+	"Cannot canonicalize dockerfile path %s: %v":                      "不能规范Dockerfile路径 %s: %v",
+	"Cgroup Driver: %s\n":                                             "Cgroup 驱动: %s\n",
+	"Change settings for a plugin":                                    "为指定插件修改配置",
+	"Check the Dockerfile for common mistakes instead of building it": "检查 Dockerfile 中的常见错误而不构建镜像",
+	`Client:
+ Version:      {{.Client.Version}}
+ API version:  {{.Client.APIVersion}}
+ Go version:   {{.Client.GoVersion}}
+ Git commit:   {{.Client.GitCommit}}
+ Built:        {{.Client.BuildTime}}
+ OS/Arch:      {{.Client.Os}}/{{.Client.Arch}}{{if .Client.Experimental}}
+ Experimental: {{.Client.Experimental}}{{end}}{{if .ServerOK}}
+
+Server:
+ Version:      {{.Server.Version}}
+ API version:  {{.Server.APIVersion}}
+ Go version:   {{.Server.GoVersion}}
+ Git commit:   {{.Server.GitCommit}}
+ Built:        {{.Server.BuildTime}}
+ OS/Arch:      {{.Server.Os}}/{{.Server.Arch}}{{if .Server.Experimental}}
+ Experimental: {{.Server.Experimental}}{{end}}{{end}}`: `Client:
+ 版本:      {{.Client.Version}}
+ API版本:  {{.Client.APIVersion}}
+ Go 版本:   {{.Client.GoVersion}}
+ Git提交号:   {{.Client.GitCommit}}
+ 构建:        {{.Client.BuildTime}}
+ 操作系统/架构:      {{.Client.Os}}/{{.Client.Arch}}{{if .Client.Experimental}}
+ 试验版: {{.Client.Experimental}}{{end}}{{if .ServerOK}}
+Server:
+ 版本:      {{.Server.Version}}
+ API 版本:  {{.Server.APIVersion}}
+ Go 版本:   {{.Server.GoVersion}}
+ Git提交号:   {{.Server.GitCommit}}
+ 构建:        {{.Server.BuildTime}}
+ 操作系统/架构:      {{.Server.Os}}/{{.Server.Arch}}{{if .Server.Experimental}}
+ 试验版: {{.Server.Experimental}}{{end}}{{end}}`,
+	"Cluster Advertise: %s\n":                         "集群广播地址: %s\n",
+	"Cluster Store: %s\n":                             "集群存储: %s\n",
+	"Command to run to check health":                  "运行健康的检查的命令",
+	"Commit message":                                  "容器提交镜像的消息",
+	"Confirm passphrase: ":                            "确认口令: ",
+	"Conflicting options: --restart and --rm":         "选项冲突: --restart and --rm",
+	"Conflicting options: --rm and -d":                "选项冲突: --rm and -d",
+	"Conflicting options: -a and -d":                  "选项冲突: -a and -d",
+	"Connect a container to a network":                "连接一个容器到一个网络",
+	"Consecutive failures needed to report unhealthy": "需要汇报不健康的最终错误次数",
+	"Container ID file found, make sure the other container isn't running or delete %s": "容器ID文件已找到，请确保其他的容器并没有在运行或被删除 %s",
+	"Container IPv4 address (e.g. 172.30.100.104)":                                      "容器的IPv4地址(比如172.30.100.104)",
+	"Container IPv4/IPv6 link-local addresses":                                          "容器 IPv4/IPv6 本地连接地址",
+	"Container IPv6 address (e.g. 2001:db8::33)":                                        "容器的IPv6地址(比如2001:db8::33)",
+	"Container MAC address (e.g. 92:d0:c6:0a:29:33)":                                    "容器MAC地址 (e.g. 92:d0:c6:0a:29:33)",
+	"Container host name":                                                               "容器的主机名",
+	"Container isolation technology":                                                    "容器的隔离技术",
+	"Container labels":                                                                  "服务中容器的标签",
+	"Container name cannot be empty":                                                    "容器名称不能为空",
+	"ContainerSpec:\n":                                                                  "容器配置:\n",
+	"Containers":                                                                        "容器",
+	"Containers space usage:":                                                           "容器空间使用情况：",
+	"Containers: %d\n":                                                                  "容器数: %d\n",
+	"Copy files/folders between a container and the local filesystem":                   "在容器和宿主机本地文件系统之间拷贝文件",
+	"Cowardly refusing to save to a terminal. Use the -o flag or redirect.":             "终端拒绝保存输出内容，请您使用 -o 参数或者重定向。",
+	"Create a network":                                                                  "创建一个网络",
+	"Create a new container":                                                            "创建一个新的容器，属于容器实体，未开始运行",
+	"Create a new image from a container's changes":                                     "从一个容器的变化部分创建一个新的镜像",
+	"Create a new service":                                                              "创建一个新的服务",
+	"Create a secret from a file or STDIN as content":                                   "以文件或标准输入的内容创建密钥",
+	"Create a volume":                                                                   "创建一个数据存储卷",
+	"Create and update a stack from a Distributed Application Bundle (DAB)":             "从一个应用描述bundle（dab文件）中创建和更新一个stack",
+	"Creating network %s\n":                                                             "创建网络 %s\n",
+	"Creating service %s\n":                                                             "创建服务 %s\n",
+	"DESIRED STATE":                                                                     "预期状态",
+	"DRIVER \tVOLUME NAME":                                                              "驱动 \t数据卷名称",
+	"Daemon socket(s) to connect to":                                                    "Docker引擎监听的套接字",
+	"Debug Mode (client): %v\n":                                                         "调试模式(客户端): %v\n",
+	"Debug Mode (server): %v\n":                                                         "调试模式(服务端): %v\n",
+	"Default Runtime: %s\n":                                                             "默认运行时: %s\n",
+	"Delay between restart attempts":                                                    "两次重启之间的延时",
+	"Delay between updates":                                                             "两次更新之间的延时",
+	"Deleted Containers:":                                                               "已删除的容器:",
+	"Deleted Images:":                                                                   "已删除的镜像:",
+	"Deleted Networks:":                                                                 "已删除的网络:",
+	"Deleted Volumes:":                                                                  "已删除的数据卷:",
+	"Deleted: %s\n":                                                                     "已删除: %s\n",
+	"Demote one or more nodes from manager in the swarm":                                "将Swarm集群中的一个或多个节点从管理者降级为工作者",
+	"Detached mode: run command in the background":                                      "后台模式: 在后台运行用户指定的命令",
+	"Disable OOM Killer":                                                                "禁用OOM Killer",
+	"Disable a plugin":                                                                  "禁用指定插件",
+	"Disable any container-specified HEALTHCHECK":                                       "禁用容器内任何指定的健康检查",
+	"Disable streaming stats and only pull the first result":                            "禁用实时数据并下拉第一次返回的结果",
+	"Disconnect a container from a network":                                             "断开一个容器和一个网络的连接",
+	"Dispatcher heartbeat period":                                                       "分发器的心跳周期",
+	"Display a live stream of container(s) resource usage statistics":                   "显示容器资源使用情况的实时流",
+	"Display all tasks":                                                                 "显示所有任务",
+	"Display detailed information on one or more networks":                              "显示一个或多个网络的详细信息",
+	"Display detailed information on one or more nodes":                                 "显示Swarm集群中一个或多个节点的详细信息",
+	"Display detailed information on one or more secrets":                               "显示一个或多个密钥的详细信息",
+	"Display detailed information on one or more services":                              "显示一个或多个服务的详细信息",
+	"Display detailed information on one or more volumes":                               "显示一个或多个存储卷的详细信息",
+	"Display system-wide information":                                                   "显示Docker引擎系统级别的信息",
+	"Display the running processes of a container":                                      "显示一个运行容器中运行的所有进程信息",
+	"Display total file sizes":                                                          "显示所有的文件大小",
+	"Display total file sizes if the type is container":                                 "如果类型为容器，显示所有的文件大小信息",
+	"Do not attach STDIN":                                                               "不附加标准输入",
+	"Do not delete untagged parents":                                                    "不删除没有标签的父镜像",
+	"Do not map IDs to Names":                                                           "不将ID解析成名称",
+	"Do not prompt for confirmation":                                                    "不提示确认",
+	"Do not truncate the output":                                                        "不截断命令输出内容",
+	"Do not use cache when building the image":                                          "构建镜像时不使用镜像缓存",
+	"Do you grant the above permissions? [y/N] ":                                        "您允许授予以下特权? [y/N] ",
+	"Docker Root Dir: %s\n":                                                             "Docker引擎根目录: %s\n",
+	"Docker version %s, build %s, experimental\n":                                       "Docker引擎版本 %s, 构建 %s, 试验版\n",
+	"Docker version %s, build %s\n":                                                     "Docker引擎版本 %s, 构建 %s\n",
+	"Don't truncate output":                                                             "不截断输出",
+	"Download all tagged images in the repository":                                      "从镜像仓库中下拉所有标签的镜像",
+	"Driver to manage the Network":                                                      "管理网络所使用的网络驱动",
+	"Drop Linux capabilities":                                                           "丢弃 Linux 特权",
+	"Duration after each task update to monitor for failure":                            "每个任务更新后监控故障的时长",
+	"ERROR":                         "错误",
+	"Email":                         "邮箱",
+	"Enable IPv6 networking":        "启用 IPv6 网络",
+	"Enable a plugin":               "启用指定插件",
+	"Enable debug mode":             "开启调试模式",
+	"Endpoint mode (vip or dnsrr)":  "网络模式: vip(虚拟IP)|dnsrr(DNS轮询)",
+	"Engine Labels:":                "Docker引擎标签:",
+	"Engine Version:\t\t%s\n":       "Docker引擎版本:\t\t%s\n",
+	"Error checking context: '%s'.": "检验构建上下文出错: '%s'.",
+	"Error establishing connection to notary repository: %s\n":         "连接 notary 仓库出错: %s\n",
+	"Error establishing connection to trust repository: %s\n":          "连接信任仓库出错: %s\n",
+	"Error monitoring TTY size: %s\n":                                  "监视终端大小出错: %s\n",
+	"Error reading %s: %v\n":                                           "读取 %s 出错: %v\n",
+	"Error reading content from %q: %v":                                "从 %q 读取内容出错: %v",
+	"Error saving credentials: %v":                                     "保存认证信息失败: %v",
+	"Error saving mirrors: %v":                                         "保存镜像加速器配置出错: %v",
+	"Error: Cannot perform an interactive login from a non TTY device": "错误: 无法在非 TTY 设备上进行交互式登录",
+	"Error: Neither old nor new names may be empty":                    "错误: 新名称和旧名称均不能为空",
+	"Error: No public port '%s' published for %s":                      "错误: 没有暴露端口'%s'给容器 %s",
+	"Error: No such container, image or task: %s":                      "错误：没有次容器，镜像，任务: %s",
+	"Error: Non-null Username Required":                                "错误: 用户名不能为空",
+	"Error: Password Required":                                         "错误: 需要密码",
+	"Error: could not find signing keys for remote repository %s, or could not decrypt signing key: %v":    "错误: 找不到远程仓库 %s 的签名密钥, 或无法解密签名密钥: %v",
+	"Error: could not produce valid signature for %s.  If Yubikey was used, was touch input provided?: %v": "错误: 无法为 %s 生成有效签名. 如果使用了 Yubikey, 是否已触摸确认?: %v",
+	"Error: error contacting notary server: %v":                                                            "错误: 连接 notary 服务器出错: %v",
+	"Error: failed to rename container named %s":                                                           "错误: 重命名容器名称到 %s 失败",
+	"Error: failed to start containers: %v":                                                                "错误: 启动容器失败:  %v",
+	"Error: no such service: %s":                                                                           "错误: 没有该服务 %s",
+	"Error: no trust data available for remote repository %s. Try running notary server and setting DOCKER_CONTENT_TRUST_SERVER to its HTTPS address?": "错误: 远程仓库 %s 没有可用的信任数据. 请尝试运行 notary 服务器并将 DOCKER_CONTENT_TRUST_SERVER 设置为它的 HTTPS 地址",
+	"Error: remote repository %s out-of-date: %v":                                           "错误: 远程仓库 %s 已过期: %v",
+	"Error: remote trust data does not exist for %s: %v":                                    "错误: %s 的远程信任数据不存在: %v",
+	"Error: signing keys for remote repository %s not found: %v":                            "错误: 未找到远程仓库 %s 的签名密钥: %v",
+	"Error: trust data missing for remote repository %s or remote repository not found: %v": "错误: 远程仓库 %s 缺少信任数据或远程仓库不存在: %v",
+	"Experimental: %v\n": "试验版: %v\n",
+	"Export a container's filesystem as a tar archive":        "以一个压缩包的形式导出一个容器的文件系统",
+	"Expose a port or a range of ports":                       "暴露一个或者指定范围的端口",
+	"Failed to create the container ID file: %s":              "创建容器ID文件失败: %s",
+	"Failed to parse %v as a rational number":                 "无法将 %v 解析为有理数",
+	"Failed to pull from mirror %s: %v\n":                     "从镜像加速器 %s 拉取失败: %v\n",
+	"Failed to remove network %s: %s":                         "删除网络 %s 失败: %s",
+	"Failed to remove service %s: %s":                         "删除服务 %s 失败: %s",
+	"Failed to remove some resources":                         "删除部分资源失败",
+	"Failed to remove the CID file '%s': %s \n":               "删除容器ID文件'%s'失败: %s \n",
+	"Failed to sign %q:%s - %s\n":                             "签名 %q:%s 失败 - %s\n",
+	"Failed to write the container ID to the file: %s":        "写容器ID至容器ID文件失败: %s",
+	"Failure rate to tolerate during an update":               "更新期间可容忍的失败率",
+	"Falling back to Docker Hub":                              "回退到Docker Hub拉取",
+	"Fetch the logs of a container":                           "获取一个容器的运行日志",
+	"Fetch the logs of a service":                             "获取一个服务的运行日志",
+	"Filter output based on conditions provided":              "基于指定条件过滤命令输出内容",
+	"Finished initializing %q\n":                              "已完成初始化 %q\n",
+	"Follow log output":                                       "跟踪容器日志输出",
+	"Force create a new cluster from current state.":          "从节点当前状态强制创建一个集群。",
+	"Force leave ignoring warnings.":                          "强制脱离Swarm集群，忽略所有警告。",
+	"Force removal of the image":                              "强制删除镜像",
+	"Force remove an active node":                             "强制删除一个活跃节点",
+	"Force the container to disconnect from a network":        "强制容器从网络断开连接",
+	"Force the removal of a running container (uses SIGKILL)": "强制删除一个运行的容器(使用信号SIGKILL)",
+	"Format the output using the given go template":           "基于指定的Go语言模板格式化命令输出内容",
+	"Get real time events from the server":                    "获取Docker引擎的实时事件",
+	"Give extended privileges to the command":                 "为运行命令授予格外的特权",
+	"Give extended privileges to this container":              "授予容器所有的特权",
+	"HOSTNAME":                              "主机名",
+	"HostIP is not supported by a service.": "服务不支持宿主机IP.",
+	"Hostname:\t\t%s\n":                     "主机名:\t\t%s\n",
+	"Http Proxy: %s\n":                      "Http代理: %s\n",
+	"Https Proxy: %s\n":                     "Https代理: %s\n",
+	"ID:\t\t\t%s\n":                         "节点ID:\t\t\t%s\n",
+	"IMAGE":                                 "镜像",
+	"IMAGE\tCREATED\tCREATED BY\tSIZE\tCOMMENT": "镜像\t创建时间\t创建者\t大小\t备注",
+	"IP Address":                                 "IP 地址",
+	"IP Address Management Driver":               "IP地址管理驱动",
+	"IPC namespace to use":                       "使用的IPC命名空间",
+	"IPv4 or IPv6 Gateway for the master subnet": "为主子网设置的IPv4或IPv6网关",
+	"IPv6 Address":                               "IPv6 地址",
+	"Images":                                     "镜像",
+	"Images space usage:":                        "镜像空间使用情况：",
+	"Images to consider as cache sources":        "作为缓存来源的镜像",
+	"Images: %d\n":                               "镜像数: %d\n",
+	"Import the contents from a tarball to create a filesystem image": "从一个压缩包中导入内容，从而创建一个文件系统镜像",
+	"Initialize a swarm":                          "初始化Swarm集群",
+	"Insecure Registries:":                        "不受信的镜像仓库:",
+	"Inspect a plugin":                            "查看指定插件的详细信息",
+	"Inspect changes on a container's filesystem": "查看容器文件系统的变化差异",
+	"Install a plugin":                            "安装指定插件",
+	"Invalid cidr %s : %v":                        "无效的 CIDR %s : %v",
+	"Invalid scale specifier '%s'.\nSee '%s --help'.\n\nUsage:  %s\n\n%s": "无效的扩展指定项 '%s'.\n查看 '%s --help'.\n\n用途:  %s\n\n%s",
+	"Invalid subnet %s : %v":                               "无效的子网地址 %s : %v",
+	"Join a swarm as a node and/or manager":                "加入作为工作节点(worker)或者管理节点(manager)加入一个Swarm集群",
+	"Joined at:\t\t%s\n":                                   "加入集群时间:\t\t%s\n",
+	"Keep STDIN open even if not attached":                 "即使不被附加也保持标准输入打开",
+	"Kernel Version: %s\n":                                 "内核版本: %s\n",
+	"Kernel memory limit":                                  "内核内存限制",
+	"Kill one or more running containers":                  "终止一个或多个运行的容器",
+	"Labels:":                                              "标签:",
+	"Language of the client output (e.g. en_US, zh_CN)":    "客户端输出使用的语言（例如en_US、zh_CN）",
+	"Leave a swarm":                                        "脱离Swarm集群",
+	"Limit CPU CFS (Completely Fair Scheduler) period":     "限制CPU绝对公平调度算法（CFS）的时间周期",
+	"Limit CPU CFS (Completely Fair Scheduler) quota":      "限制CPU绝对公平调度算法（CFS）的时间限额",
+	"Limit CPUs":                                           "限制CPU使用量",
+	"Limit Memory":                                         "限制内存使用量",
+	"Limit read rate (IO per second) from a device":        "限制一个设备的读速率(IOps)",
+	"Limit read rate (bytes per second) from a device":     "限制一个设备的读速率(bps)",
+	"Limit the CPU CFS (Completely Fair Scheduler) period": "限制CPU绝对公平调度算法(CFS)的时间周期",
+	"Limit the CPU CFS (Completely Fair Scheduler) quota":  "限制CPU绝对公平调度算法(CFS)的时间限额",
+	"Limit write rate (IO per second) to a device":         "限制一个设备的写速率(IOps)",
+	"Limit write rate (bytes per second) to a device":      "限制一个设备的写速率(bps)",
+	"Limits":                  "资源限制",
+	"List containers":         "罗列所有容器",
+	"List images":             "罗列所有镜像",
+	"List networks":           "罗列所有网络",
+	"List nodes in the swarm": "在Swarm集群中罗列所有的节点",
+	"List plugins":            "罗列所有插件",
+	"List port mappings or a specific mapping for the container": "罗列为容器指定的所有端口映射信息",
+	"List registry mirrors": "罗列镜像加速器",
+	"List secrets":          "列出密钥",
+	"List services":         "罗列所有服务",
+	"List tasks running on a node, defaults to current node": "罗列一个节点上的运行任务，默认指定指定当前节点",
+	"List the tasks in the stack":                            "罗列一个stack中的所有运行任务",
+	"List the tasks of a service":                            "罗列一个服务内的所有任务",
+	"List volumes":                                           "罗列所有存储卷",
+	"Listen address (format: <ip|interface>[:port])":         "Swarm监听地址 （格式: <IP地址|网卡>[:端口]）",
+	"Load an image from a tar archive or STDIN":              "从一个压缩包或者标准输入加载一个镜像",
+	"Loading bundle from %s\n":                               "从 %s 加载应用包\n",
+	"Local Volumes":                                          "本地数据卷",
+	"Local Volumes space usage:":                             "本地数据卷空间使用情况：",
+	"Location of client config files":                        "客户端配置文件路径",
+	"Log driver options":                                     "日志驱动选项",
+	"Log in to a Docker registry.":                           "登陆一个Docker镜像仓库.",
+	"Log in to a Docker registry.\nIf no server is specified, the default is defined by the daemon.": "登陆一个Docker镜像仓库.\n如果没有制定服务器, Docker引擎会采用默认地址.",
+	"Log out from a Docker registry.": "登出Docker镜像仓库.",
+	"Log out from a Docker registry.\nIf no server is specified, the default is defined by the daemon.": "登出Docker镜像仓库.\n如果没有制定服务端，Docker引擎会采用默认地址.",
+	"Logging Driver: %s\n":         "日志驱动: %s\n",
+	"Logging driver for container": "为容器指定日志驱动",
+	"Logging driver for service":   "服务的日志驱动",
+	"Login with your Docker ID to push and pull images from Docker Hub. If you don't have a Docker ID, head over to https://hub.docker.com to create one.": "使用您的 Docker ID 登录以从 Docker Hub 推送和拉取镜像. 如果您还没有 Docker ID, 请前往 https://hub.docker.com 创建一个.",
+	"MANAGER STATUS": "管理者状态",
+	"MEMs in which to allow execution (0-3, 0,1)": "允许容器执行的CPU内存所在核指定(0-3,0,1): 0-3代表运行运行在0,1,2,3这4个核上",
+	"Manage Docker":             "管理 Docker",
+	"Manage Docker Swarm":       "管理Swarm集群",
+	"Manage Docker Swarm Nodes": "管理 Swarm 集群节点",
+	"Manage Docker networks":    "管理Docker网络",
+	"Manage Docker plugins":     "管理 Docker 插件",
+	"Manage Docker secrets":     "管理 Docker 密钥",
+	"Manage Docker services":    "管理 Docker 服务",
+	"Manage Docker stacks":      "管理Docker stack",
+	"Manage Docker volumes":     "管理Docker存储卷",
+	"Manage containers":         "管理容器",
+	"Manage images":             "管理镜像",
+	"Manage join tokens":        "管理加入令牌",
+	"Manage the registry mirrors used to pull from Docker Hub":                 "管理从Docker Hub拉取镜像时使用的镜像加速器",
+	"Manager %s demoted in the swarm.\n":                                       "在Swarm集群中成功将节点 %s 降级为工作者.\n",
+	"Manager Status:":                                                          "管理角色状态:",
+	"Max number of search results":                                             "搜索结果的最大数",
+	"Maximum IO bandwidth limit for the system drive (Windows only)":           "系统驱动的最大IO带宽限制(只支持Windows)",
+	"Maximum IOps limit for the system drive (Windows only)":                   "系统驱动的最大IOps限制(只支持Windows)",
+	"Maximum number of restarts before giving up":                              "放弃重启前的最大次数",
+	"Maximum number of tasks updated simultaneously (0 to update all at once)": "并发更新任务时的最大数量(0代表立即更新所有任务)",
+	"Maximum time to allow one check to run":                                   "允许一次健康检查运行的最长时间",
+	"Measure the latency of registry mirrors":                                  "测量镜像加速器的延迟",
+	"Memory limit":            "内存限制",
+	"Memory soft limit":       "内存软限制",
+	"Mode:\t\tGlobal":         "模式:\t\t全局",
+	"Mode:\t\tReplicated":     "模式:\t\t副本",
+	"Mount a tmpfs directory": "挂载一个临时文件系统目录",
+	"Mount the container's root filesystem as read only": "将容器的根文件系统挂载为只读模式",
+	"Mount volumes from the specified container(s)":      "从指定的容器挂载存储卷",
+	"NAME":                "名称",
+	"NAME \tTAG \tACTIVE": "名称 \t标签 \t活跃状态",
+	"NAME\tDESCRIPTION\tSTARS\tOFFICIAL\tAUTOMATED\n":       "名称\t描述\t星星数\t是否官方\t是否自动构建\n",
+	"NETWORK ID\tNAME\tDRIVER\tSCOPE":                       "网络ID\t名称\t驱动\t范围",
+	"NODE":                                                  "节点",
+	"Name and optionally a tag in the 'name:tag' format":    "镜像名称以及可选的标签，若指定标签，格式为：'名称:标签' ",
+	"Name of the Dockerfile (Default is 'PATH/Dockerfile')": "Dockerfile的名称(默认为当前目录下的Dockerfile文件路径)",
+	"Name: %s\n":           "名称: %s\n",
+	"Name:\t\t%s\n":        "名称:\t\t%s\n",
+	"Name:\t\t\t%s\n":      "名称:\t\t\t%s\n",
+	"Network attachments":  "网络附加信息",
+	"Networks:":            "网络:",
+	"No such mirror: %s\n": "镜像加速器不存在: %s\n",
+	"No tag specified, skipping trust metadata push":                      "未指定标签, 跳过推送信任元数据",
+	"No targets found, please provide a specific tag in order to sign it": "未找到目标, 请指定要签名的标签",
+	"No trust data for %s":                             "%s 没有信任数据",
+	"No trusted tags for %s":                           "%s 没有受信任的标签",
+	"Node %s promoted to a manager in the swarm.\n":    "成功将Swarm集群中的节点 %s 升级到管理者角色。\n",
+	"Node left the swarm.":                             "节点成功脱离Swarm集群",
+	"Not logged in to %s\n":                            "不能登陆地址 %s\n",
+	"Nothing found in stack: %s\n":                     "在stack中没有找到任何内容: %s\n",
+	"Number of lines to show from the end of the logs": "从日志尾部往上显示制定数量行数的日志，all代表显示所有日志",
+	"Number of tasks":                                  "服务内任务数量",
+	"OSType: %s\n":                                     "操作系统类型: %s\n",
+	"Only display IDs":                                 "仅显示ID",
+	"Only display network IDs":                         "仅显示网络ID",
+	"Only display numeric IDs":                         "仅显示容器ID",
+	"Only display token":                               "只显示令牌",
+	"Only display volume names":                        "仅显示存储卷名称",
+	"Only displays with at least x stars":              "只显示至少有 x 个用户星星的镜像",
+	"Only show automated builds":                       "只显示自动化构建出来的镜像",
+	"Only show numeric IDs":                            "仅显示数字ID",
+	"Operating System: %s\n":                           "操作系统: %s\n",
+	"Optional parent cgroup for the container":         "为容器设置的可选cgroup父系统",
+	"Optional volume driver for the container":         "为容器指定可选的存储卷驱动",
+	"Other nodes have to join the restored swarm again. Run 'docker swarm join-token worker' or 'docker swarm join-token manager' and follow the instructions.\n\n": "其他节点需要重新加入恢复后的Swarm集群。请运行 'docker swarm join-token worker' 或 'docker swarm join-token manager' 并按照提示操作。\n\n",
+	"Override the key sequence for detaching a container": "覆盖从容器停止附加的退出键顺序",
+	"Overwrite the default ENTRYPOINT of the image":       "覆盖镜像默认的ENTRYPOINT",
+	"PID namespace to use":                                "使用的PID命名空间",
+	"Passphrase: ":                                        "口令: ",
+	"Password":                                            "密码",
+	"Password: ":                                          "密码: ",
+	"Path to TLS certificate file":                        "TLS 证书文件的路径信息",
+	"Path to TLS key file":                                "TLS 密钥文件路径信息",
+	"Pause all processes within one or more containers":   "暂停一个或多个容器内部的所有进程的运行",
+	"Pause container during commit":                       "在容器提交镜像过程中先暂停容器运行",
+	"Placement constraints":                               "服务节点安放的限制条件",
+	"Placement:":                                          "放置策略:",
+	"Platform:":                                           "平台信息:",
+	"Please specify only one -H":                          "请只指定一个 -H",
+	"Plugin %q is requesting the following privileges:\n": "插件 %q 正在请求以下特权:\n",
+	"Plugins:":                                            "插件:",
+	"Plugins:\n":                                          "插件:\n",
+	"Ports:":                                              "端口:",
+	"Pretty-print containers using a Go template":         "使用一个Go语言的模板打印容器",
+	"Pretty-print images using a Go template":             "使用一个Go语言模板打印镜像信息",
+	"Print sizes and dates in human readable format":      "在人工可读的格式下打印镜像的大小和日期",
+	"Print the information in a human friendly format.":   "通过人工可读的格式输出命令信息。",
+	"Print the stack configuration":                       "打印stack的配置信息",
+	"Print usage":                                         "打印用途",
+	"Print version information and quit":                  "打印版本信息并退出",
+	"Promote one or more nodes to manager in the swarm":   "在Swarm集群中升级一个或多个到管理者角色",
+	"Provide filter values (e.g. 'label=<key>=<value>')":  "提供一些过滤值(比如 'label=<key>=<value>')",
+	"Provide filter values (e.g. 'until=<timestamp>')":    "提供一些过滤值(比如 'until=<timestamp>')",
+	"Provide filter values (i.e. 'dangling=true')":        "提供一些过滤值(比如 'dangling=true')",
+	"Proxy all received signals to the process":           "代理所有接收到的信号至进程",
+	"Proxy received signals to the process":               "代理指定的信号到容器运行进程",
+	"Prune volumes":                                       "清理数据卷",
+	"Publish a container's port(s) to the host":           "将容器内部端口映射到宿主机的指定端口",
+	"Publish a port as a node port":                       "将服务的一个端口暴露为一个节点端口",
+	"Publish all exposed ports to random ports":           "映射容器内部的所有端口到宿主机上的随机端口",
+	"Pull (%d of %d): %s%s@%s\n":                          "拉取 (%d/%d): %s%s@%s\n",
+	"Pull an image or a repository from a registry":       "从一个镜像仓库下拉一个镜像",
+	"Pulling %s from mirror %s\n":                         "正在从镜像加速器 %[2]s 拉取 %[1]s\n",
+	"Push a plugin":                                       "上传插件",
+	"Push an image or a repository to a registry":         "上传一个镜像到镜像仓库",
+	"REPLICAS": "副本数",
+	"REPOSITORY	TAG	IMAGE ID	CREATED	SIZE	SHARED SIZE	UNIQUE SIZE	CONTAINERS": "仓库	标签	镜像 ID	创建时间	大小	共享大小	独占大小	容器数",
+	"Read from tar archive file, instead of STDIN":                            "从压缩包中读取内容，而不是标准输入",
+	"Read in a file of environment variables":                                 "从一个文件中为容器读取环境变量",
+	"Read in a line delimited file of labels":                                 "从一个标签文件中读取标签信息",
+	"Registry Mirrors:":                                                 "镜像加速器:",
+	"Registry: %v\n":                                                    "镜像仓库: %v\n",
+	"Remove a constraint":                                               "删除一条限制条件",
+	"Remove a container label by its key":                               "通过键值删除一个容器的标签",
+	"Remove a label by its key":                                         "通过键值删除一个标签",
+	"Remove a mount by its target path":                                 "通过目标路径删除一个挂载",
+	"Remove a node label if exists":                                     "删除一个节点标签，如果存在的话",
+	"Remove a plugin":                                                   "删除指定插件",
+	"Remove a published port by its target port":                        "通过目标端口删除一个对外暴露的端口",
+	"Remove a secret by its name or ID":                                 "按名称或 ID 移除密钥",
+	"Remove all stopped containers":                                     "删除所有已停止的容器",
+	"Remove all unused images, not just dangling ones":                  "删除所有未使用的镜像,而不仅是悬空镜像",
+	"Remove all unused local volumes":                                   "删除所有未使用的本地数据卷",
+	"Remove all unused networks":                                        "删除所有未使用的网络",
+	"Remove an environment variable":                                    "删除一个环境变量",
+	"Remove intermediate containers after a successful build":           "成狗构建后删除中间容器",
+	"Remove one or more containers":                                     "删除一个或多个容器",
+	"Remove one or more images":                                         "删除一个或者多个镜像",
+	"Remove one or more networks":                                       "删除一个或多个网络",
+	"Remove one or more nodes from the swarm":                           "从Swarm集群中删除一个或多个节点",
+	"Remove one or more registry mirrors":                               "删除一个或多个镜像加速器",
+	"Remove one or more secrets":                                        "删除一个或多个密钥",
+	"Remove one or more services":                                       "删除一个或多个服务",
+	"Remove one or more volumes":                                        "删除一个或多个存储卷",
+	"Remove the specified link":                                         "删除指定的链接",
+	"Remove the stack":                                                  "删除stack",
+	"Remove the volumes associated with the container":                  "删除容器时同时删除容器相关的存储卷",
+	"Remove unused data":                                                "删除未使用的数据",
+	"Remove unused images":                                              "删除未使用的镜像",
+	"Removing login credentials for %s\n":                               "为Docker镜像仓库 %s 删除登陆认证信息\n",
+	"Removing network %s\n":                                             "删除网络 %s\n",
+	"Removing service %s\n":                                             "删除服务 %s\n",
+	"Rename a container":                                                "重命名一个容器",
+	"Reservations":                                                      "资源预留",
+	"Reserve CPUs":                                                      "预留CPU使用量",
+	"Reserve Memory":                                                    "预留内存使用量",
+	"Resources:":                                                        "资源:",
+	"Restart a container":                                               "重启一个或多个容器",
+	"Restart policy to apply when a container exits":                    "当一个容器退出时为其采取的重启策略",
+	"Restart when condition is met (none, on-failure, or any)":          "条件满足时的重启策略:none(无), on-failure(失败时重启), 或者其他)",
+	"Restore a swarm from a backup, with this node as its only manager": "从备份恢复Swarm集群，当前节点作为唯一的管理者",
+	"Restrict external access to the network":                           "限制外界对网络的访问能力",
+	"Return JSON for specified type, (e.g image, container or task)":    "为指定的类型返回JSON内容",
+	"Return low-level information on a container, image or task":        "返回容器、镜像或任务的底层想相信信息",
+	"Revert a service to its previous specification":                    "将服务恢复为其先前的配置",
+	"Role of the node (worker/manager)":                                 "节点角色工作者或管理者(worker/manager)",
+	"Rotate join token":                                                 "轮换加入令牌",
+	"Run a command in a new container":                                  "在一个新的容器中运行一条命令",
+	"Run a command in a running container":                              "在运行容器中运行指定命令",
+	"Run container in background and print container ID":                "在后台运行容器并打印容器ID",
+	"Runtime to use for this container":                                 "为容器选择的容器运行时驱动类型",
+	"Runtimes:":                                                         "运行时:",
+	`SECURITY WARNING: You are building a Docker image from Windows against a non-Windows Docker host. All files and directories added to build context will have '-rwxr-xr-x' permissions. It is recommended to double check and reset permissions for sensitive files and directories.`: `安全警告: 您在一个非Windows的Docker引擎上构建Windows机器的Docker的镜像。所有添加到构建上下文的文件和目录将添加'-rwxr-xr-x'权限。推荐您为敏感的文件和目录进行权限的重复查验。`,
+	"STATUS": "状态",
+	"Save one or more images to a tar archive (streamed to STDOUT by default)": "将一个或多个镜像保存至压缩包(默认情况下流传输至标准输出)",
+	"Scale one or multiple services":                                           "扩展一个或多个服务",
+	"Search the Docker Hub for images":                                         "在 Docker Hub(Docker官方镜像仓库)中搜索镜像",
+	"Seconds to wait for stop before killing it":                               "终止容器前等待容器停止的秒数",
+	"Seconds to wait for stop before killing the container":                    "在终止一个容器前，等待容器停止的秒数",
+	"Security Options":      "安全选项",
+	"Security Options:":     "安全选项:",
+	"See '%s %s --help'.\n": "查看 '%s %s --help'.\n",
+	"See '%s --help'.\n":    "查看 '%s --help'.\n",
+	"Send registry authentication details to Swarm agents":                "将镜像仓库认证信息发送给 Swarm 代理",
+	"Send registry authentication details to swarm agents":                "向Swarm集群中节点上的代理模块发送注册认证信息",
+	"Server Version: %s\n":                                                "Docker引擎版本: %s\n",
+	"Service command args":                                                "服务的启动命令",
+	"Service image tag":                                                   "服务镜像的标签",
+	"Service labels":                                                      "服务自身标签",
+	"Service mode (replicated or global)":                                 "服务类型: 副本(replicated)/全局(global)",
+	"Service name":                                                        "服务名",
+	"Set DNS options":                                                     "设置DNS选项",
+	"Set IPAM driver specific options":                                    "设置IP地址管理器的特定参数",
+	"Set build-time variables":                                            "设置构建时的环境变量",
+	"Set commit message for imported image":                               "为导入的镜像设置提交信息",
+	"Set custom DNS search domains":                                       "设置自定义的DNS搜索域",
+	"Set custom DNS servers":                                              "设置自定义的DNS服务器地址",
+	"Set driver specific options":                                         "设置指定驱动的选项",
+	"Set environment variables":                                           "设置容器运行时环境变量",
+	"Set meta data on a container":                                        "为一个容器上设置元数据",
+	"Set metadata for a secret":                                           "设置密钥的元数据",
+	"Set metadata for a volume":                                           "为一个存储卷设置元数据",
+	"Set metadata for an image":                                           "为一个镜像设置元数据",
+	"Set metadata on a network":                                           "在一个网络设置元数据",
+	"Set the logging level":                                               "设置日志级别",
+	"Set the networking mode for the RUN instructions during build":       "设置构建期间 RUN 指令的网络模式",
+	"Set the target build stage to build":                                 "设置要构建的目标构建阶段",
+	"Set type of progress output (plain, json)":                           "设置进度输出的类型(plain, json)",
+	"Show all containers (default shows just running)":                    "显示所有容器(默认仅显示运行状态的容器)",
+	"Show all events created since timestamp":                             "从指定时间戳开始打印所有的事件",
+	"Show all images (default hides intermediate images)":                 "显示所有的镜像(默认情况隐藏中间镜像)",
+	"Show detailed information on space usage":                            "显示空间使用的详细信息",
+	"Show digests":                                                        "显示验证信息",
+	"Show docker disk usage":                                              "显示 Docker 磁盘使用情况",
+	"Show extra details provided to logs":                                 "显示提供给日志的额外细节",
+	"Show logs before timestamp":                                          "显示该时间戳之前的日志",
+	"Show logs since timestamp":                                           "从某一个时间戳开始获取日志",
+	"Show n last created containers (includes all states)":                "显示n个最新创建的容器(包含所有的状态)",
+	"Show the Docker version information":                                 "显示Docker的版本信息",
+	"Show the history of an image":                                        "显示一个镜像的历史信息",
+	"Show the latest created container (includes all states)":             "显示最新创建的容器(包含所有的状态)",
+	"Show timestamps":                                                     "显示日志的时间戳",
+	"Signal to send to the container":                                     "发送给容器的信号",
+	"Signal to stop a container, %v by default":                           "停止一个容器的信号, 默认是 %v",
+	"Signing and pushing trust metadata":                                  "正在签名并推送信任元数据",
+	"Size of /dev/shm, default value is 64MB":                             "内存共享文件的/dev/shm的大小, 默认值为64MB",
+	"Skip image verification":                                             "跳过镜像验证",
+	"Skipping target for %q\n":                                            "跳过 %q 的目标\n",
+	"Skipping unreachable mirror %s: %v\n":                                "跳过无法访问的镜像加速器 %s: %v\n",
+	"Specifications of one or more certificate signing endpoints":         "一个或多个认证签名节点的详细说明",
+	"Specify secrets to expose to the service":                            "指定向服务公开的密钥",
+	"Specify volume driver name":                                          "指定存储驱动的名称",
+	"Specify volume name":                                                 "指定存储卷的名称",
+	"Squash newly built layers into a single new layer":                   "将新构建的层压缩为单个新层",
+	"Start one or more stopped containers":                                "启动一个或多个停止的容器",
+	"Status:":                                                             "状态:",
+	"Stop one or more running containers":                                 "停止一个或多个运行容器",
+	"Storage Driver: %s\n":                                                "存储驱动: %s\n",
+	"Storage driver options for the container":                            "为容器设置存储驱动选项",
+	"Stream events until this timestamp":                                  "输出所有的事件直到指定时间戳为止",
+	"Subnet in CIDR format that represents a network segment":             "CIDR格式的子网信息，代表一个网络段",
+	"Succesfully rotated %s join token.\n\n":                              "已成功轮换 %s 加入令牌.\n\n",
+	"Successfully signed %q:%s\n":                                         "已成功签名 %q:%s\n",
+	"Suppress the build output and print image ID on success":             "压缩构建输出，并在构建成功时打印镜像ID",
+	"Suppress the load output":                                            "压缩导入输出",
+	"Swap limit equal to memory plus swap: '-1' to enable unlimited swap": "交换内存限制 等于 实际内存 ＋ 交换区内存: '-1' 代表启用不受限的交换区内存",
+	"Swarm backed up to %s.\n":                                            "Swarm集群已备份到 %s。\n",
+	"Swarm initialized: current node (%s) is now a manager.\n\n":          "成功初始化Swarm集群: 当前节点 (%s) 现在已经是管理者角色。\n\n",
+	"Swarm restored: current node (%s) is now a manager.\n\n":             "成功恢复Swarm集群: 当前节点 (%s) 现在已经是管理者角色。\n\n",
+	"Swarm updated.":                                                      "Swarm集群更新完毕。",
+	"Swarm: %v\n":                                                         "Swarm集群: %v\n",
+	"Sysctl options":                                                      "系统控制 sysctl 选项",
+	"TYPE	TOTAL	ACTIVE	SIZE	RECLAIMABLE":                                  "类型	总数	活跃	大小	可回收",
+	"Tag an image into a repository":                                      "为一个镜像添加一个标签",
+	"Tagging %s as %s\n":                                                  "将 %s 标记为 %s\n",
+	"Task history retention limit":                                        "任务历史保留数量限制",
+	"Template parsing error: %s":                                          "模板解析错误: %s",
+	"Template parsing error: %v":                                          "模板解析错误: %v",
+	"This flag is deprecated and will be removed in a future version":     "该参数已经被废弃，并会在未来的版本中被移除",
+	"This node joined a swarm as a manager.":                              "此节点已经作为一个管理节点(manager)加入指定的Swarm集群。",
+	"This node joined a swarm as a worker.":                               "此节点已经作为一个工作节点(worker)加入指定的Swarm集群。",
+	"Time between running the check":                                      "运行健康检查的时间间隔",
+	"Time to wait before force killing a container":                       "强制终止容器前的等待时间",
+	"To add a manager to this swarm, run 'docker swarm join-token manager' and follow the instructions.\n\n":                "在此Swarm集群中添加一个管理者角色, 运行 'docker swarm join-token manager' 并遵循相应的说明。\n\n",
+	"To add a manager to this swarm, run the following command:\n\n    docker swarm join \\\n    --token %s \\\n    %s\n\n": "要向该 swarm 添加管理节点, 请运行以下命令:\n\n    docker swarm join \\\n    --token %s \\\n    %s\n\n",
+	"To add a worker to this swarm, run the following command:\n\n    docker swarm join \\\n    --token %s \\\n    %s\n\n":  "要向该 swarm 添加工作节点, 请运行以下命令:\n\n    docker swarm join \\\n    --token %s \\\n    %s\n\n",
+	"Token for entry into the swarm":                   "进入此Swarm集群所需的令牌",
+	"Total Memory: %s\n":                               "内存总数: %s\n",
+	"Total reclaimed space:":                           "共回收空间:",
+	"Trust certs signed only by this CA":               "仅被CA签名的受信certs路径信息",
+	"Tune container memory swappiness (0 to 100)":      "设置容器内存swappiness参数 (0 到 100)",
+	"Tune container pids limit (set -1 for unlimited)": "设置容器进程上限(设置-1代表没有限制)",
+	"Tune host's OOM preferences (-1000 to 1000)":      "设置OOM偏好参数 (-1000 至 1000)",
+	"UPDATED":                             "更新时间",
+	"UTS namespace to use":                "使用的UTS命名空间",
+	"Ulimit options":                      "用户限制 Ulimit 选项",
+	"Unable to find image '%s' locally\n": "本地无法找到镜像 '%s'\n",
+	"Unable to parse logging level: %s\n": "无法解析日志级别: %s\n",
+	"Unknown mode: %s":                    "未知的服务模式: %s",
+	"Unpause all processes within one or more containers": "恢复一个或多个容器中所有被挂起的进程",
+	"Unsupported signal: %v. Discarding.\n":               "不支持的信号: %v. 已丢弃.\n",
+	"Untagged: %s\n":                                      "去标签: %s\n",
+	"Update a node":                                       "更新Swarm集群中的单个节点",
+	"Update a service":                                    "更新一个服务",
+	"Update configuration of one or more containers":      "更新一个或者多个容器的配置信息",
+	"Update status:":                                      "更新状态:",
+	"Update the swarm":                                    "更新Swarm集群",
+	"UpdateConfig:\n":                                     "更新配置:\n",
+	"Updating service %s (id: %s)\n":                      "更新服务 %s (id: %s)\n",
+	`Usage:	{{if not .HasSubCommands}}{{.UseLine}}{{end}}{{if .HasSubCommands}}{{ .CommandPath}} COMMAND{{end}}
+
+{{ .Short | trim }}{{if gt .Aliases 0}}
+
+Aliases:
+  {{.NameAndAliases}}{{end}}{{if .HasExample}}
+
+Examples:
+{{ .Example }}{{end}}{{if .HasFlags}}
+
+Options:
+{{.Flags.FlagUsages | trimRightSpace}}{{end}}{{ if .HasAvailableSubCommands}}
+
+Commands:{{range .Commands}}{{if .IsAvailableCommand}}
+  {{rpad .Name .NamePadding }} {{.Short}}{{end}}{{end}}{{end}}{{ if .HasSubCommands }}
+
+Run '{{.CommandPath}} COMMAND --help' for more information on a command.{{end}}
+`: `用途:	{{if not .HasSubCommands}}{{.UseLine}}{{end}}{{if .HasSubCommands}}{{ .CommandPath}} COMMAND{{end}}
+
+{{ .Short | trim }}{{if gt .Aliases 0}}
+
+别名:
+  {{.NameAndAliases}}{{end}}{{if .HasExample}}
+
+案例:
+{{ .Example }}{{end}}{{if .HasFlags}}
+
+选项:
+{{.Flags.FlagUsages | trimRightSpace}}{{end}}{{ if .HasAvailableSubCommands}}
+
+命令:{{range .Commands}}{{if .IsAvailableCommand}}
+  {{rpad .Name .NamePadding }} {{.Short}}{{end}}{{end}}{{end}}{{ if .HasSubCommands }}
+
+运行 '{{.CommandPath}} COMMAND --help' 来获取此命令的更多详细信息.{{end}}
+`,
+	"Usage of %s:\n": "%s 的用途:\n",
+	"Usage: docker [OPTIONS] COMMAND [arg...]\n       docker [ --help | -v | --version ]\n\n": "用途: docker [OPTIONS] COMMAND [arg...]\n       docker [ --help | -v | --version ]\n\n",
+	"Usage: dockerd [OPTIONS]\n\n":    "用途: dockerd [OPTIONS]\n\n",
+	"Usage:\n":                        "用途:\n",
+	"Usage:\t":                        "用途:\t",
+	"Use TLS and verify the remote":   "使用TLS来验证远程连接",
+	"Use TLS; implied by --tlsverify": "使用TLS通过参数--tlsverify",
+	"User namespace to use":           "使用的用户命名空间",
+	"Username":                        "用户名",
+	"Username or UID (format: <name|uid>[:<group|gid>])": "用户名或用户ID (格式: <用户名|用户ID>[:<组|组ID>])",
+	"Username: %v\n":                        "用户名: %v\n",
+	"Using default tag: %s\n":               "使用默认标签: %s\n",
+	"VOLUME NAME	LINKS	SIZE":                "数据卷名称	链接数	大小",
+	"Validity period for node certificates": "节点证书的验证周期",
+	"WARNING! This will remove all dangling images.\nAre you sure you want to continue?":                                          "警告!这将删除所有悬空镜像。\n确定要继续吗?",
+	"WARNING! This will remove all images without at least one container associated to them.\nAre you sure you want to continue?": "警告!这将删除所有没有任何容器关联的镜像。\n确定要继续吗?",
+	"WARNING! This will remove all local volumes not used by at least one container.\nAre you sure you want to continue?":         "警告!这将删除所有未被任何容器使用的本地数据卷。\n确定要继续吗?",
+	"WARNING! This will remove all networks not used by at least one container.\nAre you sure you want to continue?":              "警告!这将删除所有未被任何容器使用的网络。\n确定要继续吗?",
+	"WARNING! This will remove all stopped containers.\nAre you sure you want to continue?":                                       "警告!这将删除所有已停止的容器。\n确定要继续吗?",
+	"WARNING! This will remove:":        "警告!这将删除:",
+	"WARNING: %s\n":                     "警告: %s\n",
+	"WARNING: --size ignored for tasks": "警告: --size 被任务所忽略",
+	"WARNING: Disabling the OOM killer on containers without setting a '-m/--memory' limit may be dangerous.\n": "警告: 在容器上禁用OOM killer时没有设定'-m/--memory'限制将带来危险.\n",
+	"WARNING: Error loading config file:%v\n":                                                                        "警告: 加载配置文件出错:%v\n",
+	"WARNING: IPv4 forwarding is disabled":                                                                           "警告: IPv4转发功能已禁用",
+	"WARNING: Localhost DNS setting (--dns=%s) may fail in containers.\n":                                            "警告: 本地的DNS设定(--dns=%s)在容器内有可能失效.\n",
+	"WARNING: No cpu cfs period support":                                                                             "警告: 不支持 cpu cfs 周期 ",
+	"WARNING: No cpu cfs quota support":                                                                              "警告: 不支持 cpu cfs 限额 ",
+	"WARNING: No cpu shares support":                                                                                 "警告: 不支持 cpu 时间",
+	"WARNING: No cpuset support":                                                                                     "警告: 不支持 cpuset",
+	"WARNING: No kernel memory limit support":                                                                        "警告: 不支持内核内存限制",
+	"WARNING: No memory limit support":                                                                               "警告: 不支持内存限制",
+	"WARNING: No oom kill disable support":                                                                           "警告: 不支持oom kill 禁用",
+	"WARNING: No swap limit support":                                                                                 "警告: 不支持交换区内存限制",
+	"WARNING: bridge-nf-call-ip6tables is disabled":                                                                  "警告: bridge-nf-call-ip6tables已禁用",
+	"WARNING: bridge-nf-call-iptables is disabled":                                                                   "警告: bridge-nf-call-iptables已禁用",
+	"WARNING: could not erase credentials: %v\n":                                                                     "警告: 清理认证信息失败: %v\n",
+	"WARNING: networks and volumes can only be filtered by label, they are not pruned":                               "警告: 网络和数据卷只能按标签过滤，因此不会清理它们",
+	"Warning: '-%s' is deprecated, it will be removed soon. See usage.\n":                                            "警告: '-%s' 已被弃用, 即将被移除. 请查看用途.\n",
+	"Warning: '-%s' is deprecated, it will be replaced by '-%s' soon. See usage.\n":                                  "警告: '-%s' 已被弃用, 即将被 '-%s' 替代. 请查看用途.\n",
+	"Warning: failed to get default registry endpoint from daemon (%v). Using system default: %s\n":                  "警告: 从守护进程获取默认镜像仓库地址失败 (%v). 使用系统默认地址: %s\n",
+	"Warning: potential malicious behavior - trust data has insufficient signatures for remote repository %s: %v":    "警告: 可能存在恶意行为 - 远程仓库 %s 的信任数据签名不足: %v",
+	"Warning: potential malicious behavior - trust data mismatch for remote repository %s: %v":                       "警告: 可能存在恶意行为 - 远程仓库 %s 的信任数据不匹配: %v",
+	"Warning: potential malicious behavior - trust data version is lower than expected for remote repository %s: %v": "警告: 可能存在恶意行为 - 远程仓库 %s 的信任数据版本低于预期: %v",
+	"Window used to evaluate the restart policy":                                                                     "评估重启策略的窗口时间",
+	"Working directory inside the container":                                                                         "进程在容器内部的工作目录",
+	"Write the container ID to the file":                                                                             "写容器ID的文件路径地址",
+	"Write to a file, instead of STDOUT":                                                                             "写入一个文件，而不是标准输出",
+	"You cannot attach to a paused container, unpause it first":                                                      "您不能附加到一个暂停的容器中，请先启动此容器。",
+	"You cannot attach to a stopped container, start it first":                                                       "您不能附加到一个停止的容器中，请先启动此容器。",
+	"You cannot start and attach multiple containers at once.":                                                       "您不能一次性启动和附加到多个容器。",
+	"You must provide one or more flags when using this command.":                                                    "当使用此命令时，您必须提供一个或多个命令参数。",
+	"[y/N]": "[y/N]",
+	"\"%s\" accepts no argument(s).\nSee '%s --help'.\n\nUsage:  %s\n\n%s":                            "\"%s\" 不接受任何参数.\n查看 '%s --help'.\n\n用途:  %s\n\n%s",
+	"\"%s\" requires at least %d and at most %d argument(s).\nSee '%s --help'.\n\nUsage:  %s\n\n%s":   "\"%s\" 需要至少 %d 个， 至多 %d 个参数.\n查看 '%s --help'.\n\n用途:  %s\n\n%s",
+	"\"%s\" requires at least %d argument(s).\nSee '%s --help'.\n\nUsage:  %s\n\n%s":                  "\"%s\" 需要至少 %d 个参数.\n查看 '%s --help'.\n\n用途:  %s\n\n%s",
+	"\"%s\" requires at most %d argument(s).\nSee '%s --help'.\n\nUsage:  %s\n\n%s":                   "\"%s\" 需要至多 %d 个参数.\n查看 '%s --help'.\n\n用途:  %s\n\n%s",
+	"\"%s\" requires exactly %d argument(s).\nSee '%s --help'.\n\nUsage:  %s\n\n%s":                   "\"%s\" 需要正好 %d 个参数.\n查看 '%s --help'.\n\n用途:  %s\n\n%s",
+	"\"logs\" command is supported only for \"json-file\" and \"journald\" logging drivers (got: %s)": "\"logs\" 命令只支持\"json-file\"和\"journald\"日志驱动(当前日志驱动为: %s)",
+	"\nCommands:\n": "\n命令:\n",
+	"\nRun 'docker COMMAND --help' for more information on a command.":     "\n运行 'docker COMMAND --help' 来获取命令的更多详细信息.",
+	"\nUsage:\tdockerd [OPTIONS]\n":                                        "\n用途:\tdockerd [OPTIONS]\n",
+	"a passphrase is required":                                             "需要提供口令",
+	"all dangling images":                                                  "所有悬空镜像",
+	"all images without at least one container associated to them":         "所有没有任何容器关联的镜像",
+	"all local volumes not used by at least one container":                 "所有未被任何容器使用的本地数据卷",
+	"all networks not used by at least one container":                      "所有未被任何容器使用的网络",
+	"all stopped containers":                                               "所有已停止的容器",
+	"bad flag syntax: %s":                                                  "错误的参数语法: %s",
+	"cannot configure multiple gateways (%s, %s) for the same subnet (%s)": "不能配置在同一个子网 (%[3]s)中配置多个网关(%[1]s, %[2]s)",
+	"cannot configure multiple ranges (%s, %s) on the same subnet (%s)":    "不能配置在同一个子网 (%[3]s)中配置多范围(%[1]s, %[2]s)",
+	"cannot mix 'bind-*' options with mount type '%s'":                     "不能将 'bind-*' 选项和挂载类型 '%s' 混用",
+	"cannot mix 'volume-*' options with mount type '%s'":                   "不能将 'volume-*' 选项和挂载类型 '%s' 混用",
+	"cannot push a digest reference":                                       "不能推送摘要引用",
+	"conflicting port mapping between %v:%v/%s and %v:%v/%s":               "端口映射 %v:%v/%s 与 %v:%v/%s 冲突",
+	"copying between containers is not supported":                          "在容器间拷贝内容目前还不支持",
+	"destination %q must be a directory":                                   "目标地址 %q 必须是一个目录地址",
+	"destination can not be empty":                                         "拷贝的目的地址不能为空",
+	"do not enable the plugin on install":                                  "不在安装过程中启用插件",
+	"docker: '%s' is not a docker command.\nSee 'docker --help'.\n":        "docker: '%s' 不是一个 docker 命令.\n查看 'docker --help'.\n",
+	"error reading passphrase: %v":                                         "读取口令出错: %v",
+	"every ip-range or gateway must have a corresponding subnet":           "每一个IP范围或网关必须拥有一个相应的子网地址",
+	"exec ID empty":                                     "exec ID为空",
+	"failed to parse %s: %v":                            "解析 %s 失败：%v",
+	"failed to untag %s: %v":                            "取消标签 %s 失败: %v",
+	"flag needs an argument: -%s":                       "命令行需要一个参数: -%s",
+	"flag provided but not defined: -%s":                "提供了未定义的参数: -%s",
+	"flag redefined: %s":                                "参数重复定义: %s",
+	"grant all permissions necessary to run the plugin": "为运行插件授予所有的必须权限",
+	"invalid availability %q, only active, pause and drain are supported": "无效的节点可达性 %q, 只支持活跃，暂停，维护状态",
+	"invalid boolean value %q for  -%s: %v":                               "-%[2]s 的布尔值 %[1]q 无效: %[3]v",
+	"invalid field '%s' must be a key=value pair":                         "无效的属性 '%s' 必须是一个键值对",
+	"invalid name: %s": "无效名称: %s",
+	"invalid progress type: %s, expected %s or %s":               "无效的进度类型: %s, 应为 %s 或 %s",
+	"invalid replicas value %s: %s":                              "无效的副本数量值 %s: %s",
+	"invalid role %q, only worker and manager are supported":     "无效的节点角色 %q, 当前Swarm只支持工作者和管理者",
+	"invalid value %q for flag -%s: %v":                          "参数 -%[2]s 的值 %[1]q 无效: %[3]v",
+	"invalid value for %s: %s":                                   "无效值 %s: %s",
+	"invalid value for populate: %s":                             "无效值 populate: %s",
+	"it will be replaced with inline Dockerfile commands.":       "此选项将来会被Dockerfile内部的命令所替代",
+	"key %s doesn't exist in node's labels":                      "节点的标签中不存在键 %s",
+	"multiple overlapping subnet configuration is not supported": "多个子网配置信息有重叠的情况，Docker引擎暂不支持",
+	"must specify at least one container source":                 "必须指定至少一个容器地址",
+	"no matching subnet for aux-address %s":                      "没有找到符合辅助网络地址的子网 %s",
+	"no matching subnet for gateway %s":                          "没有匹配的子网地址 %s",
+	"no matching subnet for range %s":                            "没有匹配的子网地址 %s",
+	"no such flag -%v":                                           "没有该参数 -%v",
+	"no valid hash, expecting sha256":                            "没有有效的哈希值, 需要 sha256",
+	"no valid signing keys for delegation roles":                 "委托角色没有有效的签名密钥",
+	"passphrases do not match":                                   "两次输入的口令不一致",
+	"please use --help":                                          "请使用 --help",
+	"refusing to create a tag with a digest reference":           "拒绝使用摘要引用创建标签",
+	"replicas can only be used with replicated mode":             "副本数(replicas)只能被使用于副本(replicated)模式",
+	"scale can only be used with replicated mode":                "扩展只能支持副本(replicated)模式的服务",
+	"source can not be empty":                                    "拷贝的源地址不能为空",
+	"source is required when specifying volume-* options":        "当指定选项 volume-* 时，挂载的源地址不能为空",
+	"tag can't be used with --all-tags/-a":                       "标签不能使用 --all-tags/-a",
+	"target is required":                                         "挂载目的地址不能为空",
+	"the external-ca option needs a protocol= parameter":         "外部CA选项必须拥有一个协议参数 protocol= ",
+	"the external-ca option needs a url= parameter":              "外部CA选项必须拥有一个URL参数 url= ",
+	"timeout waiting for stats":                                  "等待统计信息超时",
+	"type is required":                                           "挂载类型不能为空",
+	"unable to prepare context: %s":                              "准备构建上下文失败: %s",
+	"unable to read inspect data: %v":                            "无法读取详细信息: %v",
+	"unexpected key '%s' in '%s'":                                "'%[2]s' 中有意外的键 '%[1]s'",
+	"unexpected status %s":                                       "意外的状态 %s",
+	"unknown role %s":                                            "未知角色 %s",
+	"unrecognized external CA protocol %s":                       "外部CA %s 协议识别失败",
+	"use --filter=automated=true instead":                        "使用 --filter=automated=true ",
+	"use --filter=stars=3 instead":                               "使用 --filter=stars=3 ",
+	"valid https URL required for trust server, got %s":          "信任服务器需要有效的 https URL, 当前为 %s",
+	"value is too precise":                                       "数值精度过高",
+	"will be removed in 1.13.":                                   "将在 1.13 中移除.",
+}
+
+func init() {
+	Register("zh_CN", zhCN)
+}
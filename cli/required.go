@@ -5,6 +5,8 @@ import (
 	"strings"
 
 	"github.com/spf13/cobra"
+
+	"github.com/docker/docker/cli/i18n"
 )
 
 // NoArgs validates args and returns an error if there are any args
@@ -18,7 +20,7 @@ func NoArgs(cmd *cobra.Command, args []string) error {
 	}
 
 	return fmt.Errorf(
-		"\"%s\" 不接受任何参数.\n查看 '%s --help'.\n\n用途:  %s\n\n%s",
+		i18n.T("\"%s\" accepts no argument(s).\nSee '%s --help'.\n\nUsage:  %s\n\n%s"),
 		cmd.CommandPath(),
 		cmd.CommandPath(),
 		cmd.UseLine(),
@@ -33,7 +35,7 @@ func RequiresMinArgs(min int) cobra.PositionalArgs {
 			return nil
 		}
 		return fmt.Errorf(
-			"\"%s\" 需要至少 %d 个参数.\n查看 '%s --help'.\n\n用途:  %s\n\n%s",
+			i18n.T("\"%s\" requires at least %d argument(s).\nSee '%s --help'.\n\nUsage:  %s\n\n%s"),
 			cmd.CommandPath(),
 			min,
 			cmd.CommandPath(),
@@ -50,7 +52,7 @@ func RequiresMaxArgs(max int) cobra.PositionalArgs {
 			return nil
 		}
 		return fmt.Errorf(
-			"\"%s\" 需要至多 %d 个参数.\n查看 '%s --help'.\n\n用途:  %s\n\n%s",
+			i18n.T("\"%s\" requires at most %d argument(s).\nSee '%s --help'.\n\nUsage:  %s\n\n%s"),
 			cmd.CommandPath(),
 			max,
 			cmd.CommandPath(),
@@ -67,7 +69,7 @@ func RequiresRangeArgs(min int, max int) cobra.PositionalArgs {
 			return nil
 		}
 		return fmt.Errorf(
-			"\"%s\" 需要至少 %d 个， 至多 %d 个参数.\n查看 '%s --help'.\n\n用途:  %s\n\n%s",
+			i18n.T("\"%s\" requires at least %d and at most %d argument(s).\nSee '%s --help'.\n\nUsage:  %s\n\n%s"),
 			cmd.CommandPath(),
 			min,
 			max,
@@ -85,7 +87,7 @@ func ExactArgs(number int) cobra.PositionalArgs {
 			return nil
 		}
 		return fmt.Errorf(
-			"\"%s\" 需要正好 %d 个参数.\n查看 '%s --help'.\n\n用途:  %s\n\n%s",
+			i18n.T("\"%s\" requires exactly %d argument(s).\nSee '%s --help'.\n\nUsage:  %s\n\n%s"),
 			cmd.CommandPath(),
 			number,
 			cmd.CommandPath(),
@@ -28,6 +28,8 @@ type ConfigFile struct {
 	ImagesFormat     string                      `json:"imagesFormat,omitempty"`
 	DetachKeys       string                      `json:"detachKeys,omitempty"`
 	CredentialsStore string                      `json:"credsStore,omitempty"`
+	Language         string                      `json:"language,omitempty"`
+	Mirrors          []string                    `json:"mirrors,omitempty"`
 	Filename         string                      `json:"-"` // Note: for internal use only
 }
 
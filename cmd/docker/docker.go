@@ -2,6 +2,7 @@ package main
 
 import (
 	"fmt"
+	"io/ioutil"
 	"os"
 	"path/filepath"
 
@@ -10,6 +11,7 @@ import (
 	"github.com/docker/docker/cli"
 	"github.com/docker/docker/cli/cobraadaptor"
 	cliflags "github.com/docker/docker/cli/flags"
+	"github.com/docker/docker/cli/i18n"
 	"github.com/docker/docker/cliconfig"
 	"github.com/docker/docker/dockerversion"
 	flag "github.com/docker/docker/pkg/mflag"
@@ -17,38 +19,45 @@ import (
 	"github.com/docker/docker/utils"
 )
 
-var (
-	commonFlags = cliflags.InitCommonFlags()
-	clientFlags = initClientFlags(commonFlags)
-	flHelp      = flag.Bool([]string{"h", "-help"}, false, "打印用途")
-	flVersion   = flag.Bool([]string{"v", "-version"}, false, "打印版本信息并退出")
-)
-
 func main() {
 	// Set terminal emulation based on platform as required.
 	stdin, stdout, stderr := term.StdStreams()
 
 	logrus.SetOutput(stderr)
 
+	// Usage strings are translated when flags and commands are defined, so
+	// the language has to be selected before any of them is created.
+	i18n.SetLocale(clientLanguage(os.Args[1:]))
+	flag.Translate = i18n.T
+
+	commonFlags := cliflags.InitCommonFlags()
+	clientFlags := initClientFlags(commonFlags)
+	flHelp := flag.Bool([]string{"h", "-help"}, false, i18n.T("Print usage"))
+	flVersion := flag.Bool([]string{"v", "-version"}, false, i18n.T("Print version information and quit"))
+
 	flag.Merge(flag.CommandLine, clientFlags.FlagSet, commonFlags.FlagSet)
 
 	cobraAdaptor := cobraadaptor.NewCobraAdaptor(clientFlags)
 
 	flag.Usage = func() {
-		fmt.Fprint(stdout, "用途: docker [OPTIONS] COMMAND [arg...]\n       docker [ --help | -v | --version ]\n\n")
-		fmt.Fprint(stdout, "一个为容器而生的运行时管理引擎.\n\n选项:\n")
+		fmt.Fprint(stdout, i18n.T("Usage: docker [OPTIONS] COMMAND [arg...]\n       docker [ --help | -v | --version ]\n\n"))
+		fmt.Fprint(stdout, i18n.T("A self-sufficient runtime for containers.\n\nOptions:\n"))
 
 		flag.CommandLine.SetOutput(stdout)
 		flag.PrintDefaults()
 
-		help := "\n命令:\n"
+		help := i18n.T("\nCommands:\n")
 
-		dockerCommands := append(cli.DockerCommandUsage, cobraAdaptor.Usage()...)
+		var dockerCommands []cli.Command
+		for _, cmd := range cli.DockerCommandUsage {
+			dockerCommands = append(dockerCommands, cli.Command{Name: cmd.Name, Description: i18n.T(cmd.Description)})
+		}
+		dockerCommands = append(dockerCommands, cobraAdaptor.Usage()...)
 		for _, cmd := range sortCommands(dockerCommands) {
 			help += fmt.Sprintf("    %-10.10s%s\n", cmd.Name, cmd.Description)
 		}
 
-		help += "\n运行 'docker COMMAND --help' 来获取命令的更多详细信息."
+		help += i18n.T("\nRun 'docker COMMAND --help' for more information on a command.")
 		fmt.Fprintf(stdout, "%s\n", help)
 	}
 
@@ -88,16 +97,17 @@ func main() {
 
 func showVersion() {
 	if utils.ExperimentalBuild() {
-		fmt.Printf("Docker引擎版本 %s, 构建 %s, 试验版\n", dockerversion.Version, dockerversion.GitCommit)
+		fmt.Printf(i18n.T("Docker version %s, build %s, experimental\n"), dockerversion.Version, dockerversion.GitCommit)
 	} else {
-		fmt.Printf("Docker引擎版本 %s, 构建 %s\n", dockerversion.Version, dockerversion.GitCommit)
+		fmt.Printf(i18n.T("Docker version %s, build %s\n"), dockerversion.Version, dockerversion.GitCommit)
 	}
 }
 
 func initClientFlags(commonFlags *cliflags.CommonFlags) *cliflags.ClientFlags {
 	clientFlags := &cliflags.ClientFlags{FlagSet: new(flag.FlagSet), Common: commonFlags}
 	client := clientFlags.FlagSet
-	client.StringVar(&clientFlags.ConfigDir, []string{"-config"}, cliconfig.ConfigDir(), "客户端配置文件路径")
+	client.StringVar(&clientFlags.ConfigDir, []string{"-config"}, cliconfig.ConfigDir(), i18n.T("Location of client config files"))
+	client.StringVar(&clientFlags.Language, []string{"-lang"}, "", i18n.T("Language of the client output (e.g. en_US, zh_CN)"))
 
 	clientFlags.PostParse = func() {
 		clientFlags.Common.PostParse()
@@ -116,3 +126,26 @@ func initClientFlags(commonFlags *cliflags.CommonFlags) *cliflags.ClientFlags {
 	}
 	return clientFlags
 }
+
+// clientLanguage returns the language requested with --lang, by the
+// "language" key of the configuration file or by the locale environment
+// variables, in that order of precedence. The global options are parsed on a
+// scratch flag set; errors are left to the real parse.
+func clientLanguage(args []string) string {
+	clientFlags := initClientFlags(cliflags.InitCommonFlags())
+
+	flags := new(flag.FlagSet)
+	flags.SetOutput(ioutil.Discard)
+	flags.Bool([]string{"h", "-help"}, false, "")
+	flags.Bool([]string{"v", "-version"}, false, "")
+	flag.Merge(flags, clientFlags.FlagSet, clientFlags.Common.FlagSet)
+	flags.Parse(args)
+
+	if clientFlags.Language != "" {
+		return clientFlags.Language
+	}
+	if configFile, err := cliconfig.Load(clientFlags.ConfigDir); err == nil && configFile.Language != "" {
+		return configFile.Language
+	}
+	return i18n.FromEnv()
+}
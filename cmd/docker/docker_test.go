@@ -1,16 +1,21 @@
 package main
 
 import (
+	"io/ioutil"
 	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/Sirupsen/logrus"
+	cliflags "github.com/docker/docker/cli/flags"
+	"github.com/docker/docker/cliconfig"
 	"github.com/docker/docker/utils"
 )
 
 func TestClientDebugEnabled(t *testing.T) {
 	defer utils.DisableDebug()
 
+	clientFlags := initClientFlags(cliflags.InitCommonFlags())
 	clientFlags.Common.FlagSet.Parse([]string{"-D"})
 	clientFlags.PostParse()
 
@@ -21,3 +26,45 @@ func TestClientDebugEnabled(t *testing.T) {
 		t.Fatalf("expected logrus debug level, got %v", logrus.GetLevel())
 	}
 }
+
+func TestClientLanguage(t *testing.T) {
+	tmpHome, err := ioutil.TempDir("", "config-test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(tmpHome)
+
+	for _, name := range []string{"LC_ALL", "LC_MESSAGES", "LANG"} {
+		defer os.Setenv(name, os.Getenv(name))
+		os.Unsetenv(name)
+	}
+
+	if lang := clientLanguage([]string{"--config", tmpHome, "ps"}); lang != "" {
+		t.Fatalf("expected no language without flag or config, got %q", lang)
+	}
+
+	config := []byte(`{"language": "en_US"}`)
+	if err := ioutil.WriteFile(filepath.Join(tmpHome, cliconfig.ConfigFileName), config, 0600); err != nil {
+		t.Fatal(err)
+	}
+	if lang := clientLanguage([]string{"--config", tmpHome, "ps"}); lang != "en_US" {
+		t.Fatalf("expected language from config file, got %q", lang)
+	}
+	if lang := clientLanguage([]string{"--config", tmpHome, "--lang", "zh_CN", "ps", "-a"}); lang != "zh_CN" {
+		t.Fatalf("expected --lang to override config file, got %q", lang)
+	}
+	os.Setenv("LANG", "zh_CN.UTF-8")
+	if lang := clientLanguage([]string{"--config", tmpHome, "ps"}); lang != "en_US" {
+		t.Fatalf("expected config file to override LANG, got %q", lang)
+	}
+	if lang := clientLanguage([]string{"--config", tmpHome, "--no-such-flag"}); lang != "en_US" {
+		t.Fatalf("expected language from config file after parse error, got %q", lang)
+	}
+
+	if err := os.Remove(filepath.Join(tmpHome, cliconfig.ConfigFileName)); err != nil {
+		t.Fatal(err)
+	}
+	if lang := clientLanguage([]string{"--config", tmpHome, "ps"}); lang != "zh_CN.UTF-8" {
+		t.Fatalf("expected language from LANG without config file, got %q", lang)
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"os"
 
 	"github.com/Sirupsen/logrus"
+	"github.com/docker/docker/cli/i18n"
 	"github.com/docker/docker/dockerversion"
 	flag "github.com/docker/docker/pkg/mflag"
 	"github.com/docker/docker/pkg/reexec"
@@ -14,8 +15,8 @@ import (
 
 var (
 	daemonCli = NewDaemonCli()
-	flHelp    = flag.Bool([]string{"h", "-help"}, false, "打印用途")
-	flVersion = flag.Bool([]string{"v", "-version"}, false, "输出版本信息并退出")
+	flHelp    = flag.Bool([]string{"h", "-help"}, false, i18n.T("Print usage"))
+	flVersion = flag.Bool([]string{"v", "-version"}, false, i18n.T("Print version information and quit"))
 )
 
 func main() {
@@ -31,14 +32,14 @@ func main() {
 	flag.Merge(flag.CommandLine, daemonCli.commonFlags.FlagSet)
 
 	flag.Usage = func() {
-		fmt.Fprint(stdout, "用途: dockerd [OPTIONS]\n\n")
-		fmt.Fprint(stdout, "一个为容器而生的运行时管理引擎.\n\n选项:\n")
+		fmt.Fprint(stdout, i18n.T("Usage: dockerd [OPTIONS]\n\n"))
+		fmt.Fprint(stdout, i18n.T("A self-sufficient runtime for containers.\n\nOptions:\n"))
 
 		flag.CommandLine.SetOutput(stdout)
 		flag.PrintDefaults()
 	}
 	flag.CommandLine.ShortUsage = func() {
-		fmt.Fprint(stderr, "\n用途:\tdockerd [OPTIONS]\n")
+		fmt.Fprint(stderr, i18n.T("\nUsage:\tdockerd [OPTIONS]\n"))
 	}
 
 	if err := flag.CommandLine.ParseFlags(os.Args[1:], false); err != nil {
@@ -75,8 +76,8 @@ func main() {
 
 func showVersion() {
 	if utils.ExperimentalBuild() {
-		fmt.Printf("Docker引擎版本 %s, 构建 %s, 试验版\n", dockerversion.Version, dockerversion.GitCommit)
+		fmt.Printf(i18n.T("Docker version %s, build %s, experimental\n"), dockerversion.Version, dockerversion.GitCommit)
 	} else {
-		fmt.Printf("Docker引擎版本 %s, 构建 %s\n", dockerversion.Version, dockerversion.GitCommit)
+		fmt.Printf(i18n.T("Docker version %s, build %s\n"), dockerversion.Version, dockerversion.GitCommit)
 	}
 }
@@ -79,11 +79,15 @@ type CommonContainer struct {
 	MountLabel             string
 	ProcessLabel           string
 	RestartCount           int
+	LogDroppedMessages     uint64 // log messages dropped by the previous loggers, in non-blocking mode
 	HasBeenStartedBefore   bool
 	HasBeenManuallyStopped bool // used for unless-stopped restart policy
 	MountPoints            map[string]*volume.MountPoint
 	HostConfig             *containertypes.HostConfig `json:"-"` // do not serialize the host config in the json, otherwise we'll make the container unportable
 	ExecCommands           *exec.Store                `json:"-"`
+	// Secrets are exposed to the container on a tmpfs. They are only kept
+	// in memory, and never written to disk with the container.
+	Secrets []*Secret `json:"-"`
 	// logDriver for closing
 	LogDriver      logger.Logger  `json:"-"`
 	LogCopier      *logger.Copier `json:"-"`
@@ -306,6 +310,12 @@ func (container *Container) ConfigPath() (string, error) {
 	return container.GetRootResourcePath(configFileName)
 }
 
+// DroppedLogMessages returns the number of log messages dropped in
+// non-blocking mode since the container was created.
+func (container *Container) DroppedLogMessages() uint64 {
+	return container.LogDroppedMessages + logger.DroppedMessages(container.LogDriver)
+}
+
 // StartLogger starts a new logger driver for the container.
 func (container *Container) StartLogger(cfg containertypes.LogConfig) (logger.Logger, error) {
 	c, err := logger.GetLogDriver(cfg.Type)
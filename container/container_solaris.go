@@ -62,6 +62,16 @@ func (container *Container) IpcMounts() []Mount {
 	return nil
 }
 
+// UnmountSecrets unmounts the secrets of the container.
+// This is a NOOP on solaris.
+func (container *Container) UnmountSecrets(unmount func(pth string) error) {
+}
+
+// SecretMounts returns the list of secret mounts.
+func (container *Container) SecretMounts() []Mount {
+	return nil
+}
+
 // UpdateContainer updates configuration of a container
 func (container *Container) UpdateContainer(hostConfig *container.HostConfig) error {
 	return nil
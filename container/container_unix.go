@@ -256,6 +256,44 @@ func (container *Container) IpcMounts() []Mount {
 	return mounts
 }
 
+// UnmountSecrets uses the provided unmount function to unmount the tmpfs
+// holding the secrets of the container, if it was mounted.
+func (container *Container) UnmountSecrets(unmount func(pth string) error) {
+	if len(container.Secrets) == 0 {
+		return
+	}
+
+	secretsPath, err := container.SecretsPath()
+	if err != nil {
+		logrus.Error(err)
+		return
+	}
+	if err := unmount(secretsPath); err != nil && !os.IsNotExist(err) {
+		logrus.Warnf("failed to umount %s: %v", secretsPath, err)
+	}
+}
+
+// SecretMounts returns the mount of the directory holding the secrets of the
+// container, if it has any.
+func (container *Container) SecretMounts() []Mount {
+	if len(container.Secrets) == 0 {
+		return nil
+	}
+
+	secretsPath, err := container.SecretsPath()
+	if err != nil {
+		logrus.Error(err)
+		return nil
+	}
+	label.SetFileLabel(secretsPath, container.MountLabel)
+	return []Mount{{
+		Source:      secretsPath,
+		Destination: SecretsMountPoint,
+		Writable:    false,
+		Propagation: volume.DefaultPropagationMode,
+	}}
+}
+
 // UpdateContainer updates configuration of a container.
 func (container *Container) UpdateContainer(hostConfig *containertypes.HostConfig) error {
 	container.Lock()
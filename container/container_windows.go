@@ -47,6 +47,16 @@ func (container *Container) IpcMounts() []Mount {
 	return nil
 }
 
+// UnmountSecrets unmounts the secrets of the container.
+// This is a NOOP on windows.
+func (container *Container) UnmountSecrets(unmount func(pth string) error) {
+}
+
+// SecretMounts returns the list of secret mounts.
+func (container *Container) SecretMounts() []Mount {
+	return nil
+}
+
 // UnmountVolumes explicitly unmounts volumes from the container.
 func (container *Container) UnmountVolumes(forceSyscall bool, volumeEventLog func(name, action string, attributes map[string]string)) error {
 	return nil
@@ -4,6 +4,7 @@ import (
 	"time"
 
 	"github.com/Sirupsen/logrus"
+	"github.com/docker/docker/daemon/logger"
 )
 
 const (
@@ -40,6 +41,7 @@ func (container *Container) Reset(lock bool) {
 			}
 		}
 		container.LogDriver.Close()
+		container.LogDroppedMessages += logger.DroppedMessages(container.LogDriver)
 		container.LogCopier = nil
 		container.LogDriver = nil
 	}
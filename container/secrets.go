@@ -0,0 +1,18 @@
+package container
+
+// SecretsMountPoint is the path under which the secrets of a container are
+// exposed in the container.
+const SecretsMountPoint = "/run/secrets"
+
+// Secret is a secret exposed to a container, as a file named after the secret
+// under SecretsMountPoint.
+type Secret struct {
+	Name string
+	Data []byte
+}
+
+// SecretsPath returns the path of the directory on which the tmpfs holding
+// the secrets of the container is mounted.
+func (container *Container) SecretsPath() (string, error) {
+	return container.GetRootResourcePath("secrets")
+}
@@ -342,7 +342,9 @@ func (daemon *Daemon) containerCopy(container *container.Container, resource str
 // specified by a container object.
 // TODO: make sure callers don't unnecessarily convert destPath with filepath.FromSlash (Copy does it already).
 // CopyOnBuild should take in abstract paths (with slashes) and the implementation should convert it to OS-specific paths.
-func (daemon *Daemon) CopyOnBuild(cID string, destPath string, src builder.FileInfo, decompress bool) error {
+// The copied files are owned by root, or by the user and group given by
+// chown, as user[:group], resolved against the files of the container.
+func (daemon *Daemon) CopyOnBuild(cID string, destPath string, src builder.FileInfo, decompress bool, chown string) error {
 	srcPath := src.Path()
 	destExists := true
 	destDir := false
@@ -361,6 +363,20 @@ func (daemon *Daemon) CopyOnBuild(cID string, destPath string, src builder.FileI
 	}
 	defer daemon.Unmount(c)
 
+	uidMaps, gidMaps := daemon.GetUIDGIDMaps()
+	if chown != "" {
+		uid, gid, err := parseChownFlag(c, chown)
+		if err != nil {
+			return err
+		}
+		if rootUID, err = idtools.ToHost(uid, uidMaps); err != nil {
+			return err
+		}
+		if rootGID, err = idtools.ToHost(gid, gidMaps); err != nil {
+			return err
+		}
+	}
+
 	dest, err := c.GetResourcePath(destPath)
 	if err != nil {
 		return err
@@ -384,7 +400,6 @@ func (daemon *Daemon) CopyOnBuild(cID string, destPath string, src builder.FileI
 		destExists = false
 	}
 
-	uidMaps, gidMaps := daemon.GetUIDGIDMaps()
 	archiver := &archive.Archiver{
 		Untar:   chrootarchive.Untar,
 		UIDMaps: uidMaps,
@@ -3,10 +3,14 @@
 package daemon
 
 import (
+	"fmt"
 	"os"
 	"path/filepath"
+	"strconv"
+	"strings"
 
 	"github.com/docker/docker/container"
+	"github.com/opencontainers/runc/libcontainer/user"
 )
 
 // checkIfPathIsInAVolume checks if the path is in a volume. If it is, it
@@ -56,3 +60,70 @@ func fixPermissions(source, destination string, uid, gid int, destExisted bool)
 		return os.Lchown(fullpath, uid, gid)
 	})
 }
+
+// parseChownFlag resolves the user and group given as user[:group] to the
+// --chown flag of COPY and ADD. Names are looked up in the /etc/passwd and
+// /etc/group files of the container. Without a group, the group with the same
+// name or ID as the user is used.
+func parseChownFlag(container *container.Container, chown string) (int, int, error) {
+	userAndGroup := strings.SplitN(chown, ":", 2)
+	userStr, groupStr := userAndGroup[0], userAndGroup[0]
+	if len(userAndGroup) == 2 {
+		groupStr = userAndGroup[1]
+	}
+
+	uid, err := lookupChownID(userStr, func(name string) (int, error) {
+		passwdPath, err := container.GetResourcePath("/etc/passwd")
+		if err != nil {
+			return 0, err
+		}
+		users, err := user.ParsePasswdFileFilter(passwdPath, func(u user.User) bool {
+			return u.Name == name
+		})
+		if err != nil {
+			return 0, err
+		}
+		if len(users) == 0 {
+			return 0, fmt.Errorf("no such user")
+		}
+		return users[0].Uid, nil
+	})
+	if err != nil {
+		return 0, 0, fmt.Errorf("can't find uid for user %s: %v", userStr, err)
+	}
+
+	gid, err := lookupChownID(groupStr, func(name string) (int, error) {
+		groupPath, err := container.GetResourcePath("/etc/group")
+		if err != nil {
+			return 0, err
+		}
+		groups, err := user.ParseGroupFileFilter(groupPath, func(g user.Group) bool {
+			return g.Name == name
+		})
+		if err != nil {
+			return 0, err
+		}
+		if len(groups) == 0 {
+			return 0, fmt.Errorf("no such group")
+		}
+		return groups[0].Gid, nil
+	})
+	if err != nil {
+		return 0, 0, fmt.Errorf("can't find gid for group %s: %v", groupStr, err)
+	}
+	return uid, gid, nil
+}
+
+// lookupChownID returns the ID given as a number, or looks up the name.
+func lookupChownID(s string, lookup func(name string) (int, error)) (int, error) {
+	if s == "" {
+		return 0, fmt.Errorf("empty name")
+	}
+	if id, err := strconv.Atoi(s); err == nil {
+		if id < 0 {
+			return 0, fmt.Errorf("negative ID")
+		}
+		return id, nil
+	}
+	return lookup(s)
+}
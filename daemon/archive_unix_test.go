@@ -0,0 +1,58 @@
+// +build !windows
+
+package daemon
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/docker/docker/container"
+)
+
+func TestParseChownFlag(t *testing.T) {
+	root, err := ioutil.TempDir("", "docker-chown-")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(root)
+
+	if err := os.MkdirAll(filepath.Join(root, "etc"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	passwd := "root:x:0:0:root:/root:/bin/sh\napp:x:1000:1000::/home/app:/bin/sh\n"
+	if err := ioutil.WriteFile(filepath.Join(root, "etc", "passwd"), []byte(passwd), 0644); err != nil {
+		t.Fatal(err)
+	}
+	group := "root:x:0:\napp:x:1000:\nstaff:x:50:app\n"
+	if err := ioutil.WriteFile(filepath.Join(root, "etc", "group"), []byte(group), 0644); err != nil {
+		t.Fatal(err)
+	}
+	c := &container.Container{CommonContainer: container.CommonContainer{BaseFS: root}}
+
+	for _, tc := range []struct {
+		chown    string
+		uid, gid int
+	}{
+		{"app", 1000, 1000},
+		{"app:staff", 1000, 50},
+		{"1001", 1001, 1001},
+		{"1001:50", 1001, 50},
+		{"app:42", 1000, 42},
+	} {
+		uid, gid, err := parseChownFlag(c, tc.chown)
+		if err != nil {
+			t.Fatalf("%s: %v", tc.chown, err)
+		}
+		if uid != tc.uid || gid != tc.gid {
+			t.Fatalf("%s: expected %d:%d, got %d:%d", tc.chown, tc.uid, tc.gid, uid, gid)
+		}
+	}
+
+	for _, chown := range []string{"nobody", "app:nogroup", ":staff", "-1"} {
+		if _, _, err := parseChownFlag(c, chown); err == nil {
+			t.Fatalf("expected an error for %s", chown)
+		}
+	}
+}
@@ -1,6 +1,10 @@
 package daemon
 
-import "github.com/docker/docker/container"
+import (
+	"fmt"
+
+	"github.com/docker/docker/container"
+)
 
 // checkIfPathIsInAVolume checks if the path is in a volume. If it is, it
 // cannot be in a read-only volume. If it  is not in a volume, the container
@@ -12,6 +16,12 @@ func checkIfPathIsInAVolume(container *container.Container, absPath string) (boo
 	return false, nil
 }
 
+// parseChownFlag returns an error, as the ownership of files can't be set on
+// Windows.
+func parseChownFlag(container *container.Container, chown string) (int, int, error) {
+	return 0, 0, fmt.Errorf("--chown is not supported on Windows")
+}
+
 func fixPermissions(source, destination string, uid, gid int, destExisted bool) error {
 	// chown is not supported on Windows
 	return nil
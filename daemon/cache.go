@@ -0,0 +1,206 @@
+package daemon
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+
+	"github.com/Sirupsen/logrus"
+	"github.com/docker/docker/builder"
+	"github.com/docker/docker/dockerversion"
+	"github.com/docker/docker/image"
+	"github.com/docker/docker/layer"
+	"github.com/docker/docker/runconfig"
+	containertypes "github.com/docker/engine-api/types/container"
+)
+
+// imageCache is the build cache of a single build. It matches the images
+// built locally, then the layers and history of the cache sources given to
+// the build.
+type imageCache struct {
+	daemon  *Daemon
+	sources []*image.Image
+}
+
+// MakeImageCache returns the build cache for a build using the images
+// referred to by sourceRefs as additional cache sources. Images which can't
+// be found are ignored.
+func (daemon *Daemon) MakeImageCache(sourceRefs []string) builder.ImageCache {
+	if len(sourceRefs) == 0 {
+		return daemon
+	}
+
+	cache := &imageCache{daemon: daemon}
+	for _, ref := range sourceRefs {
+		img, err := daemon.GetImage(ref)
+		if err != nil {
+			logrus.Warnf("Could not look up %s for cache resolution, skipping: %+v", ref, err)
+			continue
+		}
+		cache.sources = append(cache.sources, img)
+	}
+	return cache
+}
+
+// GetCachedImageOnBuild returns the ID of an image built from parentID with
+// cfg, either locally or as a step of one of the cache sources. A cache miss
+// returns an empty ID and a nil error.
+func (ic *imageCache) GetCachedImageOnBuild(parentID string, cfg *containertypes.Config) (string, error) {
+	imgID, err := ic.daemon.GetCachedImageOnBuild(parentID, cfg)
+	if err != nil || imgID != "" {
+		return imgID, err
+	}
+
+	var parent *image.Image
+	lenHistory := 0
+	if parentID != "" {
+		parent, err = ic.daemon.imageStore.Get(image.ID(parentID))
+		if err != nil {
+			return "", err
+		}
+		lenHistory = len(parent.History)
+	}
+
+	for _, target := range ic.sources {
+		if !isValidParent(target, parent) || !isValidConfig(cfg, target, lenHistory) {
+			continue
+		}
+
+		if len(target.History)-1 == lenHistory {
+			// The last step of the source is the source itself.
+			if parent != nil {
+				if err := ic.daemon.imageStore.SetParent(target.ID(), parent.ID()); err != nil {
+					return "", err
+				}
+			}
+			return target.ID().String(), nil
+		}
+
+		imgID, err := ic.restoreCachedImage(parent, target, cfg)
+		if err != nil {
+			return "", err
+		}
+		// The following steps can only match the same source.
+		ic.sources = []*image.Image{target}
+		return imgID.String(), nil
+	}
+
+	ic.sources = nil
+	return "", nil
+}
+
+// restoreCachedImage creates the image of the step of target following
+// parent, which shares its layers with target. target does not record the
+// config of its intermediate steps, so the image gets cfg as its config and
+// container config, as a local build of the step would.
+func (ic *imageCache) restoreCachedImage(parent, target *image.Image, cfg *containertypes.Config) (image.ID, error) {
+	var history []image.History
+	rootFS := image.NewRootFS()
+	lenHistory := 0
+	if parent != nil {
+		history = append(history, parent.History...)
+		*rootFS = *parent.RootFS
+		rootFS.DiffIDs = append([]layer.DiffID(nil), parent.RootFS.DiffIDs...)
+		lenHistory = len(parent.History)
+	}
+	history = append(history, target.History[lenHistory])
+	if diffID := layerForHistoryIndex(target, lenHistory); diffID != "" {
+		rootFS.Append(diffID)
+	}
+
+	config, err := json.Marshal(&image.Image{
+		V1Image: image.V1Image{
+			DockerVersion:   dockerversion.Version,
+			Config:          cfg,
+			ContainerConfig: *cfg,
+			Architecture:    target.Architecture,
+			OS:              target.OS,
+			Author:          target.Author,
+			Created:         history[len(history)-1].Created,
+		},
+		RootFS:     rootFS,
+		History:    history,
+		OSFeatures: target.OSFeatures,
+		OSVersion:  target.OSVersion,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	imgID, err := ic.daemon.imageStore.Create(config)
+	if err != nil {
+		return "", err
+	}
+	if parent != nil {
+		if err := ic.daemon.imageStore.SetParent(imgID, parent.ID()); err != nil {
+			return "", err
+		}
+	}
+	return imgID, nil
+}
+
+// isValidParent returns whether img was built on top of parent, that is
+// whether the history and layers of parent are the first ones of img.
+func isValidParent(img, parent *image.Image) bool {
+	if len(img.History) == 0 {
+		return false
+	}
+	if parent == nil || len(parent.History) == 0 && len(parent.RootFS.DiffIDs) == 0 {
+		return true
+	}
+	if len(parent.History) >= len(img.History) {
+		return false
+	}
+	if len(parent.RootFS.DiffIDs) > len(img.RootFS.DiffIDs) {
+		return false
+	}
+	for i, h := range parent.History {
+		if !reflect.DeepEqual(h, img.History[i]) {
+			return false
+		}
+	}
+	for i, diffID := range parent.RootFS.DiffIDs {
+		if diffID != img.RootFS.DiffIDs[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// isValidConfig returns whether the step of target at index was built with
+// the config cfg. The last step is the image itself, whose container config
+// is compared the same way as by the local cache. The intermediate steps
+// only record their command in their history entry, the same way as by
+// Commit, so they are matched on it alone. The config set by the earlier
+// instructions of the Dockerfile is part of the commands of their own steps,
+// but changes to the config made outside of the Dockerfile are not seen.
+func isValidConfig(cfg *containertypes.Config, target *image.Image, index int) bool {
+	if strings.Join(cfg.Cmd, " ") != target.History[index].CreatedBy {
+		return false
+	}
+	if index == len(target.History)-1 {
+		return runconfig.Compare(&target.ContainerConfig, cfg)
+	}
+	return true
+}
+
+// layerForHistoryIndex returns the layer added by the history entry of img
+// at index, or an empty DiffID if the entry added none.
+func layerForHistoryIndex(img *image.Image, index int) layer.DiffID {
+	layerIndex := 0
+	for i, h := range img.History {
+		if i == index {
+			if h.EmptyLayer {
+				return ""
+			}
+			break
+		}
+		if !h.EmptyLayer {
+			layerIndex++
+		}
+	}
+	if layerIndex >= len(img.RootFS.DiffIDs) {
+		return ""
+	}
+	return img.RootFS.DiffIDs[layerIndex]
+}
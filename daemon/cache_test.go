@@ -0,0 +1,151 @@
+package daemon
+
+import (
+	"io/ioutil"
+	"os"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/docker/docker/image"
+	"github.com/docker/docker/layer"
+	containertypes "github.com/docker/engine-api/types/container"
+)
+
+func TestIsValidParent(t *testing.T) {
+	base := &image.Image{
+		RootFS:  &image.RootFS{Type: "layers", DiffIDs: []layer.DiffID{"sha256:a"}},
+		History: []image.History{{CreatedBy: "ADD file:a in /"}},
+	}
+	img := &image.Image{
+		RootFS: &image.RootFS{Type: "layers", DiffIDs: []layer.DiffID{"sha256:a", "sha256:b"}},
+		History: []image.History{
+			{CreatedBy: "ADD file:a in /"},
+			{CreatedBy: "/bin/sh -c #(nop) ENV foo=bar", EmptyLayer: true},
+			{CreatedBy: "/bin/sh -c make"},
+		},
+	}
+	other := &image.Image{
+		RootFS:  &image.RootFS{Type: "layers", DiffIDs: []layer.DiffID{"sha256:c"}},
+		History: []image.History{{CreatedBy: "ADD file:c in /"}},
+	}
+
+	if !isValidParent(img, nil) {
+		t.Fatal("expected any image to be built on scratch")
+	}
+	if !isValidParent(img, base) {
+		t.Fatal("expected the image to be built on its base")
+	}
+	if isValidParent(img, other) {
+		t.Fatal("expected the image not to be built on another image")
+	}
+	if isValidParent(base, img) {
+		t.Fatal("expected the base not to be built on the image")
+	}
+
+	if diffID := layerForHistoryIndex(img, 1); diffID != "" {
+		t.Fatalf("expected no layer for an empty history entry, got %s", diffID)
+	}
+	if diffID := layerForHistoryIndex(img, 2); diffID != "sha256:b" {
+		t.Fatalf("expected sha256:b for the last history entry, got %s", diffID)
+	}
+}
+
+func TestIsValidConfig(t *testing.T) {
+	img := &image.Image{
+		V1Image: image.V1Image{
+			ContainerConfig: containertypes.Config{
+				Cmd: []string{"/bin/sh", "-c", "make"},
+				Env: []string{"foo=bar"},
+			},
+		},
+		History: []image.History{
+			{CreatedBy: "ADD file:a in /"},
+			{CreatedBy: "/bin/sh -c #(nop) ENV foo=bar", EmptyLayer: true},
+			{CreatedBy: "/bin/sh -c make"},
+		},
+	}
+
+	step := &containertypes.Config{Cmd: []string{"/bin/sh", "-c", "#(nop) ENV foo=bar"}}
+	if !isValidConfig(step, img, 1) {
+		t.Fatal("expected an intermediate step to match its command")
+	}
+	if isValidConfig(step, img, 2) {
+		t.Fatal("expected a different command not to match")
+	}
+
+	last := &containertypes.Config{Cmd: []string{"/bin/sh", "-c", "make"}, Env: []string{"foo=bar"}}
+	if !isValidConfig(last, img, 2) {
+		t.Fatal("expected the last step to match the container config of the image")
+	}
+	last.Env = []string{"foo=baz"}
+	if isValidConfig(last, img, 2) {
+		t.Fatal("expected a different config not to match the last step")
+	}
+}
+
+type emptyLayerStore struct{}
+
+func (emptyLayerStore) Get(layer.ChainID) (layer.Layer, error) {
+	return nil, nil
+}
+
+func (emptyLayerStore) Release(layer.Layer) ([]layer.Metadata, error) {
+	return nil, nil
+}
+
+func TestRestoreCachedImage(t *testing.T) {
+	root, err := ioutil.TempDir("", "docker-cache-test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(root)
+	fs, err := image.NewFSStoreBackend(root)
+	if err != nil {
+		t.Fatal(err)
+	}
+	is, err := image.NewImageStore(fs, emptyLayerStore{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	created := time.Date(2016, 9, 1, 0, 0, 0, 0, time.UTC)
+	target := &image.Image{
+		V1Image: image.V1Image{
+			Config:       &containertypes.Config{Env: []string{"foo=bar", "bar=baz"}},
+			Architecture: "amd64",
+			OS:           "linux",
+		},
+		RootFS: image.NewRootFS(),
+		History: []image.History{
+			{Created: created, CreatedBy: "/bin/sh -c #(nop) ENV foo=bar", EmptyLayer: true},
+			{Created: created.Add(time.Minute), CreatedBy: "/bin/sh -c #(nop) ENV bar=baz", EmptyLayer: true},
+		},
+	}
+	cfg := &containertypes.Config{
+		Cmd: []string{"/bin/sh", "-c", "#(nop) ENV foo=bar"},
+		Env: []string{"foo=bar"},
+	}
+
+	ic := &imageCache{daemon: &Daemon{imageStore: is}}
+	id, err := ic.restoreCachedImage(nil, target, cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	img, err := is.Get(id)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The config of the step comes from the build, not from the source,
+	// whose config is the one of its last step.
+	if !reflect.DeepEqual(img.Config, cfg) || !reflect.DeepEqual(img.ContainerConfig, *cfg) {
+		t.Fatalf("expected the config of the step, got %+v and %+v", img.Config, img.ContainerConfig)
+	}
+	if !reflect.DeepEqual(img.History, target.History[:1]) {
+		t.Fatalf("expected the history of the first step, got %+v", img.History)
+	}
+	if !img.Created.Equal(created) || img.Architecture != "amd64" || img.OS != "linux" {
+		t.Fatalf("expected the metadata of the source, got %v, %s, %s", img.Created, img.Architecture, img.OS)
+	}
+}
@@ -0,0 +1,309 @@
+package cluster
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"fmt"
+	"io"
+	"path/filepath"
+
+	"github.com/Sirupsen/logrus"
+	types "github.com/docker/engine-api/types/swarm"
+	swarmapi "github.com/docker/swarmkit/api"
+	"github.com/docker/swarmkit/ca"
+	swarmstate "github.com/docker/swarmkit/manager/state"
+	"github.com/docker/swarmkit/manager/state/store"
+	"golang.org/x/crypto/pbkdf2"
+	"golang.org/x/net/context"
+)
+
+// backupMagic identifies the files produced by Backup.
+const backupMagic = "SWARMBACKUP"
+
+const (
+	backupVersion    = 1
+	backupSaltLen    = 16
+	backupKeyLen     = 32
+	backupIterations = 100000
+)
+
+var errBackupPassphrase = fmt.Errorf("a passphrase is required to back up or restore a swarm")
+
+// errInvalidBackup is returned when a backup can't be decrypted, because
+// either the passphrase is wrong or the file is corrupted.
+var errInvalidBackup = fmt.Errorf("unable to decrypt the backup: wrong passphrase or corrupted backup")
+
+// encryptBackup encrypts data with AES-256-GCM, using a key derived from
+// passphrase. The header holding the format version and the salt of the key
+// is authenticated along with the data.
+func encryptBackup(data []byte, passphrase string) ([]byte, error) {
+	salt := make([]byte, backupSaltLen)
+	if _, err := io.ReadFull(rand.Reader, salt); err != nil {
+		return nil, err
+	}
+	header := append([]byte(backupMagic), backupVersion)
+	header = append(header, salt...)
+
+	gcm, err := newBackupCipher(passphrase, salt)
+	if err != nil {
+		return nil, err
+	}
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return nil, err
+	}
+
+	out := append(header, nonce...)
+	return gcm.Seal(out, nonce, data, header), nil
+}
+
+// decryptBackup reverses encryptBackup.
+func decryptBackup(data []byte, passphrase string) ([]byte, error) {
+	headerLen := len(backupMagic) + 1 + backupSaltLen
+	if len(data) < headerLen || !bytes.Equal(data[:len(backupMagic)], []byte(backupMagic)) {
+		return nil, fmt.Errorf("invalid swarm backup")
+	}
+	if v := data[len(backupMagic)]; v != backupVersion {
+		return nil, fmt.Errorf("unsupported swarm backup version %d", v)
+	}
+	header, salt := data[:headerLen], data[len(backupMagic)+1:headerLen]
+
+	gcm, err := newBackupCipher(passphrase, salt)
+	if err != nil {
+		return nil, err
+	}
+	data = data[headerLen:]
+	if len(data) < gcm.NonceSize() {
+		return nil, errInvalidBackup
+	}
+	plain, err := gcm.Open(nil, data[:gcm.NonceSize()], data[gcm.NonceSize():], header)
+	if err != nil {
+		return nil, errInvalidBackup
+	}
+	return plain, nil
+}
+
+func newBackupCipher(passphrase string, salt []byte) (cipher.AEAD, error) {
+	key := pbkdf2.Key([]byte(passphrase), salt, backupIterations, backupKeyLen, sha256.New)
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+// Backup returns a consistent snapshot of the state of the swarm, including
+// the key material of its root CA, encrypted with the passphrase of req.
+func (c *Cluster) Backup(req types.BackupRequest) ([]byte, error) {
+	c.RLock()
+	defer c.RUnlock()
+
+	if !c.isActiveManager() {
+		return nil, c.errNoManager()
+	}
+	if req.Passphrase == "" {
+		return nil, errBackupPassphrase
+	}
+
+	m := c.node.Manager()
+	if m == nil {
+		return nil, c.errNoManager()
+	}
+
+	var (
+		snapshot *swarmapi.StoreSnapshot
+		err      error
+	)
+	m.RaftNode.MemoryStore().View(func(tx store.ReadTx) {
+		snapshot, err = m.RaftNode.MemoryStore().Save(tx)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := snapshot.Marshal()
+	if err != nil {
+		return nil, err
+	}
+	return encryptBackup(data, req.Passphrase)
+}
+
+// Restore creates a new cluster from a backup, with the current node as its
+// only manager. The cluster keeps the ID, settings and root CA of the backed
+// up one, and its services, networks and secrets are recreated.
+func (c *Cluster) Restore(req types.RestoreRequest) (string, error) {
+	if req.Passphrase == "" {
+		return "", errBackupPassphrase
+	}
+	data, err := decryptBackup(req.Backup, req.Passphrase)
+	if err != nil {
+		return "", err
+	}
+	var snapshot swarmapi.StoreSnapshot
+	if err := snapshot.Unmarshal(data); err != nil {
+		return "", fmt.Errorf("invalid swarm backup: %v", err)
+	}
+	if len(snapshot.Clusters) == 0 {
+		return "", fmt.Errorf("invalid swarm backup: no cluster found")
+	}
+	cluster := snapshot.Clusters[0]
+	if len(cluster.RootCA.CAKey) == 0 {
+		return "", fmt.Errorf("the backup doesn't contain the root CA key of the swarm")
+	}
+
+	c.Lock()
+	if node := c.node; node != nil {
+		c.Unlock()
+		return "", ErrSwarmExists
+	}
+
+	if req.ListenAddr, err = validateAddr(req.ListenAddr); err != nil {
+		c.Unlock()
+		return "", fmt.Errorf("invalid ListenAddr %q: %v", req.ListenAddr, err)
+	}
+
+	localAddr, listenAddr, advertiseAddr, err := c.resolveNewClusterAddrs(req.ListenAddr, req.AdvertiseAddr)
+	if err != nil {
+		c.Unlock()
+		return "", err
+	}
+
+	if err := ca.BootstrapClusterWithRootCA(filepath.Join(c.root, "certificates"), cluster.RootCA.CACert, cluster.RootCA.CAKey, cluster.ID); err != nil {
+		c.clearState()
+		c.Unlock()
+		return "", fmt.Errorf("error restoring the root CA of the swarm: %v", err)
+	}
+
+	n, err := c.startNewNode(false, localAddr, "", listenAddr, advertiseAddr, "", "")
+	if err != nil {
+		c.clearState()
+		c.Unlock()
+		return "", err
+	}
+	c.Unlock()
+
+	select {
+	case <-n.Ready():
+		ctx, cancel := context.WithTimeout(context.Background(), swarmConnectTimeout)
+		defer cancel()
+		if err := restoreSnapshot(ctx, n, &snapshot); err != nil {
+			// Don't leave a partly restored cluster running, so that
+			// the restore can be retried.
+			c.Lock()
+			if c.node == n {
+				if err := c.stopNode(); err != nil {
+					logrus.Errorf("error stopping the restored node: %v", err)
+				}
+			}
+			if err := c.clearState(); err != nil {
+				logrus.Errorf("error clearing the restored state: %v", err)
+			}
+			c.Unlock()
+			return "", err
+		}
+		go c.reconnectOnFailure(n)
+		return n.NodeID(), nil
+	case <-n.done:
+		c.RLock()
+		defer c.RUnlock()
+		if err := c.clearState(); err != nil {
+			return "", err
+		}
+		return "", c.err
+	}
+}
+
+// restoreSnapshot waits, until ctx is done, for the manager of node to create
+// the cluster object and recreates the objects of snapshot in its store.
+// Tasks and nodes are not restored: the orchestrators recreate the tasks of
+// the services, and other nodes have to join the new cluster.
+func restoreSnapshot(ctx context.Context, node *node, snapshot *swarmapi.StoreSnapshot) error {
+	m := node.Manager()
+	if m == nil {
+		return fmt.Errorf("the restored node is not running as a manager")
+	}
+	s := m.RaftNode.MemoryStore()
+
+	var found bool
+	watch, cancel, err := store.ViewAndWatch(s, func(tx store.ReadTx) error {
+		clusters, err := store.FindClusters(tx, store.All)
+		found = len(clusters) > 0
+		return err
+	}, swarmstate.EventCreateCluster{})
+	if err != nil {
+		return err
+	}
+	defer cancel()
+	if !found {
+		select {
+		case <-watch:
+		case <-node.done:
+			return fmt.Errorf("the restored node stopped before the cluster was created")
+		case <-ctx.Done():
+			return fmt.Errorf("timed out waiting for the restored cluster to start")
+		}
+	}
+
+	return s.Update(func(tx store.Tx) error {
+		cluster := store.GetCluster(tx, snapshot.Clusters[0].ID)
+		if cluster == nil {
+			return fmt.Errorf("cluster %s not found", snapshot.Clusters[0].ID)
+		}
+		cluster.Spec = snapshot.Clusters[0].Spec
+		cluster.RootCA.JoinTokens = snapshot.Clusters[0].RootCA.JoinTokens
+		if err := store.UpdateCluster(tx, cluster); err != nil {
+			return fmt.Errorf("error restoring cluster settings: %v", err)
+		}
+
+		for _, secret := range snapshot.Secrets {
+			if err := store.CreateSecret(tx, secret); err != nil {
+				return fmt.Errorf("error restoring secret %s: %v", secret.Spec.Annotations.Name, err)
+			}
+		}
+
+		// Networks created by the new manager, such as the ingress
+		// network, are kept, and services are attached to them instead.
+		networkIDs := make(map[string]string)
+		for _, network := range snapshot.Networks {
+			existing, err := store.FindNetworks(tx, store.ByName(network.Spec.Annotations.Name))
+			if err != nil {
+				return err
+			}
+			if len(existing) > 0 {
+				networkIDs[network.ID] = existing[0].ID
+				continue
+			}
+			// Let the allocator allocate the network again.
+			network.DriverState = nil
+			network.IPAM = nil
+			if err := store.CreateNetwork(tx, network); err != nil {
+				return fmt.Errorf("error restoring network %s: %v", network.Spec.Annotations.Name, err)
+			}
+		}
+
+		for _, service := range snapshot.Services {
+			specs := []*swarmapi.ServiceSpec{&service.Spec}
+			if service.PreviousSpec != nil {
+				specs = append(specs, service.PreviousSpec)
+			}
+			for _, spec := range specs {
+				for _, attachment := range spec.Networks {
+					if id, ok := networkIDs[attachment.Target]; ok {
+						attachment.Target = id
+					}
+				}
+			}
+			service.Endpoint = nil
+			service.UpdateStatus = nil
+			if err := store.CreateService(tx, service); err != nil {
+				return fmt.Errorf("error restoring service %s: %v", service.Spec.Annotations.Name, err)
+			}
+		}
+
+		logrus.Infof("restored %d services, %d networks and %d secrets from backup", len(snapshot.Services), len(snapshot.Networks), len(snapshot.Secrets))
+		return nil
+	})
+}
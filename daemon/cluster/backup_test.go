@@ -0,0 +1,74 @@
+package cluster
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestEncryptBackupRoundTrip(t *testing.T) {
+	data := []byte("swarm snapshot")
+	encrypted, err := encryptBackup(data, "passphrase")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if bytes.Contains(encrypted, data) {
+		t.Fatal("expected the backup to be encrypted")
+	}
+
+	decrypted, err := decryptBackup(encrypted, "passphrase")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(decrypted, data) {
+		t.Fatalf("expected %q, got %q", data, decrypted)
+	}
+}
+
+func TestDecryptBackupWrongPassphrase(t *testing.T) {
+	encrypted, err := encryptBackup([]byte("swarm snapshot"), "passphrase")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := decryptBackup(encrypted, "wrong"); err != errInvalidBackup {
+		t.Fatalf("expected %v, got %v", errInvalidBackup, err)
+	}
+}
+
+func TestDecryptBackupTamperedHeader(t *testing.T) {
+	encrypted, err := encryptBackup([]byte("swarm snapshot"), "passphrase")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The salt is part of the authenticated header.
+	tampered := append([]byte(nil), encrypted...)
+	tampered[len(backupMagic)+1] ^= 0xff
+	if _, err := decryptBackup(tampered, "passphrase"); err != errInvalidBackup {
+		t.Fatalf("expected %v, got %v", errInvalidBackup, err)
+	}
+
+	tampered = append([]byte(nil), encrypted...)
+	tampered[len(backupMagic)] = backupVersion + 1
+	if _, err := decryptBackup(tampered, "passphrase"); err == nil {
+		t.Fatal("expected an error for an unsupported version")
+	}
+
+	tampered = append([]byte(nil), encrypted...)
+	tampered[0] ^= 0xff
+	if _, err := decryptBackup(tampered, "passphrase"); err == nil {
+		t.Fatal("expected an error for an invalid backup")
+	}
+}
+
+func TestDecryptBackupTruncated(t *testing.T) {
+	encrypted, err := encryptBackup([]byte("swarm snapshot"), "passphrase")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := decryptBackup(encrypted[:len(encrypted)-1], "passphrase"); err != errInvalidBackup {
+		t.Fatalf("expected %v, got %v", errInvalidBackup, err)
+	}
+	if _, err := decryptBackup(encrypted[:len(backupMagic)+1+backupSaltLen], "passphrase"); err != errInvalidBackup {
+		t.Fatalf("expected %v, got %v", errInvalidBackup, err)
+	}
+}
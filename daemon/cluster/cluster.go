@@ -3,10 +3,12 @@ package cluster
 import (
 	"encoding/json"
 	"fmt"
+	"io"
 	"io/ioutil"
 	"net"
 	"os"
 	"path/filepath"
+	"strconv"
 	"strings"
 	"sync"
 	"time"
@@ -14,18 +16,23 @@ import (
 	"google.golang.org/grpc"
 
 	"github.com/Sirupsen/logrus"
+	"github.com/docker/docker/api/types/backend"
 	"github.com/docker/docker/daemon/cluster/convert"
 	executorpkg "github.com/docker/docker/daemon/cluster/executor"
 	"github.com/docker/docker/daemon/cluster/executor/container"
 	"github.com/docker/docker/errors"
 	"github.com/docker/docker/opts"
 	"github.com/docker/docker/pkg/ioutils"
+	"github.com/docker/docker/pkg/stdcopy"
+	"github.com/docker/docker/pkg/stringid"
 	"github.com/docker/docker/runconfig"
 	apitypes "github.com/docker/engine-api/types"
 	"github.com/docker/engine-api/types/filters"
 	types "github.com/docker/engine-api/types/swarm"
+	timetypes "github.com/docker/engine-api/types/time"
 	swarmagent "github.com/docker/swarmkit/agent"
 	swarmapi "github.com/docker/swarmkit/api"
+	"github.com/docker/swarmkit/protobuf/ptypes"
 	"golang.org/x/net/context"
 )
 
@@ -368,17 +375,51 @@ func (c *Cluster) Init(req types.InitRequest) (string, error) {
 		return "", err
 	}
 
-	listenHost, listenPort, err := resolveListenAddr(req.ListenAddr)
+	localAddr, listenAddr, advertiseAddr, err := c.resolveNewClusterAddrs(req.ListenAddr, req.AdvertiseAddr)
 	if err != nil {
 		c.Unlock()
 		return "", err
 	}
 
-	advertiseHost, advertisePort, err := c.resolveAdvertiseAddr(req.AdvertiseAddr, listenPort)
+	// todo: check current state existing
+	n, err := c.startNewNode(req.ForceNewCluster, localAddr, "", listenAddr, advertiseAddr, "", "")
 	if err != nil {
 		c.Unlock()
 		return "", err
 	}
+	c.Unlock()
+
+	select {
+	case <-n.Ready():
+		if err := initClusterSpec(n, req.Spec); err != nil {
+			return "", err
+		}
+		go c.reconnectOnFailure(n)
+		return n.NodeID(), nil
+	case <-n.done:
+		c.RLock()
+		defer c.RUnlock()
+		if !req.ForceNewCluster { // if failure on first attempt don't keep state
+			if err := c.clearState(); err != nil {
+				return "", err
+			}
+		}
+		return "", c.err
+	}
+}
+
+// resolveNewClusterAddrs resolves the local, listen and advertise addresses
+// of the first manager of a new cluster.
+func (c *Cluster) resolveNewClusterAddrs(listenAddr, advertiseAddr string) (string, string, string, error) {
+	listenHost, listenPort, err := resolveListenAddr(listenAddr)
+	if err != nil {
+		return "", "", "", err
+	}
+
+	advertiseHost, advertisePort, err := c.resolveAdvertiseAddr(advertiseAddr, listenPort)
+	if err != nil {
+		return "", "", "", err
+	}
 
 	localAddr := listenHost
 
@@ -389,8 +430,7 @@ func (c *Cluster) Init(req types.InitRequest) (string, error) {
 		advertiseIP := net.ParseIP(advertiseHost)
 		if advertiseIP == nil {
 			// not an IP
-			c.Unlock()
-			return "", errMustSpecifyListenAddr
+			return "", "", "", errMustSpecifyListenAddr
 		}
 
 		systemIPs := listSystemIPs()
@@ -403,37 +443,12 @@ func (c *Cluster) Init(req types.InitRequest) (string, error) {
 			}
 		}
 		if !found {
-			c.Unlock()
-			return "", errMustSpecifyListenAddr
+			return "", "", "", errMustSpecifyListenAddr
 		}
 		localAddr = advertiseIP.String()
 	}
 
-	// todo: check current state existing
-	n, err := c.startNewNode(req.ForceNewCluster, localAddr, "", net.JoinHostPort(listenHost, listenPort), net.JoinHostPort(advertiseHost, advertisePort), "", "")
-	if err != nil {
-		c.Unlock()
-		return "", err
-	}
-	c.Unlock()
-
-	select {
-	case <-n.Ready():
-		if err := initClusterSpec(n, req.Spec); err != nil {
-			return "", err
-		}
-		go c.reconnectOnFailure(n)
-		return n.NodeID(), nil
-	case <-n.done:
-		c.RLock()
-		defer c.RUnlock()
-		if !req.ForceNewCluster { // if failure on first attempt don't keep state
-			if err := c.clearState(); err != nil {
-				return "", err
-			}
-		}
-		return "", c.err
-	}
+	return localAddr, net.JoinHostPort(listenHost, listenPort), net.JoinHostPort(advertiseHost, advertisePort), nil
 }
 
 // Join makes current Cluster part of an existing swarm cluster.
@@ -832,6 +847,10 @@ func (c *Cluster) CreateService(s types.ServiceSpec, encodedAuth string) (string
 		return "", err
 	}
 
+	if err := populateSecretID(ctx, c.client, &s); err != nil {
+		return "", err
+	}
+
 	serviceSpec, err := convert.ServiceSpecToGRPC(s)
 	if err != nil {
 		return "", err
@@ -873,7 +892,7 @@ func (c *Cluster) GetService(input string) (types.Service, error) {
 }
 
 // UpdateService updates existing service to match new properties.
-func (c *Cluster) UpdateService(serviceID string, version uint64, spec types.ServiceSpec, encodedAuth string) error {
+func (c *Cluster) UpdateService(serviceID string, version uint64, spec types.ServiceSpec, encodedAuth string, rollback string) error {
 	c.RLock()
 	defer c.RUnlock()
 
@@ -889,6 +908,10 @@ func (c *Cluster) UpdateService(serviceID string, version uint64, spec types.Ser
 		return err
 	}
 
+	if err := populateSecretID(ctx, c.client, &spec); err != nil {
+		return err
+	}
+
 	serviceSpec, err := convert.ServiceSpecToGRPC(spec)
 	if err != nil {
 		return err
@@ -914,6 +937,16 @@ func (c *Cluster) UpdateService(serviceID string, version uint64, spec types.Ser
 		serviceSpec.Task.GetContainer().PullOptions = ctnr.PullOptions
 	}
 
+	var rollbackRequest swarmapi.UpdateServiceRequest_Rollback
+	switch rollback {
+	case "", "none":
+		rollbackRequest = swarmapi.UpdateServiceRequest_NONE
+	case "previous":
+		rollbackRequest = swarmapi.UpdateServiceRequest_PREVIOUS
+	default:
+		return fmt.Errorf("unrecognized rollback option %s", rollback)
+	}
+
 	_, err = c.client.UpdateService(
 		ctx,
 		&swarmapi.UpdateServiceRequest{
@@ -922,6 +955,7 @@ func (c *Cluster) UpdateService(serviceID string, version uint64, spec types.Ser
 			ServiceVersion: &swarmapi.Version{
 				Index: version,
 			},
+			Rollback: rollbackRequest,
 		},
 	)
 	return err
@@ -950,6 +984,121 @@ func (c *Cluster) RemoveService(input string) error {
 	return nil
 }
 
+// ServiceLogs streams the logs of the tasks of a service. Each line of logs is
+// prefixed by the task and the node it comes from.
+func (c *Cluster) ServiceLogs(ctx context.Context, input string, config *backend.ContainerLogsConfig, started chan struct{}) error {
+	// The lock isn't held while streaming, which can last until the
+	// request is cancelled.
+	c.RLock()
+	if !c.isActiveManager() {
+		c.RUnlock()
+		return c.errNoManager()
+	}
+	conn, client := c.conn, c.client
+	c.RUnlock()
+
+	service, err := getService(ctx, client, input)
+	if err != nil {
+		return err
+	}
+
+	options := &swarmapi.LogSubscriptionOptions{
+		Follow: config.Follow,
+	}
+	if config.ShowStdout {
+		options.Streams = append(options.Streams, swarmapi.LogStreamStdout)
+	}
+	if config.ShowStderr {
+		options.Streams = append(options.Streams, swarmapi.LogStreamStderr)
+	}
+	if config.Tail != "" && config.Tail != "all" {
+		tail, err := strconv.ParseInt(config.Tail, 10, 64)
+		if err != nil || tail < 0 {
+			return fmt.Errorf("invalid tail value: %s", config.Tail)
+		}
+		// The last n messages are asked with a tail of -n-1.
+		options.Tail = -tail - 1
+	}
+	if config.Since != "" {
+		s, n, err := timetypes.ParseTimestamps(config.Since, 0)
+		if err != nil {
+			return err
+		}
+		if options.Since, err = ptypes.TimestampProto(time.Unix(s, n)); err != nil {
+			return err
+		}
+	}
+
+	stream, err := swarmapi.NewLogsClient(conn).SubscribeLogs(ctx, &swarmapi.SubscribeLogsRequest{
+		Selector: &swarmapi.LogSelector{ServiceIDs: []string{service.ID}},
+		Options:  options,
+	})
+	if err != nil {
+		return err
+	}
+
+	wf := ioutils.NewWriteFlusher(config.OutStream)
+	defer wf.Close()
+	close(started)
+	wf.Flush()
+
+	outStream := stdcopy.NewStdWriter(wf, stdcopy.Stdout)
+	errStream := stdcopy.NewStdWriter(wf, stdcopy.Stderr)
+
+	prefixes := make(map[string]string)
+	for {
+		resp, err := stream.Recv()
+		if err == io.EOF || ctx.Err() != nil {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		for _, msg := range resp.Messages {
+			if msg.Context == nil {
+				continue
+			}
+			prefix, ok := prefixes[msg.Context.TaskID]
+			if !ok {
+				prefix = taskLogPrefix(ctx, client, service, msg.Context)
+				prefixes[msg.Context.TaskID] = prefix
+			}
+
+			line := []byte(prefix + " | ")
+			if config.Timestamps && msg.Timestamp != nil {
+				if ts, err := ptypes.Timestamp(msg.Timestamp); err == nil {
+					line = append(line, ts.Format(time.RFC3339Nano)+" "...)
+				}
+			}
+			line = append(line, msg.Data...)
+
+			switch msg.Stream {
+			case swarmapi.LogStreamStdout:
+				outStream.Write(line)
+			case swarmapi.LogStreamStderr:
+				errStream.Write(line)
+			}
+		}
+	}
+}
+
+// taskLogPrefix returns the prefix of the log lines of a task, made of the
+// name of the task and the hostname of its node, falling back on their IDs.
+func taskLogPrefix(ctx context.Context, client swarmapi.ControlClient, service *swarmapi.Service, logContext *swarmapi.LogContext) string {
+	taskName := service.Spec.Annotations.Name + "." + stringid.TruncateID(logContext.TaskID)
+	if r, err := client.GetTask(ctx, &swarmapi.GetTaskRequest{TaskID: logContext.TaskID}); err == nil && r.Task.Slot != 0 {
+		taskName = fmt.Sprintf("%s.%d.%s", service.Spec.Annotations.Name, r.Task.Slot, stringid.TruncateID(logContext.TaskID))
+	}
+
+	nodeName := stringid.TruncateID(logContext.NodeID)
+	if r, err := client.GetNode(ctx, &swarmapi.GetNodeRequest{NodeID: logContext.NodeID}); err == nil && r.Node.Description != nil {
+		nodeName = r.Node.Description.Hostname
+	}
+
+	return taskName + "@" + nodeName
+}
+
 // GetNodes returns a list of all nodes known to a cluster.
 func (c *Cluster) GetNodes(options apitypes.NodeListOptions) ([]types.Node, error) {
 	c.RLock()
@@ -1267,6 +1416,117 @@ func getNetwork(ctx context.Context, c swarmapi.ControlClient, input string) (*s
 	return rg.Network, nil
 }
 
+// GetSecrets returns all secrets of a cluster, without their data.
+func (c *Cluster) GetSecrets(options apitypes.SecretListOptions) ([]types.Secret, error) {
+	c.RLock()
+	defer c.RUnlock()
+
+	if !c.isActiveManager() {
+		return nil, c.errNoManager()
+	}
+
+	filters, err := newListSecretsFilters(options.Filter)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := c.getRequestContext()
+	defer cancel()
+
+	r, err := c.client.ListSecrets(ctx, &swarmapi.ListSecretsRequest{Filters: filters})
+	if err != nil {
+		return nil, err
+	}
+
+	secrets := []types.Secret{}
+
+	for _, secret := range r.Secrets {
+		secrets = append(secrets, convert.SecretFromGRPC(secret))
+	}
+	return secrets, nil
+}
+
+// GetSecret returns a secret based on an ID or name, without its data.
+func (c *Cluster) GetSecret(input string) (types.Secret, error) {
+	c.RLock()
+	defer c.RUnlock()
+
+	if !c.isActiveManager() {
+		return types.Secret{}, c.errNoManager()
+	}
+
+	ctx, cancel := c.getRequestContext()
+	defer cancel()
+
+	secret, err := getSecret(ctx, c.client, input)
+	if err != nil {
+		return types.Secret{}, err
+	}
+	return convert.SecretFromGRPC(secret), nil
+}
+
+// CreateSecret creates a new secret in a cluster and returns its ID.
+func (c *Cluster) CreateSecret(s types.SecretSpec) (string, error) {
+	c.RLock()
+	defer c.RUnlock()
+
+	if !c.isActiveManager() {
+		return "", c.errNoManager()
+	}
+
+	ctx, cancel := c.getRequestContext()
+	defer cancel()
+
+	secretSpec := convert.SecretSpecToGRPC(s)
+	r, err := c.client.CreateSecret(ctx, &swarmapi.CreateSecretRequest{Spec: &secretSpec})
+	if err != nil {
+		return "", err
+	}
+
+	return r.Secret.ID, nil
+}
+
+// RemoveSecret removes a secret from a cluster.
+func (c *Cluster) RemoveSecret(input string) error {
+	c.RLock()
+	defer c.RUnlock()
+
+	if !c.isActiveManager() {
+		return c.errNoManager()
+	}
+
+	ctx, cancel := c.getRequestContext()
+	defer cancel()
+
+	secret, err := getSecret(ctx, c.client, input)
+	if err != nil {
+		return err
+	}
+
+	if _, err := c.client.RemoveSecret(ctx, &swarmapi.RemoveSecretRequest{SecretID: secret.ID}); err != nil {
+		return err
+	}
+	return nil
+}
+
+// populateSecretID resolves the secrets referenced by a service, by ID or
+// name, so that both are set on each reference.
+func populateSecretID(ctx context.Context, client swarmapi.ControlClient, s *types.ServiceSpec) error {
+	for _, ref := range s.TaskTemplate.ContainerSpec.Secrets {
+		input := ref.SecretID
+		if input == "" {
+			input = ref.SecretName
+		}
+		secret, err := getSecret(ctx, client, input)
+		if err != nil {
+			return err
+		}
+		ref.SecretID = secret.ID
+		ref.SecretName = secret.Spec.Annotations.Name
+	}
+	return nil
+}
+
 // Cleanup stops active swarm node. This is run before daemon shutdown.
 func (c *Cluster) Cleanup() {
 	c.Lock()
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"strings"
 
+	container "github.com/docker/engine-api/types/container"
 	types "github.com/docker/engine-api/types/swarm"
 	swarmapi "github.com/docker/swarmkit/api"
 	"github.com/docker/swarmkit/protobuf/ptypes"
@@ -54,6 +55,18 @@ func containerSpecFromGRPC(c *swarmapi.ContainerSpec) types.ContainerSpec {
 		grace, _ := ptypes.Duration(c.StopGracePeriod)
 		containerSpec.StopGracePeriod = &grace
 	}
+
+	if c.Healthcheck != nil {
+		containerSpec.Healthcheck = healthConfigFromGRPC(c.Healthcheck)
+	}
+
+	// Secrets
+	for _, s := range c.Secrets {
+		containerSpec.Secrets = append(containerSpec.Secrets, &types.SecretReference{
+			SecretID:   s.SecretID,
+			SecretName: s.SecretName,
+		})
+	}
 	return containerSpec
 }
 
@@ -72,6 +85,18 @@ func containerToGRPC(c types.ContainerSpec) (*swarmapi.ContainerSpec, error) {
 		containerSpec.StopGracePeriod = ptypes.DurationProto(*c.StopGracePeriod)
 	}
 
+	if c.Healthcheck != nil {
+		containerSpec.Healthcheck = healthConfigToGRPC(c.Healthcheck)
+	}
+
+	// Secrets
+	for _, s := range c.Secrets {
+		containerSpec.Secrets = append(containerSpec.Secrets, &swarmapi.SecretReference{
+			SecretID:   s.SecretID,
+			SecretName: s.SecretName,
+		})
+	}
+
 	// Mounts
 	for _, m := range c.Mounts {
 		mount := swarmapi.Mount{
@@ -114,3 +139,23 @@ func containerToGRPC(c types.ContainerSpec) (*swarmapi.ContainerSpec, error) {
 
 	return containerSpec, nil
 }
+
+func healthConfigFromGRPC(h *swarmapi.HealthConfig) *container.HealthConfig {
+	interval, _ := ptypes.Duration(h.Interval)
+	timeout, _ := ptypes.Duration(h.Timeout)
+	return &container.HealthConfig{
+		Test:     h.Test,
+		Interval: interval,
+		Timeout:  timeout,
+		Retries:  int(h.Retries),
+	}
+}
+
+func healthConfigToGRPC(h *container.HealthConfig) *swarmapi.HealthConfig {
+	return &swarmapi.HealthConfig{
+		Test:     h.Test,
+		Interval: ptypes.DurationProto(h.Interval),
+		Timeout:  ptypes.DurationProto(h.Timeout),
+		Retries:  int32(h.Retries),
+	}
+}
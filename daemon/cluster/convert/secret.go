@@ -0,0 +1,37 @@
+package convert
+
+import (
+	types "github.com/docker/engine-api/types/swarm"
+	swarmapi "github.com/docker/swarmkit/api"
+	"github.com/docker/swarmkit/protobuf/ptypes"
+)
+
+// SecretFromGRPC converts a grpc Secret to a Secret. The data of the secret
+// is never returned.
+func SecretFromGRPC(s *swarmapi.Secret) types.Secret {
+	secret := types.Secret{
+		ID: s.ID,
+	}
+
+	// Meta
+	secret.Version.Index = s.Meta.Version.Index
+	secret.CreatedAt, _ = ptypes.Timestamp(s.Meta.CreatedAt)
+	secret.UpdatedAt, _ = ptypes.Timestamp(s.Meta.UpdatedAt)
+
+	// Annotations
+	secret.Spec.Name = s.Spec.Annotations.Name
+	secret.Spec.Labels = s.Spec.Annotations.Labels
+
+	return secret
+}
+
+// SecretSpecToGRPC converts a SecretSpec to a grpc SecretSpec.
+func SecretSpecToGRPC(s types.SecretSpec) swarmapi.SecretSpec {
+	return swarmapi.SecretSpec{
+		Annotations: swarmapi.Annotations{
+			Name:   s.Name,
+			Labels: s.Labels,
+		},
+		Data: s.Data,
+	}
+}
@@ -5,12 +5,14 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"strconv"
 	"strings"
 	"syscall"
 	"time"
 
 	"github.com/Sirupsen/logrus"
 	"github.com/docker/docker/api/server/httputils"
+	"github.com/docker/docker/api/types/backend"
 	executorpkg "github.com/docker/docker/daemon/cluster/executor"
 	"github.com/docker/engine-api/types"
 	"github.com/docker/engine-api/types/events"
@@ -18,6 +20,7 @@ import (
 	"github.com/docker/libnetwork"
 	"github.com/docker/swarmkit/api"
 	"github.com/docker/swarmkit/log"
+	"github.com/docker/swarmkit/protobuf/ptypes"
 	"golang.org/x/net/context"
 )
 
@@ -27,9 +30,10 @@ import (
 type containerAdapter struct {
 	backend   executorpkg.Backend
 	container *containerConfig
+	secrets   *secrets
 }
 
-func newContainerAdapter(b executorpkg.Backend, task *api.Task) (*containerAdapter, error) {
+func newContainerAdapter(b executorpkg.Backend, task *api.Task, secrets *secrets) (*containerAdapter, error) {
 	ctnr, err := newContainerConfig(task)
 	if err != nil {
 		return nil, err
@@ -38,6 +42,7 @@ func newContainerAdapter(b executorpkg.Backend, task *api.Task) (*containerAdapt
 	return &containerAdapter{
 		container: ctnr,
 		backend:   b,
+		secrets:   secrets,
 	}, nil
 }
 
@@ -132,6 +137,14 @@ func (c *containerAdapter) create(ctx context.Context, backend executorpkg.Backe
 		return err
 	}
 
+	secrets, err := c.container.secrets(c.secrets)
+	if err != nil {
+		return err
+	}
+	if err := backend.SetContainerSecrets(cr.ID, secrets); err != nil {
+		return err
+	}
+
 	// Docker daemon currently doesn't support multiple networks in container create
 	// Connect to all other networks
 	nc := c.container.connectNetworkingConfig()
@@ -229,6 +242,51 @@ func (c *containerAdapter) remove(ctx context.Context) error {
 	})
 }
 
+// logs returns a reader of the logs of the container, multiplexed as written
+// by the logs API and prefixed by their timestamp. The stream of logs can be
+// shutdown by cancelling the context.
+func (c *containerAdapter) logs(ctx context.Context, options api.LogSubscriptionOptions) (io.ReadCloser, error) {
+	config := &backend.ContainerLogsConfig{
+		ContainerLogsOptions: types.ContainerLogsOptions{
+			Follow:     options.Follow,
+			Timestamps: true,
+			Tail:       "all",
+		},
+	}
+
+	// A negative tail sends the last -n-1 messages.
+	if options.Tail < 0 {
+		config.Tail = strconv.FormatInt(-options.Tail-1, 10)
+	}
+
+	if options.Since != nil {
+		since, err := ptypes.Timestamp(options.Since)
+		if err != nil {
+			return nil, err
+		}
+		config.Since = fmt.Sprintf("%d.%09d", since.Unix(), since.Nanosecond())
+	}
+
+	if len(options.Streams) == 0 {
+		config.ShowStdout, config.ShowStderr = true, true
+	}
+	for _, stream := range options.Streams {
+		switch stream {
+		case api.LogStreamStdout:
+			config.ShowStdout = true
+		case api.LogStreamStderr:
+			config.ShowStderr = true
+		}
+	}
+
+	pr, pw := io.Pipe()
+	config.OutStream = pw
+	go func() {
+		pw.CloseWithError(c.backend.ContainerLogs(ctx, c.container.name(), config, make(chan struct{})))
+	}()
+	return pr, nil
+}
+
 func (c *containerAdapter) createVolumes(ctx context.Context, backend executorpkg.Backend) error {
 	// Create plugin volumes that are embedded inside a Mount
 	for _, mount := range c.container.task.Spec.GetContainer().Mounts {
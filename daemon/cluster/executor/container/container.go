@@ -9,6 +9,7 @@ import (
 
 	"github.com/Sirupsen/logrus"
 
+	containerpkg "github.com/docker/docker/container"
 	clustertypes "github.com/docker/docker/daemon/cluster/provider"
 	"github.com/docker/docker/reference"
 	"github.com/docker/engine-api/types"
@@ -18,6 +19,7 @@ import (
 	"github.com/docker/engine-api/types/network"
 	"github.com/docker/swarmkit/agent/exec"
 	"github.com/docker/swarmkit/api"
+	"github.com/docker/swarmkit/protobuf/ptypes"
 )
 
 const (
@@ -96,12 +98,13 @@ func (c *containerConfig) image() string {
 
 func (c *containerConfig) config() *enginecontainer.Config {
 	config := &enginecontainer.Config{
-		Labels:     c.labels(),
-		User:       c.spec().User,
-		Env:        c.spec().Env,
-		WorkingDir: c.spec().Dir,
-		Image:      c.image(),
-		Volumes:    c.volumes(),
+		Labels:      c.labels(),
+		User:        c.spec().User,
+		Env:         c.spec().Env,
+		WorkingDir:  c.spec().Dir,
+		Image:       c.image(),
+		Volumes:     c.volumes(),
+		Healthcheck: c.healthcheck(),
 	}
 
 	if len(c.spec().Command) > 0 {
@@ -119,6 +122,40 @@ func (c *containerConfig) config() *enginecontainer.Config {
 	return config
 }
 
+// healthcheck returns the healthcheck of the spec, overriding the one of the
+// image, or nil if the spec doesn't have one.
+func (c *containerConfig) healthcheck() *enginecontainer.HealthConfig {
+	hc := c.spec().Healthcheck
+	if hc == nil {
+		return nil
+	}
+	interval, _ := ptypes.Duration(hc.Interval)
+	timeout, _ := ptypes.Duration(hc.Timeout)
+	return &enginecontainer.HealthConfig{
+		Test:     hc.Test,
+		Interval: interval,
+		Timeout:  timeout,
+		Retries:  int(hc.Retries),
+	}
+}
+
+// secrets returns the secrets referenced by the spec, with their data looked
+// up in the secrets known to the node.
+func (c *containerConfig) secrets(known *secrets) ([]*containerpkg.Secret, error) {
+	var list []*containerpkg.Secret
+	for _, ref := range c.spec().Secrets {
+		secret := known.get(ref.SecretID)
+		if secret == nil {
+			return nil, fmt.Errorf("secret %s not found on node", ref.SecretName)
+		}
+		list = append(list, &containerpkg.Secret{
+			Name: ref.SecretName,
+			Data: secret.Spec.Data,
+		})
+	}
+	return list, nil
+}
+
 func (c *containerConfig) labels() map[string]string {
 	var (
 		system = map[string]string{
@@ -5,6 +5,7 @@ import (
 	"os"
 
 	executorpkg "github.com/docker/docker/daemon/cluster/executor"
+	"github.com/docker/docker/pkg/stdcopy"
 	"github.com/docker/engine-api/types"
 	"github.com/docker/engine-api/types/events"
 	"github.com/docker/libnetwork"
@@ -32,10 +33,11 @@ type controller struct {
 }
 
 var _ exec.Controller = &controller{}
+var _ exec.ControllerLogs = &controller{}
 
 // NewController returns a dockerexec runner for the provided task.
-func newController(b executorpkg.Backend, task *api.Task) (*controller, error) {
-	adapter, err := newContainerAdapter(b, task)
+func newController(b executorpkg.Backend, task *api.Task, secrets *secrets) (*controller, error) {
+	adapter, err := newContainerAdapter(b, task, secrets)
 	if err != nil {
 		return nil, err
 	}
@@ -362,6 +364,39 @@ func (r *controller) Remove(ctx context.Context) error {
 	return nil
 }
 
+// Logs publishes the logs of the container matching options, until they are
+// all sent or ctx is cancelled.
+func (r *controller) Logs(ctx context.Context, publisher exec.LogPublisher, options api.LogSubscriptionOptions) error {
+	if err := r.checkClosed(); err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	logs, err := r.adapter.logs(ctx, options)
+	if err != nil {
+		return err
+	}
+	defer logs.Close()
+
+	logContext := api.LogContext{
+		ServiceID: r.task.ServiceID,
+		NodeID:    r.task.NodeID,
+		TaskID:    r.task.ID,
+	}
+	stdout := newLogWriter(ctx, publisher, logContext, api.LogStreamStdout)
+	stderr := newLogWriter(ctx, publisher, logContext, api.LogStreamStderr)
+	if _, err := stdcopy.StdCopy(stdout, stderr, logs); err != nil {
+		if isUnknownContainer(err) {
+			// The container was never created, or is already removed.
+			return nil
+		}
+		return err
+	}
+	return nil
+}
+
 // Close the runner and clean up any ephemeral resources.
 func (r *controller) Close() error {
 	select {
@@ -16,12 +16,14 @@ import (
 
 type executor struct {
 	backend executorpkg.Backend
+	secrets *secrets
 }
 
 // NewExecutor returns an executor from the docker client.
 func NewExecutor(b executorpkg.Backend) exec.Executor {
 	return &executor{
 		backend: b,
+		secrets: &secrets{},
 	}
 }
 
@@ -121,7 +123,7 @@ func (e *executor) Configure(ctx context.Context, node *api.Node) error {
 
 // Controller returns a docker container runner.
 func (e *executor) Controller(t *api.Task) (exec.Controller, error) {
-	ctlr, err := newController(e.backend, t)
+	ctlr, err := newController(e.backend, t, e.secrets)
 	if err != nil {
 		return nil, err
 	}
@@ -146,6 +148,13 @@ func (e *executor) SetNetworkBootstrapKeys(keys []*api.EncryptionKey) error {
 	return nil
 }
 
+// SetSecrets keeps the secrets referenced by the tasks of the node in memory,
+// until the controllers of the tasks create their containers.
+func (e *executor) SetSecrets(secrets []*api.Secret) error {
+	e.secrets.set(secrets)
+	return nil
+}
+
 type sortedPlugins []api.PluginDescription
 
 func (sp sortedPlugins) Len() int { return len(sp) }
@@ -0,0 +1,75 @@
+package container
+
+import (
+	"bytes"
+	"fmt"
+	"time"
+
+	"github.com/docker/swarmkit/agent/exec"
+	"github.com/docker/swarmkit/api"
+	"github.com/docker/swarmkit/protobuf/ptypes"
+	"golang.org/x/net/context"
+)
+
+// logWriter publishes the lines of logs written to it as log messages of one
+// stream of a task. Each line must start with its timestamp, as written by the
+// logs API.
+type logWriter struct {
+	ctx        context.Context
+	publisher  exec.LogPublisher
+	logContext api.LogContext
+	stream     api.LogStream
+	buf        []byte // incomplete line
+}
+
+func newLogWriter(ctx context.Context, publisher exec.LogPublisher, logContext api.LogContext, stream api.LogStream) *logWriter {
+	return &logWriter{
+		ctx:        ctx,
+		publisher:  publisher,
+		logContext: logContext,
+		stream:     stream,
+	}
+}
+
+func (w *logWriter) Write(p []byte) (int, error) {
+	w.buf = append(w.buf, p...)
+	for {
+		i := bytes.IndexByte(w.buf, '\n')
+		if i < 0 {
+			return len(p), nil
+		}
+		line := w.buf[:i+1]
+		w.buf = w.buf[i+1:]
+
+		ts, data, err := parseLogLine(line)
+		if err != nil {
+			return 0, err
+		}
+		timestamp, err := ptypes.TimestampProto(ts)
+		if err != nil {
+			return 0, err
+		}
+		logContext := w.logContext
+		if err := w.publisher.Publish(w.ctx, &api.LogMessage{
+			Context:   &logContext,
+			Timestamp: timestamp,
+			Stream:    w.stream,
+			Data:      data,
+		}); err != nil {
+			return 0, err
+		}
+	}
+}
+
+// parseLogLine splits a line of logs into its timestamp and its data.
+func parseLogLine(line []byte) (time.Time, []byte, error) {
+	i := bytes.IndexByte(line, ' ')
+	if i < 0 {
+		return time.Time{}, nil, fmt.Errorf("log line without timestamp: %q", line)
+	}
+	ts, err := time.Parse(time.RFC3339Nano, string(line[:i]))
+	if err != nil {
+		return time.Time{}, nil, err
+	}
+	return ts, append([]byte(nil), line[i+1:]...), nil
+}
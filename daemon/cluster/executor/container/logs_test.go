@@ -0,0 +1,68 @@
+package container
+
+import (
+	"testing"
+	"time"
+
+	"github.com/docker/swarmkit/agent/exec"
+	"github.com/docker/swarmkit/api"
+	"github.com/docker/swarmkit/protobuf/ptypes"
+	"golang.org/x/net/context"
+)
+
+func TestParseLogLine(t *testing.T) {
+	ts, data, err := parseLogLine([]byte("2016-10-17T10:20:30.000000001Z hello world\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if expected := time.Date(2016, 10, 17, 10, 20, 30, 1, time.UTC); !ts.Equal(expected) {
+		t.Fatalf("expected timestamp %v, got %v", expected, ts)
+	}
+	if string(data) != "hello world\n" {
+		t.Fatalf("unexpected data %q", data)
+	}
+
+	if _, _, err := parseLogLine([]byte("hello\n")); err == nil {
+		t.Fatal("expected an error for a line without timestamp")
+	}
+	if _, _, err := parseLogLine([]byte("hello world\n")); err == nil {
+		t.Fatal("expected an error for a line with an invalid timestamp")
+	}
+}
+
+func TestLogWriter(t *testing.T) {
+	var messages []*api.LogMessage
+	publisher := exec.LogPublisherFunc(func(ctx context.Context, message *api.LogMessage) error {
+		messages = append(messages, message)
+		return nil
+	})
+	logContext := api.LogContext{ServiceID: "service", TaskID: "task"}
+	w := newLogWriter(context.Background(), publisher, logContext, api.LogStreamStderr)
+
+	// Lines can be split across writes.
+	for _, p := range []string{"2016-10-17T10:20:30Z one\n2016-10-17T10:20:31Z t", "wo\n2016-10-17T10:20:32Z three"} {
+		if _, err := w.Write([]byte(p)); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if len(messages) != 2 {
+		t.Fatalf("expected 2 messages, got %d", len(messages))
+	}
+	for i, expected := range []string{"one\n", "two\n"} {
+		m := messages[i]
+		if string(m.Data) != expected {
+			t.Fatalf("expected data %q, got %q", expected, m.Data)
+		}
+		if m.Stream != api.LogStreamStderr || *m.Context != logContext {
+			t.Fatalf("unexpected message %v", m)
+		}
+		ts, err := ptypes.Timestamp(m.Timestamp)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if expected := time.Date(2016, 10, 17, 10, 20, 30+i, 0, time.UTC); !ts.Equal(expected) {
+			t.Fatalf("expected timestamp %v, got %v", expected, ts)
+		}
+	}
+}
@@ -0,0 +1,37 @@
+package container
+
+import (
+	"sync"
+
+	"github.com/docker/swarmkit/api"
+)
+
+// secrets holds the secrets sent to the node along with its tasks. They are
+// only kept in memory.
+type secrets struct {
+	mu sync.RWMutex
+	m  map[string]*api.Secret
+}
+
+// set replaces the secrets.
+func (s *secrets) set(list []*api.Secret) {
+	m := make(map[string]*api.Secret, len(list))
+	for _, secret := range list {
+		m[secret.ID] = secret
+	}
+
+	s.mu.Lock()
+	s.m = m
+	s.mu.Unlock()
+}
+
+// get returns the secret with the given ID, or nil if it isn't known.
+func (s *secrets) get(id string) *api.Secret {
+	if s == nil {
+		return nil
+	}
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.m[id]
+}
@@ -0,0 +1,45 @@
+package container
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/docker/swarmkit/api"
+)
+
+func TestContainerConfigSecrets(t *testing.T) {
+	known := &secrets{}
+	known.set([]*api.Secret{
+		{ID: "id1", Spec: api.SecretSpec{Annotations: api.Annotations{Name: "password"}, Data: []byte("s3cr3t")}},
+	})
+
+	config, err := newContainerConfig(&api.Task{
+		ID: "task",
+		Spec: api.TaskSpec{
+			Runtime: &api.TaskSpec_Container{
+				Container: &api.ContainerSpec{
+					Image: "image_name",
+					Secrets: []*api.SecretReference{
+						{SecretID: "id1", SecretName: "password"},
+					},
+				},
+			},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	list, err := config.secrets(known)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(list) != 1 || list[0].Name != "password" || string(list[0].Data) != "s3cr3t" {
+		t.Fatalf("unexpected secrets: %v", list)
+	}
+
+	config.spec().Secrets = append(config.spec().Secrets, &api.SecretReference{SecretID: "id2", SecretName: "unknown"})
+	if _, err := config.secrets(known); err == nil || !strings.Contains(err.Error(), "secret unknown not found on node") {
+		t.Fatalf("expected error, got: %v", err)
+	}
+}
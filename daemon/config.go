@@ -26,6 +26,13 @@ const (
 	// maximum number of uploads that
 	// may take place at a time for each push.
 	defaultMaxConcurrentUploads = 5
+	// defaultMaxDownloadChunks is the default value for the number
+	// of parallel range requests used to download a large layer.
+	defaultMaxDownloadChunks = 1
+	// defaultBlobCacheSize is the default maximum size, in megabytes, of
+	// the cache of pulled blobs. The cache is disabled by default, as the
+	// disk space it uses comes in addition to the extracted layers.
+	defaultBlobCacheSize = 0
 	// stockRuntimeName is the reserved name/alias used to represent the
 	// OCI runtime being shipped with the docker daemon package.
 	stockRuntimeName = "runc"
@@ -118,6 +125,34 @@ type CommonConfig struct {
 	// may take place at a time for each push.
 	MaxConcurrentUploads *int `json:"max-concurrent-uploads,omitempty"`
 
+	// MaxDownloadChunks is the number of parallel range requests used
+	// to download a large layer.
+	MaxDownloadChunks int `json:"max-download-chunks,omitempty"`
+
+	// BlobCacheSize is the maximum size, in megabytes, of the cache of
+	// pulled blobs. Zero disables the cache.
+	BlobCacheSize int64 `json:"blob-cache-size,omitempty"`
+
+	// LogMaxTotalSize is the maximum size, in megabytes, of the rotated
+	// json-file logs of all the containers. Zero disables the cap.
+	LogMaxTotalSize int64 `json:"log-max-total-size,omitempty"`
+
+	// PeerListen is the address the layers of this daemon are served on
+	// to the peers.
+	PeerListen string `json:"peer-listen,omitempty"`
+
+	// PeerDiscovery is the URL of the discovery backend listing the peers
+	// layers are fetched from before the registry.
+	PeerDiscovery string `json:"peer-discovery,omitempty"`
+
+	// PeerAdvertise is the address this daemon registers in PeerDiscovery,
+	// for the backends that support registration.
+	PeerAdvertise string `json:"peer-advertise,omitempty"`
+
+	// PeerSecretFile is the path of a file holding the secret shared by
+	// the peers to authenticate their requests.
+	PeerSecretFile string `json:"peer-secret-file,omitempty"`
+
 	Debug     bool     `json:"debug,omitempty"`
 	Hosts     []string `json:"hosts,omitempty"`
 	LogLevel  string   `json:"log-level,omitempty"`
@@ -167,12 +202,19 @@ func (config *Config) InstallCommonFlags(cmd *flag.FlagSet, usageFn func(string)
 	cmd.Var(opts.NewNamedListOptsRef("labels", &config.Labels, opts.ValidateLabel), []string{"-label"}, usageFn("Set key=value labels to the daemon"))
 	cmd.StringVar(&config.LogConfig.Type, []string{"-log-driver"}, "json-file", usageFn("Default driver for container logs"))
 	cmd.Var(opts.NewNamedMapOpts("log-opts", config.LogConfig.Config, nil), []string{"-log-opt"}, usageFn("Default log driver options for containers"))
+	cmd.Int64Var(&config.LogMaxTotalSize, []string{"-log-max-total-size"}, 0, usageFn("Set the max size in MB of the rotated json-file logs of all containers, 0 to disable it"))
 	cmd.StringVar(&config.ClusterAdvertise, []string{"-cluster-advertise"}, "", usageFn("Address or interface name to advertise"))
 	cmd.StringVar(&config.ClusterStore, []string{"-cluster-store"}, "", usageFn("URL of the distributed storage backend"))
 	cmd.Var(opts.NewNamedMapOpts("cluster-store-opts", config.ClusterOpts, nil), []string{"-cluster-store-opt"}, usageFn("Set cluster store options"))
 	cmd.StringVar(&config.CorsHeaders, []string{"-api-cors-header"}, "", usageFn("Set CORS headers in the remote API"))
 	cmd.IntVar(&maxConcurrentDownloads, []string{"-max-concurrent-downloads"}, defaultMaxConcurrentDownloads, usageFn("Set the max concurrent downloads for each pull"))
 	cmd.IntVar(&maxConcurrentUploads, []string{"-max-concurrent-uploads"}, defaultMaxConcurrentUploads, usageFn("Set the max concurrent uploads for each push"))
+	cmd.IntVar(&config.MaxDownloadChunks, []string{"-max-download-chunks"}, defaultMaxDownloadChunks, usageFn("Set the number of parallel range requests used to download a large layer"))
+	cmd.Int64Var(&config.BlobCacheSize, []string{"-blob-cache-size"}, defaultBlobCacheSize, usageFn("Set the max size in MB of the cache of pulled layers, 0 to disable it"))
+	cmd.StringVar(&config.PeerListen, []string{"-peer-listen"}, "", usageFn("Address to serve pulled layers to peers on"))
+	cmd.StringVar(&config.PeerDiscovery, []string{"-peer-discovery"}, "", usageFn("URL of the discovery backend listing the peers to fetch layers from"))
+	cmd.StringVar(&config.PeerAdvertise, []string{"-peer-advertise"}, "", usageFn("Address or interface name to register in the peer discovery backend"))
+	cmd.StringVar(&config.PeerSecretFile, []string{"-peer-secret-file"}, "", usageFn("Path to the secret shared by peers"))
 
 	cmd.StringVar(&config.SwarmDefaultAdvertiseAddr, []string{"-swarm-default-advertise-addr"}, "", usageFn("Set default address or interface for swarm advertised address"))
 
@@ -435,6 +477,29 @@ func ValidateConfiguration(config *Config) error {
 		return fmt.Errorf("invalid max concurrent uploads: %d", *config.MaxConcurrentUploads)
 	}
 
+	// validate MaxDownloadChunks
+	if config.MaxDownloadChunks < 0 {
+		return fmt.Errorf("invalid max download chunks: %d", config.MaxDownloadChunks)
+	}
+
+	// validate BlobCacheSize
+	if config.BlobCacheSize < 0 {
+		return fmt.Errorf("invalid blob cache size: %d", config.BlobCacheSize)
+	}
+
+	// validate LogMaxTotalSize
+	if config.LogMaxTotalSize < 0 {
+		return fmt.Errorf("invalid log max total size: %d", config.LogMaxTotalSize)
+	}
+
+	// validate peer layer sharing
+	if (config.PeerListen != "" || config.PeerDiscovery != "") && config.PeerSecretFile == "" {
+		return fmt.Errorf("--peer-secret-file is required to share layers with peers")
+	}
+	if config.PeerAdvertise != "" && config.PeerDiscovery == "" {
+		return fmt.Errorf("--peer-advertise requires --peer-discovery")
+	}
+
 	// validate that "default" runtime is not reset
 	if runtimes := config.GetAllRuntimes(); len(runtimes) > 0 {
 		if _, ok := runtimes[stockRuntimeName]; ok {
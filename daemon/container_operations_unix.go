@@ -4,6 +4,7 @@ package daemon
 
 import (
 	"fmt"
+	"io/ioutil"
 	"os"
 	"path/filepath"
 	"strconv"
@@ -233,6 +234,46 @@ func (daemon *Daemon) setupIpcDirs(c *container.Container) error {
 	return nil
 }
 
+// setupSecretsDir mounts a tmpfs holding the secrets of the container, so that
+// their data is never written to the disk of the node.
+func (daemon *Daemon) setupSecretsDir(c *container.Container) (err error) {
+	if len(c.Secrets) == 0 {
+		return nil
+	}
+
+	secretsPath, err := c.SecretsPath()
+	if err != nil {
+		return err
+	}
+	rootUID, rootGID := daemon.GetRemappedUIDGID()
+	if err := idtools.MkdirAllAs(secretsPath, 0700, rootUID, rootGID); err != nil {
+		return err
+	}
+	// The tmpfs may still be mounted if the container is restarted.
+	if err := detachMounted(secretsPath); err != nil && !os.IsNotExist(err) {
+		logrus.Debugf("failed to umount %s: %v", secretsPath, err)
+	}
+	if err := syscall.Mount("secrets", secretsPath, "tmpfs", uintptr(syscall.MS_NOEXEC|syscall.MS_NOSUID|syscall.MS_NODEV), label.FormatMountLabel("mode=0755", c.GetMountLabel())); err != nil {
+		return fmt.Errorf("mounting secrets tmpfs: %s", err)
+	}
+	defer func() {
+		if err != nil {
+			detachMounted(secretsPath)
+		}
+	}()
+
+	for _, s := range c.Secrets {
+		p := filepath.Join(secretsPath, s.Name)
+		if err := ioutil.WriteFile(p, s.Data, 0444); err != nil {
+			return fmt.Errorf("writing secret %s: %s", s.Name, err)
+		}
+		if err := os.Chown(p, rootUID, rootGID); err != nil {
+			return err
+		}
+	}
+	return os.Chown(secretsPath, rootUID, rootGID)
+}
+
 func (daemon *Daemon) mountVolumes(container *container.Container) error {
 	mounts, err := daemon.setupMounts(container)
 	if err != nil {
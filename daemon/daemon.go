@@ -26,12 +26,16 @@ import (
 	"github.com/docker/docker/container"
 	"github.com/docker/docker/daemon/events"
 	"github.com/docker/docker/daemon/exec"
+	"github.com/docker/docker/daemon/logger/loggerutils"
 	"github.com/docker/engine-api/types"
 	containertypes "github.com/docker/engine-api/types/container"
 	"github.com/docker/libnetwork/cluster"
 	// register graph drivers
 	_ "github.com/docker/docker/daemon/graphdriver/register"
+	"github.com/docker/docker/distribution"
+	"github.com/docker/docker/distribution/blobcache"
 	dmetadata "github.com/docker/docker/distribution/metadata"
+	"github.com/docker/docker/distribution/peer"
 	"github.com/docker/docker/distribution/xfer"
 	"github.com/docker/docker/image"
 	"github.com/docker/docker/layer"
@@ -76,6 +80,7 @@ type Daemon struct {
 	referenceStore            reference.Store
 	downloadManager           *xfer.LayerDownloadManager
 	uploadManager             *xfer.LayerUploadManager
+	blobCache                 *blobcache.Cache
 	distributionMetadataStore dmetadata.Store
 	trustKey                  libtrust.PrivateKey
 	idIndex                   *truncindex.TruncIndex
@@ -87,6 +92,9 @@ type Daemon struct {
 	netController             libnetwork.NetworkController
 	volumes                   *store.VolumeStore
 	discoveryWatcher          discoveryReloader
+	peerListener              net.Listener
+	peerAdvertiser            discoveryReloader
+	peers                     *peer.Peers
 	root                      string
 	seccompEnabled            bool
 	shutdown                  bool
@@ -478,6 +486,8 @@ func NewDaemon(config *Config, registryService registry.Service, containerdRemot
 	if err := idtools.MkdirAllAs(daemonRepo, 0700, rootUID, rootGID); err != nil && !os.IsExist(err) {
 		return nil, err
 	}
+	// The json-file logs of the containers are stored in their directory.
+	loggerutils.SetMaxTotalSize(daemonRepo, config.LogMaxTotalSize*1024*1024)
 
 	driverName := os.Getenv("DOCKER_DRIVER")
 	if driverName == "" {
@@ -508,6 +518,19 @@ func NewDaemon(config *Config, registryService registry.Service, containerdRemot
 	logrus.Debugf("Max Concurrent Uploads: %d", *config.MaxConcurrentUploads)
 	d.uploadManager = xfer.NewLayerUploadManager(*config.MaxConcurrentUploads)
 
+	// Partial layer downloads are kept across restarts so that pulls can
+	// resume them, but not forever.
+	if err := distribution.CleanupPartialDownloads(d.downloadDir(), distribution.PartialDownloadMaxAge); err != nil {
+		logrus.Warnf("Failed to clean up partial downloads: %v", err)
+	}
+
+	if config.BlobCacheSize > 0 {
+		d.blobCache, err = blobcache.New(filepath.Join(config.Root, "blobcache"), config.BlobCacheSize*1024*1024)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	ifs, err := image.NewFSStoreBackend(filepath.Join(imageRoot, "imagedb"))
 	if err != nil {
 		return nil, err
@@ -563,6 +586,10 @@ func NewDaemon(config *Config, registryService registry.Service, containerdRemot
 		return nil, err
 	}
 
+	if err := d.initPeers(config); err != nil {
+		return nil, err
+	}
+
 	sysInfo := sysinfo.New(false)
 	// Check if Devices cgroup is mounted, it is hard requirement for container security,
 	// on Linux.
@@ -661,6 +688,8 @@ func (daemon *Daemon) Shutdown() error {
 
 	pluginShutdown()
 
+	daemon.stopPeers()
+
 	if daemon.configStore.LiveRestore && daemon.containers != nil {
 		// check if there are any running containers, if none we should do some cleanup
 		if ls, err := daemon.Containers(&types.ContainerListOptions{}); len(ls) != 0 || err != nil {
@@ -912,6 +941,10 @@ func (daemon *Daemon) Reload(config *Config) error {
 		return err
 	}
 
+	if err = daemon.reloadRegistryConfig(config, attributes); err != nil {
+		return err
+	}
+
 	if config.IsValueSet("labels") {
 		daemon.configStore.Labels = config.Labels
 	}
@@ -970,7 +1003,49 @@ func (daemon *Daemon) Reload(config *Config) error {
 	}
 	attributes["max-concurrent-downloads"] = fmt.Sprintf("%d", *daemon.configStore.MaxConcurrentDownloads)
 	attributes["max-concurrent-uploads"] = fmt.Sprintf("%d", *daemon.configStore.MaxConcurrentUploads)
+	if daemon.configStore.Mirrors != nil {
+		mirrors, _ := json.Marshal(daemon.configStore.Mirrors)
+		attributes["registry-mirrors"] = string(mirrors)
+	} else {
+		attributes["registry-mirrors"] = "[]"
+	}
+	if daemon.configStore.InsecureRegistries != nil {
+		insecureRegistries, _ := json.Marshal(daemon.configStore.InsecureRegistries)
+		attributes["insecure-registries"] = string(insecureRegistries)
+	} else {
+		attributes["insecure-registries"] = "[]"
+	}
+
+	return nil
+}
+
+// reloadRegistryConfig replaces the registry mirrors and insecure registries
+// used by the registry service. Pulls and pushes in progress finish with the
+// previous configuration. The options that changed are listed in the
+// "registry-changed" attribute of the reload event.
+func (daemon *Daemon) reloadRegistryConfig(config *Config, attributes map[string]string) error {
+	if !config.IsValueSet("registry-mirrors") && !config.IsValueSet("insecure-registries") {
+		return nil
+	}
 
+	options := daemon.configStore.ServiceOptions
+	if config.IsValueSet("registry-mirrors") {
+		options.Mirrors = config.Mirrors
+	}
+	if config.IsValueSet("insecure-registries") {
+		options.InsecureRegistries = config.InsecureRegistries
+	}
+
+	changed, err := daemon.RegistryService.ReplaceConfig(options)
+	if err != nil {
+		return fmt.Errorf("failed to reload registry configuration: %v", err)
+	}
+	daemon.configStore.ServiceOptions = options
+
+	attributes["registry-changed"] = strings.Join(changed, ",")
+	if len(changed) > 0 {
+		logrus.Infof("Reloaded registry configuration, changed: %s", strings.Join(changed, ", "))
+	}
 	return nil
 }
 
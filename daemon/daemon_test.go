@@ -13,6 +13,7 @@ import (
 	_ "github.com/docker/docker/pkg/discovery/memory"
 	"github.com/docker/docker/pkg/registrar"
 	"github.com/docker/docker/pkg/truncindex"
+	"github.com/docker/docker/registry"
 	"github.com/docker/docker/volume"
 	volumedrivers "github.com/docker/docker/volume/drivers"
 	"github.com/docker/docker/volume/local"
@@ -364,6 +365,53 @@ func TestDaemonReloadNotAffectOthers(t *testing.T) {
 	}
 }
 
+func TestDaemonReloadRegistryConfig(t *testing.T) {
+	daemon := &Daemon{}
+	daemon.configStore = &Config{
+		CommonConfig: CommonConfig{
+			Labels: []string{"foo:bar"},
+			ServiceOptions: registry.ServiceOptions{
+				Mirrors: []string{"https://mirror-1.com"},
+				V2Only:  true,
+			},
+		},
+	}
+	daemon.RegistryService = registry.NewService(daemon.configStore.ServiceOptions)
+
+	valuesSets := make(map[string]interface{})
+	valuesSets["registry-mirrors"] = []string{"https://mirror-2.com"}
+	newConfig := &Config{
+		CommonConfig: CommonConfig{
+			ServiceOptions: registry.ServiceOptions{
+				Mirrors: []string{"https://mirror-2.com"},
+			},
+			valuesSet: valuesSets,
+		},
+	}
+
+	if err := daemon.Reload(newConfig); err != nil {
+		t.Fatal(err)
+	}
+	if mirrors := daemon.RegistryService.ServiceConfig().Mirrors; len(mirrors) != 1 || mirrors[0] != "https://mirror-2.com/" {
+		t.Fatalf("expected registry mirrors to be reloaded, got %v", mirrors)
+	}
+	if !daemon.configStore.V2Only {
+		t.Fatal("expected disable-legacy-registry to be kept")
+	}
+	if label := daemon.configStore.Labels[0]; label != "foo:bar" {
+		t.Fatalf("expected daemon label `foo:bar`, got %s", label)
+	}
+
+	valuesSets["registry-mirrors"] = []string{"ftp://mirror-3.com"}
+	newConfig.Mirrors = []string{"ftp://mirror-3.com"}
+	if err := daemon.Reload(newConfig); err == nil {
+		t.Fatal("expected an error reloading an invalid mirror")
+	}
+	if mirrors := daemon.RegistryService.ServiceConfig().Mirrors; mirrors[0] != "https://mirror-2.com/" {
+		t.Fatalf("expected registry mirrors to be kept after a failed reload, got %v", mirrors)
+	}
+}
+
 func TestDaemonDiscoveryReload(t *testing.T) {
 	daemon := &Daemon{}
 	daemon.configStore = &Config{
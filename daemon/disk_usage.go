@@ -0,0 +1,168 @@
+package daemon
+
+import (
+	"fmt"
+
+	"github.com/docker/docker/image"
+	"github.com/docker/docker/layer"
+	"github.com/docker/docker/pkg/directory"
+	"github.com/docker/docker/volume"
+	"github.com/docker/engine-api/types"
+)
+
+// SystemDiskUsage returns the disk space used by the image layers, the
+// writable layers of the containers, the local volumes and the build cache.
+func (daemon *Daemon) SystemDiskUsage() (*types.DiskUsage, error) {
+	containers, err := daemon.Containers(&types.ContainerListOptions{Size: true, All: true})
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve container list: %v", err)
+	}
+
+	images, err := daemon.Images("", "", false)
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve image list: %v", err)
+	}
+	// The intermediate images of builds are only listed with all.
+	allImages, err := daemon.Images("", "", true)
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve image list: %v", err)
+	}
+
+	var layersSize int64
+	allLayers := daemon.layerStore.Map()
+	diffSizes := make(map[layer.ChainID]int64, len(allLayers))
+	for chainID, l := range allLayers {
+		size, err := l.DiffSize()
+		if err != nil {
+			return nil, fmt.Errorf("failed to get size of layer %s: %v", chainID, err)
+		}
+		diffSizes[chainID] = size
+		layersSize += size
+	}
+
+	chains := make(map[string][]layer.ChainID, len(allImages))
+	for _, img := range allImages {
+		i, err := daemon.imageStore.Get(image.ID(img.ID))
+		if err != nil {
+			return nil, err
+		}
+		chains[img.ID] = layerChains(i.RootFS)
+	}
+	setImagesUsage(images, chains, diffSizes, containers)
+	buildCache, buildCacheSize := buildCacheUsage(allImages, chains, diffSizes, containers)
+
+	volumes, err := daemon.localVolumesUsage()
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.DiskUsage{
+		LayersSize:     layersSize,
+		Images:         images,
+		Containers:     containers,
+		Volumes:        volumes,
+		BuildCache:     buildCache,
+		BuildCacheSize: buildCacheSize,
+	}, nil
+}
+
+// layerChains returns the ChainID of every layer of rootFS, from the bottom
+// one.
+func layerChains(rootFS *image.RootFS) []layer.ChainID {
+	r := *rootFS
+	r.DiffIDs = nil
+	chains := make([]layer.ChainID, 0, len(rootFS.DiffIDs))
+	for _, diffID := range rootFS.DiffIDs {
+		r.Append(diffID)
+		chains = append(chains, r.ChainID())
+	}
+	return chains
+}
+
+// setImagesUsage sets the number of containers using each image and the size
+// of its layers also used by another image. chains are the layers of each
+// image and diffSizes the size of each layer.
+func setImagesUsage(images []*types.Image, chains map[string][]layer.ChainID, diffSizes map[layer.ChainID]int64, containers []*types.Container) {
+	refs := make(map[layer.ChainID]int)
+	for _, img := range images {
+		for _, chainID := range chains[img.ID] {
+			refs[chainID]++
+		}
+	}
+
+	for _, img := range images {
+		img.SharedSize = 0
+		for _, chainID := range chains[img.ID] {
+			if refs[chainID] > 1 {
+				img.SharedSize += diffSizes[chainID]
+			}
+		}
+		img.Containers = 0
+		for _, c := range containers {
+			if c.ImageID == img.ID {
+				img.Containers++
+			}
+		}
+	}
+}
+
+// buildCacheUsage returns the images left over by builds, that is the
+// untagged images, intermediate ones included, not used by any container. It
+// also returns the size of the layers used only by these images, which is
+// part of the size of the image layers.
+func buildCacheUsage(images []*types.Image, chains map[string][]layer.ChainID, diffSizes map[layer.ChainID]int64, containers []*types.Container) ([]*types.Image, int64) {
+	usedImages := make(map[string]bool, len(containers))
+	for _, c := range containers {
+		usedImages[c.ImageID] = true
+	}
+
+	var cache []*types.Image
+	usedLayers := make(map[layer.ChainID]bool)
+	for _, img := range images {
+		untagged := len(img.RepoTags) == 1 && img.RepoTags[0] == "<none>:<none>"
+		if untagged && !usedImages[img.ID] {
+			cache = append(cache, img)
+			continue
+		}
+		for _, chainID := range chains[img.ID] {
+			usedLayers[chainID] = true
+		}
+	}
+
+	var size int64
+	for _, img := range cache {
+		for _, chainID := range chains[img.ID] {
+			if !usedLayers[chainID] {
+				// Count each layer once.
+				usedLayers[chainID] = true
+				size += diffSizes[chainID]
+			}
+		}
+	}
+	return cache, size
+}
+
+// localVolumesUsage returns the volumes of the local driver, with the size of
+// their data and the number of containers using them.
+func (daemon *Daemon) localVolumesUsage() ([]*types.Volume, error) {
+	vols, err := daemon.volumes.FilterByDriver(volume.DefaultDriverName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve local volumes: %v", err)
+	}
+
+	volumes := make([]*types.Volume, 0, len(vols))
+	for _, v := range vols {
+		size, err := directory.Size(v.Path())
+		if err != nil {
+			return nil, fmt.Errorf("failed to get size of volume %s: %v", v.Name(), err)
+		}
+		tv := volumeToAPIType(v)
+		tv.Mountpoint = v.Path()
+		tv.UsageData = &types.VolumeUsageData{
+			Size:     size,
+			RefCount: int64(len(daemon.volumes.Refs(v))),
+		}
+		volumes = append(volumes, tv)
+	}
+	return volumes, nil
+}
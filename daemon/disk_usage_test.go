@@ -0,0 +1,64 @@
+package daemon
+
+import (
+	"testing"
+
+	"github.com/docker/docker/image"
+	"github.com/docker/docker/layer"
+	"github.com/docker/engine-api/types"
+)
+
+func TestSetImagesUsage(t *testing.T) {
+	base := layerChains(&image.RootFS{Type: "layers", DiffIDs: []layer.DiffID{"sha256:a"}})
+	child := layerChains(&image.RootFS{Type: "layers", DiffIDs: []layer.DiffID{"sha256:a", "sha256:b"}})
+	other := layerChains(&image.RootFS{Type: "layers", DiffIDs: []layer.DiffID{"sha256:c"}})
+	if len(child) != 2 || child[0] != base[0] {
+		t.Fatalf("expected the child to share the layer of its base, got %v and %v", base, child)
+	}
+
+	images := []*types.Image{{ID: "base"}, {ID: "child"}, {ID: "other"}}
+	chains := map[string][]layer.ChainID{"base": base, "child": child, "other": other}
+	diffSizes := map[layer.ChainID]int64{child[0]: 10, child[1]: 20, other[0]: 40}
+	containers := []*types.Container{{ImageID: "child"}, {ImageID: "child"}, {ImageID: "other"}}
+
+	setImagesUsage(images, chains, diffSizes, containers)
+
+	expected := []struct {
+		shared, containers int64
+	}{
+		{10, 0},
+		{10, 2},
+		{0, 1},
+	}
+	for i, img := range images {
+		if img.SharedSize != expected[i].shared || img.Containers != expected[i].containers {
+			t.Fatalf("expected %s to share %d bytes with %d containers, got %d bytes with %d containers", img.ID, expected[i].shared, expected[i].containers, img.SharedSize, img.Containers)
+		}
+	}
+}
+
+func TestBuildCacheUsage(t *testing.T) {
+	base := layerChains(&image.RootFS{Type: "layers", DiffIDs: []layer.DiffID{"sha256:a"}})
+	step := layerChains(&image.RootFS{Type: "layers", DiffIDs: []layer.DiffID{"sha256:a", "sha256:b"}})
+	stale := layerChains(&image.RootFS{Type: "layers", DiffIDs: []layer.DiffID{"sha256:a", "sha256:b", "sha256:c"}})
+	used := layerChains(&image.RootFS{Type: "layers", DiffIDs: []layer.DiffID{"sha256:d"}})
+
+	untagged := []string{"<none>:<none>"}
+	images := []*types.Image{
+		{ID: "base", RepoTags: []string{"base:latest"}},
+		{ID: "step", RepoTags: untagged},
+		{ID: "stale", RepoTags: untagged},
+		{ID: "used", RepoTags: untagged},
+	}
+	chains := map[string][]layer.ChainID{"base": base, "step": step, "stale": stale, "used": used}
+	diffSizes := map[layer.ChainID]int64{stale[0]: 10, stale[1]: 20, stale[2]: 40, used[0]: 80}
+	containers := []*types.Container{{ImageID: "used"}}
+
+	cache, size := buildCacheUsage(images, chains, diffSizes, containers)
+	if len(cache) != 2 || cache[0].ID != "step" || cache[1].ID != "stale" {
+		t.Fatalf("expected the untagged unused images in the build cache, got %v", cache)
+	}
+	if size != 60 {
+		t.Fatalf("expected a build cache of 60 bytes, got %d", size)
+	}
+}
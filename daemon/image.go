@@ -5,6 +5,8 @@ import (
 
 	"github.com/docker/docker/builder"
 	"github.com/docker/docker/image"
+	"github.com/docker/docker/layer"
+	"github.com/docker/docker/pkg/stringid"
 	"github.com/docker/docker/reference"
 	"github.com/docker/docker/runconfig"
 	containertypes "github.com/docker/engine-api/types/container"
@@ -72,6 +74,67 @@ func (daemon *Daemon) GetImageOnBuild(name string) (builder.Image, error) {
 	return img, nil
 }
 
+// MountImageOnBuild mounts the filesystem of the image with the given ID, so
+// that files can be copied out of it during a build. The returned function
+// unmounts it.
+func (daemon *Daemon) MountImageOnBuild(imageID string) (string, func() error, error) {
+	img, err := daemon.imageStore.Get(image.ID(imageID))
+	if err != nil {
+		return "", nil, err
+	}
+	rwLayer, err := daemon.layerStore.CreateRWLayer(stringid.GenerateNonCryptoID(), img.RootFS.ChainID(), "", nil, nil)
+	if err != nil {
+		return "", nil, err
+	}
+	release := func() error {
+		metadata, err := daemon.layerStore.ReleaseRWLayer(rwLayer)
+		layer.LogReleaseMetadata(metadata)
+		return err
+	}
+	root, err := rwLayer.Mount("")
+	if err != nil {
+		release()
+		return "", nil, err
+	}
+	return root, func() error {
+		if err := rwLayer.Unmount(); err != nil {
+			return err
+		}
+		return release()
+	}, nil
+}
+
+// LayerOnBuild returns the ID and size of the layer added by the image with
+// the given ID on top of parent, or an empty ID if it added none.
+func (daemon *Daemon) LayerOnBuild(id, parent string) (string, int64, error) {
+	img, err := daemon.imageStore.Get(image.ID(id))
+	if err != nil {
+		return "", 0, err
+	}
+	parentLayers := 0
+	if parent != "" {
+		parentImg, err := daemon.imageStore.Get(image.ID(parent))
+		if err != nil {
+			return "", 0, err
+		}
+		parentLayers = len(parentImg.RootFS.DiffIDs)
+	}
+	if len(img.RootFS.DiffIDs) <= parentLayers {
+		return "", 0, nil
+	}
+
+	l, err := daemon.layerStore.Get(img.RootFS.ChainID())
+	if err != nil {
+		return "", 0, err
+	}
+	defer layer.ReleaseAndLog(daemon.layerStore, l)
+	size, err := l.DiffSize()
+	if err != nil {
+		return "", 0, err
+	}
+	return l.DiffID().String(), size, nil
+}
+
 // GetCachedImage returns the most recent created image that is a child
 // of the image with imgID, that had the same config when it was
 // created. nil is returned if a child cannot be found. An error is
@@ -2,6 +2,7 @@ package daemon
 
 import (
 	"io"
+	"path/filepath"
 	"strings"
 
 	"github.com/docker/distribution/digest"
@@ -88,15 +89,18 @@ func (daemon *Daemon) pullImageWithReference(ctx context.Context, ref reference.
 	}()
 
 	imagePullConfig := &distribution.ImagePullConfig{
-		MetaHeaders:      metaHeaders,
-		AuthConfig:       authConfig,
-		ProgressOutput:   progress.ChanOutput(progressChan),
-		RegistryService:  daemon.RegistryService,
-		ImageEventLogger: daemon.LogImageEvent,
-		MetadataStore:    daemon.distributionMetadataStore,
-		ImageStore:       daemon.imageStore,
-		ReferenceStore:   daemon.referenceStore,
-		DownloadManager:  daemon.downloadManager,
+		MetaHeaders:       metaHeaders,
+		AuthConfig:        authConfig,
+		ProgressOutput:    progress.ChanOutput(progressChan),
+		RegistryService:   daemon.RegistryService,
+		ImageEventLogger:  daemon.LogImageEvent,
+		MetadataStore:     daemon.distributionMetadataStore,
+		ImageStore:        daemon.imageStore,
+		ReferenceStore:    daemon.referenceStore,
+		DownloadManager:   daemon.downloadManager,
+		DownloadDir:       daemon.downloadDir(),
+		MaxDownloadChunks: daemon.configStore.MaxDownloadChunks,
+		BlobCache:         daemon.blobCache,
 	}
 
 	err := distribution.Pull(ctx, ref, imagePullConfig)
@@ -104,3 +108,9 @@ func (daemon *Daemon) pullImageWithReference(ctx context.Context, ref reference.
 	<-writesDone
 	return err
 }
+
+// downloadDir returns the directory where partially downloaded layers are
+// kept between pulls.
+func (daemon *Daemon) downloadDir() string {
+	return filepath.Join(daemon.configStore.Root, "downloads")
+}
@@ -0,0 +1,138 @@
+package daemon
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"runtime"
+	"time"
+
+	"github.com/docker/docker/image"
+	"github.com/docker/docker/layer"
+	"github.com/docker/docker/pkg/archive"
+)
+
+// SquashImage creates an image with the layers that the image with the given
+// ID adds on top of parent merged into a single layer, and returns its ID.
+// The history of the image is kept, with the merged entries marked as empty
+// layers. An empty parent squashes every layer of the image.
+func (daemon *Daemon) SquashImage(id, parent string) (string, error) {
+	if runtime.GOOS == "windows" {
+		return "", fmt.Errorf("squashing images is not supported on Windows")
+	}
+
+	img, err := daemon.imageStore.Get(image.ID(id))
+	if err != nil {
+		return "", err
+	}
+
+	rootFS := image.NewRootFS()
+	var parentHistory int
+	if parent != "" {
+		parentImg, err := daemon.imageStore.Get(image.ID(parent))
+		if err != nil {
+			return "", err
+		}
+		if !isRootFSPrefix(parentImg.RootFS, img.RootFS) {
+			return "", fmt.Errorf("image %s is not built on top of %s", id, parent)
+		}
+		rootFS = &image.RootFS{}
+		*rootFS = *parentImg.RootFS
+		rootFS.DiffIDs = append([]layer.DiffID(nil), parentImg.RootFS.DiffIDs...)
+		parentHistory = len(parentImg.History)
+	}
+
+	newRoot, releaseNew, err := daemon.MountImageOnBuild(id)
+	if err != nil {
+		return "", err
+	}
+	defer releaseNew()
+
+	var oldRoot string
+	if parent != "" {
+		root, releaseOld, err := daemon.MountImageOnBuild(parent)
+		if err != nil {
+			return "", err
+		}
+		defer releaseOld()
+		oldRoot = root
+	} else {
+		// Diff against an empty directory, to include every file.
+		oldRoot, err = ioutil.TempDir("", "docker-squash-")
+		if err != nil {
+			return "", err
+		}
+		defer os.RemoveAll(oldRoot)
+	}
+
+	changes, err := archive.ChangesDirs(newRoot, oldRoot)
+	if err != nil {
+		return "", err
+	}
+	uidMaps, gidMaps := daemon.GetUIDGIDMaps()
+	arch, err := archive.ExportChanges(newRoot, changes, uidMaps, gidMaps)
+	if err != nil {
+		return "", err
+	}
+	defer arch.Close()
+
+	l, err := daemon.layerStore.Register(arch, rootFS.ChainID())
+	if err != nil {
+		return "", err
+	}
+	defer layer.ReleaseAndLog(daemon.layerStore, l)
+
+	newImage := *img
+	newImage.RootFS = rootFS
+	newImage.History = make([]image.History, 0, len(img.History)+1)
+	for i, h := range img.History {
+		if i >= parentHistory {
+			h.EmptyLayer = true
+		}
+		newImage.History = append(newImage.History, h)
+	}
+
+	now := time.Now().UTC()
+	h := image.History{
+		Created:    now,
+		Comment:    fmt.Sprintf("merge %s to %s", id, parent),
+		EmptyLayer: true,
+	}
+	if diffID := l.DiffID(); diffID != layer.DigestSHA256EmptyTar {
+		h.EmptyLayer = false
+		newImage.RootFS.Append(diffID)
+	}
+	newImage.History = append(newImage.History, h)
+	newImage.Created = now
+	newImage.Parent = ""
+
+	config, err := json.Marshal(&newImage)
+	if err != nil {
+		return "", err
+	}
+	newID, err := daemon.imageStore.Create(config)
+	if err != nil {
+		return "", err
+	}
+	if parent != "" {
+		if err := daemon.imageStore.SetParent(newID, image.ID(parent)); err != nil {
+			return "", err
+		}
+	}
+	return newID.String(), nil
+}
+
+// isRootFSPrefix returns whether the layers of parent are the first layers
+// of child.
+func isRootFSPrefix(parent, child *image.RootFS) bool {
+	if len(parent.DiffIDs) > len(child.DiffIDs) {
+		return false
+	}
+	for i, diffID := range parent.DiffIDs {
+		if child.DiffIDs[i] != diffID {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,230 @@
+package daemon
+
+import (
+	"archive/tar"
+	"bytes"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"runtime"
+	"sort"
+	"testing"
+
+	"github.com/docker/docker/daemon/graphdriver"
+	"github.com/docker/docker/daemon/graphdriver/vfs"
+	"github.com/docker/docker/image"
+	"github.com/docker/docker/layer"
+	"github.com/docker/docker/pkg/archive"
+	containertypes "github.com/docker/engine-api/types/container"
+)
+
+func TestIsRootFSPrefix(t *testing.T) {
+	rootFS := func(diffIDs ...layer.DiffID) *image.RootFS {
+		r := image.NewRootFS()
+		for _, diffID := range diffIDs {
+			r.Append(diffID)
+		}
+		return r
+	}
+
+	for _, tc := range []struct {
+		parent, child *image.RootFS
+		expected      bool
+	}{
+		{rootFS(), rootFS(), true},
+		{rootFS(), rootFS("sha256:a"), true},
+		{rootFS("sha256:a"), rootFS("sha256:a", "sha256:b"), true},
+		{rootFS("sha256:a", "sha256:b"), rootFS("sha256:a", "sha256:b"), true},
+		{rootFS("sha256:b"), rootFS("sha256:a", "sha256:b"), false},
+		{rootFS("sha256:a", "sha256:b"), rootFS("sha256:a"), false},
+	} {
+		if actual := isRootFSPrefix(tc.parent, tc.child); actual != tc.expected {
+			t.Fatalf("expected %v for %v and %v, got %v", tc.expected, tc.parent.DiffIDs, tc.child.DiffIDs, actual)
+		}
+	}
+}
+
+// squashTestLayer registers a layer with the given files on top of parent.
+// A file named .wh.<name> deletes <name> from the layers below.
+func squashTestLayer(t *testing.T, ls layer.Store, parent layer.ChainID, files ...string) layer.ChainID {
+	buf := &bytes.Buffer{}
+	tw := tar.NewWriter(buf)
+	for _, name := range files {
+		if err := tw.WriteHeader(&tar.Header{Name: name, Mode: 0644, Size: int64(len(name)), Typeflag: tar.TypeReg}); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := tw.Write([]byte(name)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := tw.Close(); err != nil {
+		t.Fatal(err)
+	}
+	l, err := ls.Register(buf, parent)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return l.ChainID()
+}
+
+func squashTestImage(t *testing.T, is image.Store, ls layer.Store, chainID layer.ChainID, history ...image.History) *image.Image {
+	l, err := ls.Get(chainID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer layer.ReleaseAndLog(ls, l)
+
+	rootFS := image.NewRootFS()
+	for p := l; p != nil; p = p.Parent() {
+		rootFS.DiffIDs = append([]layer.DiffID{p.DiffID()}, rootFS.DiffIDs...)
+	}
+	config, err := json.Marshal(&image.Image{
+		V1Image: image.V1Image{Config: &containertypes.Config{}},
+		RootFS:  rootFS,
+		History: history,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	id, err := is.Create(config)
+	if err != nil {
+		t.Fatal(err)
+	}
+	img, err := is.Get(id)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return img
+}
+
+// squashedFiles returns the files in the last layer of the image.
+func squashedFiles(t *testing.T, ls layer.Store, img *image.Image) []string {
+	l, err := ls.Get(img.RootFS.ChainID())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer layer.ReleaseAndLog(ls, l)
+	ts, err := l.TarStream()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer ts.Close()
+
+	var files []string
+	tr := tar.NewReader(ts)
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		if hdr.Typeflag != tar.TypeDir {
+			files = append(files, filepath.Clean(hdr.Name))
+		}
+	}
+	sort.Strings(files)
+	return files
+}
+
+func TestSquashImage(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("squashing images is not supported on Windows")
+	}
+	defer func(apply func(string, archive.Reader, *archive.TarOptions) (int64, error), copy func(string, string) error) {
+		graphdriver.ApplyUncompressedLayer = apply
+		vfs.CopyWithTar = copy
+	}(graphdriver.ApplyUncompressedLayer, vfs.CopyWithTar)
+	graphdriver.ApplyUncompressedLayer = archive.UnpackLayer
+	vfs.CopyWithTar = archive.CopyWithTar
+
+	root, err := ioutil.TempDir("", "docker-squash-test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(root)
+	ls, err := layer.NewStoreFromOptions(layer.StoreOptions{
+		StorePath:                 root,
+		MetadataStorePathTemplate: filepath.Join(root, "image", "%s", "layerdb"),
+		GraphDriver:               "vfs",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	fs, err := image.NewFSStoreBackend(filepath.Join(root, "imagedb"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	is, err := image.NewImageStore(fs, ls)
+	if err != nil {
+		t.Fatal(err)
+	}
+	daemon := &Daemon{imageStore: is, layerStore: ls}
+
+	// The base image has a and b. The image adds c and d, then deletes b
+	// and d.
+	baseChain := squashTestLayer(t, ls, "", "a", "b")
+	base := squashTestImage(t, is, ls, baseChain, image.History{CreatedBy: "ADD a b /"})
+	chain := squashTestLayer(t, ls, baseChain, "c", "d")
+	chain = squashTestLayer(t, ls, chain, ".wh.b", ".wh.d")
+	img := squashTestImage(t, is, ls, chain,
+		image.History{CreatedBy: "ADD a b /"},
+		image.History{CreatedBy: "ADD c d /"},
+		image.History{CreatedBy: "/bin/sh -c #(nop) ENV foo=bar", EmptyLayer: true},
+		image.History{CreatedBy: "/bin/sh -c rm b d"},
+	)
+
+	for _, tc := range []struct {
+		parent       *image.Image
+		layers       int
+		emptyHistory []bool
+		files        []string
+	}{
+		// Only the deletion of b, in the base image, is kept as a whiteout.
+		{base, 2, []bool{false, true, true, true, false}, []string{".wh.b", "c"}},
+		// Without a parent, nothing is deleted from the squashed layer.
+		{nil, 1, []bool{true, true, true, true, false}, []string{"a", "c"}},
+	} {
+		var parent string
+		if tc.parent != nil {
+			parent = tc.parent.ID().String()
+		}
+		id, err := daemon.SquashImage(img.ID().String(), parent)
+		if err != nil {
+			t.Fatal(err)
+		}
+		squashed, err := is.Get(image.ID(id))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if len(squashed.RootFS.DiffIDs) != tc.layers {
+			t.Fatalf("expected %d layers squashing on %q, got %v", tc.layers, parent, squashed.RootFS.DiffIDs)
+		}
+		if tc.parent != nil && squashed.RootFS.DiffIDs[0] != tc.parent.RootFS.DiffIDs[0] {
+			t.Fatalf("expected the layer of the parent to be kept, got %v", squashed.RootFS.DiffIDs)
+		}
+		var emptyHistory []bool
+		for _, h := range squashed.History {
+			emptyHistory = append(emptyHistory, h.EmptyLayer)
+		}
+		if !reflect.DeepEqual(emptyHistory, tc.emptyHistory) {
+			t.Fatalf("expected empty history entries %v squashing on %q, got %v", tc.emptyHistory, parent, emptyHistory)
+		}
+		if files := squashedFiles(t, ls, squashed); !reflect.DeepEqual(files, tc.files) {
+			t.Fatalf("expected squashed files %v on %q, got %v", tc.files, parent, files)
+		}
+
+		p, err := is.GetParent(squashed.ID())
+		if tc.parent != nil && (err != nil || p != tc.parent.ID()) {
+			t.Fatalf("expected %s as parent, got %s (%v)", tc.parent.ID(), p, err)
+		}
+		if tc.parent == nil && err == nil {
+			t.Fatalf("expected no parent, got %s", p)
+		}
+	}
+}
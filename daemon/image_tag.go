@@ -1,12 +1,17 @@
 package daemon
 
 import (
+	"fmt"
+
+	"github.com/docker/distribution/digest"
 	"github.com/docker/docker/image"
 	"github.com/docker/docker/reference"
 )
 
 // TagImage creates the tag specified by newTag, pointing to the image named
-// imageName (alternatively, imageName can also be an image ID).
+// imageName (alternatively, imageName can also be an image ID). The tag can
+// also be a digest, to record the digest an image was pulled by under another
+// name, e.g. when it was pulled through a mirror.
 func (daemon *Daemon) TagImage(imageName, repository, tag string) error {
 	imageID, err := daemon.GetImageID(imageName)
 	if err != nil {
@@ -17,6 +22,13 @@ func (daemon *Daemon) TagImage(imageName, repository, tag string) error {
 	if err != nil {
 		return err
 	}
+	if dgst, err := digest.ParseDigest(tag); err == nil {
+		ref, err := reference.WithDigest(newTag, dgst)
+		if err != nil {
+			return err
+		}
+		return daemon.addImageDigest(imageID, ref)
+	}
 	if tag != "" {
 		if newTag, err = reference.WithTag(newTag, tag); err != nil {
 			return err
@@ -35,3 +47,20 @@ func (daemon *Daemon) TagImageWithReference(imageID image.ID, newTag reference.N
 	daemon.LogImageEvent(imageID.String(), newTag.String(), "tag")
 	return nil
 }
+
+// addImageDigest adds the digest reference to the image ID provided. A digest
+// cannot be moved to another image.
+func (daemon *Daemon) addImageDigest(imageID image.ID, ref reference.Canonical) error {
+	if id, err := daemon.referenceStore.Get(ref); err == nil {
+		if id != imageID {
+			return fmt.Errorf("digest %s is already used by image %s", ref.String(), id.String())
+		}
+		return nil
+	}
+	if err := daemon.referenceStore.AddDigest(ref, imageID, false); err != nil {
+		return err
+	}
+
+	daemon.LogImageEvent(imageID.String(), ref.String(), "tag")
+	return nil
+}
@@ -135,20 +135,21 @@ func (daemon *Daemon) getInspectData(container *container.Container, size bool)
 	}
 
 	contJSONBase := &types.ContainerJSONBase{
-		ID:           container.ID,
-		Created:      container.Created.Format(time.RFC3339Nano),
-		Path:         container.Path,
-		Args:         container.Args,
-		State:        containerState,
-		Image:        container.ImageID.String(),
-		LogPath:      container.LogPath,
-		Name:         container.Name,
-		RestartCount: container.RestartCount,
-		Driver:       container.Driver,
-		MountLabel:   container.MountLabel,
-		ProcessLabel: container.ProcessLabel,
-		ExecIDs:      container.GetExecIDs(),
-		HostConfig:   &hostConfig,
+		ID:                 container.ID,
+		Created:            container.Created.Format(time.RFC3339Nano),
+		Path:               container.Path,
+		Args:               container.Args,
+		State:              containerState,
+		Image:              container.ImageID.String(),
+		LogPath:            container.LogPath,
+		LogDroppedMessages: container.DroppedLogMessages(),
+		Name:               container.Name,
+		RestartCount:       container.RestartCount,
+		Driver:             container.Driver,
+		MountLabel:         container.MountLabel,
+		ProcessLabel:       container.ProcessLabel,
+		ExecIDs:            container.GetExecIDs(),
+		HostConfig:         &hostConfig,
 	}
 
 	var (
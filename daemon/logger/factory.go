@@ -86,7 +86,8 @@ func GetLogDriver(name string) (Creator, error) {
 }
 
 // ValidateLogOpts checks the options for the given log driver. The
-// options supported are specific to the LogDriver implementation.
+// options supported are specific to the LogDriver implementation, except
+// for the delivery mode options which are supported by all of them.
 func ValidateLogOpts(name string, cfg map[string]string) error {
 	if name == "none" {
 		return nil
@@ -96,9 +97,19 @@ func ValidateLogOpts(name string, cfg map[string]string) error {
 		return fmt.Errorf("logger: no log driver named '%s' is registered", name)
 	}
 
+	if err := validateModeOpts(cfg); err != nil {
+		return err
+	}
+
 	validator := factory.getLogOptValidator(name)
 	if validator != nil {
-		return validator(cfg)
+		driverCfg := make(map[string]string, len(cfg))
+		for k, v := range cfg {
+			if k != ModeKey && k != MaxBufferSizeKey {
+				driverCfg[k] = v
+			}
+		}
+		return validator(driverCfg)
 	}
 	return nil
 }
@@ -163,11 +163,12 @@ func (s *journald) Close() error {
 	return nil
 }
 
-func (s *journald) drainJournal(logWatcher *logger.LogWatcher, config logger.ReadConfig, j *C.sd_journal, oldCursor string) string {
+func (s *journald) drainJournal(logWatcher *logger.LogWatcher, config logger.ReadConfig, j *C.sd_journal, oldCursor string) (string, bool) {
 	var msg, data, cursor *C.char
 	var length C.size_t
 	var stamp C.uint64_t
 	var priority C.int
+	var done bool
 
 	// Walk the journal from here forward until we run out of new entries.
 drain:
@@ -191,6 +192,11 @@ drain:
 			}
 			// Set up the time and text of the entry.
 			timestamp := time.Unix(int64(stamp)/1000000, (int64(stamp)%1000000)*1000)
+			// Stop at the first entry past the end of the window.
+			if !config.Until.IsZero() && timestamp.After(config.Until) {
+				done = true
+				break
+			}
 			line := append(C.GoBytes(unsafe.Pointer(msg), C.int(length)), "\n"...)
 			// Recover the stream name by mapping
 			// from the journal priority back to
@@ -232,7 +238,7 @@ drain:
 		retCursor = C.GoString(cursor)
 		C.free(unsafe.Pointer(cursor))
 	}
-	return retCursor
+	return retCursor, done
 }
 
 func (s *journald) followJournal(logWatcher *logger.LogWatcher, config logger.ReadConfig, j *C.sd_journal, pfd [2]C.int, cursor string) {
@@ -244,7 +250,11 @@ func (s *journald) followJournal(logWatcher *logger.LogWatcher, config logger.Re
 		// or we hit an error.
 		status := C.wait_for_data_or_close(j, pfd[0])
 		for status == 1 {
-			cursor = s.drainJournal(logWatcher, config, j, cursor)
+			var done bool
+			cursor, done = s.drainJournal(logWatcher, config, j, cursor)
+			if done {
+				break
+			}
 			status = C.wait_for_data_or_close(j, pfd[0])
 		}
 		if status < 0 {
@@ -274,6 +284,7 @@ func (s *journald) readLogs(logWatcher *logger.LogWatcher, config logger.ReadCon
 	var cmatch *C.char
 	var stamp C.uint64_t
 	var sinceUnixMicro uint64
+	var untilUnixMicro uint64
 	var pipes [2]C.int
 	cursor := ""
 
@@ -314,10 +325,19 @@ func (s *journald) readLogs(logWatcher *logger.LogWatcher, config logger.ReadCon
 		nano := config.Since.UnixNano()
 		sinceUnixMicro = uint64(nano / 1000)
 	}
+	if !config.Until.IsZero() {
+		nano := config.Until.UnixNano()
+		untilUnixMicro = uint64(nano / 1000)
+	}
 	if config.Tail > 0 {
 		lines := config.Tail
-		// Start at the end of the journal.
-		if C.sd_journal_seek_tail(j) < 0 {
+		// Start at the end of the journal, or of the window.
+		if untilUnixMicro != 0 {
+			if C.sd_journal_seek_realtime_usec(j, C.uint64_t(untilUnixMicro+1)) < 0 {
+				logWatcher.Err <- fmt.Errorf("error seeking to end time in journal")
+				return
+			}
+		} else if C.sd_journal_seek_tail(j) < 0 {
 			logWatcher.Err <- fmt.Errorf("error seeking to end of journal")
 			return
 		}
@@ -363,8 +383,8 @@ func (s *journald) readLogs(logWatcher *logger.LogWatcher, config logger.ReadCon
 			return
 		}
 	}
-	cursor = s.drainJournal(logWatcher, config, j, "")
-	if config.Follow {
+	cursor, done := s.drainJournal(logWatcher, config, j, "")
+	if config.Follow && !done {
 		// Allocate a descriptor for following the journal, if we'll
 		// need one.  Do it here so that we can report if it fails.
 		if fd := C.sd_journal_get_fd(j); fd < C.int(0) {
@@ -9,6 +9,7 @@ import (
 	"fmt"
 	"strconv"
 	"sync"
+	"time"
 
 	"github.com/Sirupsen/logrus"
 	"github.com/docker/docker/daemon/logger"
@@ -61,7 +62,26 @@ func New(ctx logger.Context) (logger.Logger, error) {
 		}
 	}
 
-	writer, err := loggerutils.NewRotateFileWriter(ctx.LogPath, capval, maxFiles)
+	var opts loggerutils.RotateOptions
+	if compress, ok := ctx.Config["compress"]; ok {
+		var err error
+		opts.Compress, err = strconv.ParseBool(compress)
+		if err != nil {
+			return nil, fmt.Errorf("invalid value for compress: %s", compress)
+		}
+	}
+	if maxAge, ok := ctx.Config["max-age"]; ok {
+		var err error
+		opts.MaxAge, err = time.ParseDuration(maxAge)
+		if err != nil {
+			return nil, err
+		}
+		if opts.MaxAge <= 0 {
+			return nil, fmt.Errorf("max-age must be a positive duration")
+		}
+	}
+
+	writer, err := loggerutils.NewRotateFileWriterWithOptions(ctx.LogPath, capval, maxFiles, opts)
 	if err != nil {
 		return nil, err
 	}
@@ -109,12 +129,16 @@ func (l *JSONFileLogger) Log(msg *logger.Message) error {
 	return err
 }
 
-// ValidateLogOpt looks for json specific log options max-file & max-size.
+// ValidateLogOpt looks for json specific log options max-file, max-size,
+// compress & max-age. The total size of the rotated files is capped by the
+// daemon, not per container.
 func ValidateLogOpt(cfg map[string]string) error {
 	for key := range cfg {
 		switch key {
 		case "max-file":
 		case "max-size":
+		case "compress":
+		case "max-age":
 		case "labels":
 		case "env":
 		default:
@@ -7,6 +7,7 @@ import (
 	"path/filepath"
 	"reflect"
 	"strconv"
+	"strings"
 	"testing"
 	"time"
 
@@ -152,6 +153,130 @@ func TestJSONFileLoggerWithOpts(t *testing.T) {
 
 }
 
+func TestJSONFileLoggerCompressReadLogs(t *testing.T) {
+	cid := "a7317399f3f857173c6179d44823594f8294678dea9999662e5c625b5a1c7657"
+	tmp, err := ioutil.TempDir("", "docker-logger-")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(tmp)
+	filename := filepath.Join(tmp, "container.log")
+	config := map[string]string{"max-file": "3", "max-size": "1k", "compress": "true"}
+	l, err := New(logger.Context{
+		ContainerID: cid,
+		LogPath:     filename,
+		Config:      config,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer l.Close()
+	for i := 0; i < 36; i++ {
+		if err := l.Log(&logger.Message{Line: []byte("line" + strconv.Itoa(i)), Source: "src1"}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// The latest rotated file is compressed in the background.
+	deadline := time.Now().Add(10 * time.Second)
+	for {
+		if _, err := os.Stat(filename + ".1.gz"); err == nil {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("expected compressed rotated file %s", filename+".1.gz")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	if _, err := os.Stat(filename + ".2.gz"); err != nil {
+		t.Fatalf("expected compressed rotated file %s: %v", filename+".2.gz", err)
+	}
+	if _, err := os.Stat(filename + ".1"); !os.IsNotExist(err) {
+		t.Fatalf("expected the uncompressed rotated file to be removed, got %v", err)
+	}
+
+	lw := l.(logger.LogReader).ReadLogs(logger.ReadConfig{Tail: -1})
+	var lines []string
+	for msg := range lw.Msg {
+		lines = append(lines, string(msg.Line))
+	}
+	if len(lines) != 36 {
+		t.Fatalf("expected 36 lines, got %d: %q", len(lines), lines)
+	}
+	for i, line := range lines {
+		if expected := "line" + strconv.Itoa(i) + "\n"; line != expected {
+			t.Fatalf("expected line %d to be %q, got %q", i, expected, line)
+		}
+	}
+}
+
+func TestJSONFileLoggerReadLogsTimeWindow(t *testing.T) {
+	cid := "a7317399f3f857173c6179d44823594f8294678dea9999662e5c625b5a1c7657"
+	tmp, err := ioutil.TempDir("", "docker-logger-")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(tmp)
+	filename := filepath.Join(tmp, "container.log")
+	config := map[string]string{"max-file": "3", "max-size": "1k"}
+	l, err := New(logger.Context{
+		ContainerID: cid,
+		LogPath:     filename,
+		Config:      config,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer l.Close()
+	start := time.Date(2016, 9, 1, 0, 0, 0, 0, time.UTC)
+	for i := 0; i < 30; i++ {
+		if err := l.Log(&logger.Message{Line: []byte("line" + strconv.Itoa(i)), Source: "src1", Timestamp: start.Add(time.Duration(i) * time.Minute)}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	readLines := func(config logger.ReadConfig) []string {
+		lw := l.(logger.LogReader).ReadLogs(config)
+		var lines []string
+		for msg := range lw.Msg {
+			lines = append(lines, strings.TrimSpace(string(msg.Line)))
+		}
+		return lines
+	}
+
+	tests := []struct {
+		config   logger.ReadConfig
+		expected []string
+	}{
+		{
+			config:   logger.ReadConfig{Tail: -1, Since: start.Add(27 * time.Minute)},
+			expected: []string{"line27", "line28", "line29"},
+		},
+		{
+			config:   logger.ReadConfig{Tail: -1, Until: start.Add(2 * time.Minute)},
+			expected: []string{"line0", "line1", "line2"},
+		},
+		{
+			config:   logger.ReadConfig{Tail: -1, Since: start.Add(9*time.Minute + time.Second), Until: start.Add(12 * time.Minute)},
+			expected: []string{"line10", "line11", "line12"},
+		},
+		{
+			// The tail is taken from the end of the window.
+			config:   logger.ReadConfig{Tail: 2, Until: start.Add(20 * time.Minute)},
+			expected: []string{"line19", "line20"},
+		},
+		{
+			config:   logger.ReadConfig{Tail: -1, Since: start.Add(time.Hour)},
+			expected: nil,
+		},
+	}
+	for _, test := range tests {
+		if lines := readLines(test.config); !reflect.DeepEqual(lines, test.expected) {
+			t.Fatalf("expected %q for %+v, got %q", test.expected, test.config, lines)
+		}
+	}
+}
+
 func TestJSONFileLoggerWithLabelsEnv(t *testing.T) {
 	cid := "a7317399f3f857173c6179d44823594f8294678dea9999662e5c625b5a1c7657"
 	tmp, err := ioutil.TempDir("", "docker-logger-")
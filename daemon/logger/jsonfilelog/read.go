@@ -2,14 +2,17 @@ package jsonfilelog
 
 import (
 	"bytes"
+	"compress/gzip"
 	"encoding/json"
 	"fmt"
 	"io"
+	"io/ioutil"
 	"os"
 	"time"
 
 	"github.com/Sirupsen/logrus"
 	"github.com/docker/docker/daemon/logger"
+	"github.com/docker/docker/daemon/logger/loggerutils"
 	"github.com/docker/docker/pkg/filenotify"
 	"github.com/docker/docker/pkg/ioutils"
 	"github.com/docker/docker/pkg/jsonlog"
@@ -46,8 +49,10 @@ func (l *JSONFileLogger) readLogs(logWatcher *logger.LogWatcher, config logger.R
 
 	pth := l.writer.LogPath()
 	var files []io.ReadSeeker
-	for i := l.writer.MaxFiles(); i > 1; i-- {
-		f, err := os.Open(fmt.Sprintf("%s.%d", pth, i-1))
+	// Rotated files are only read when tailing, which avoids decompressing
+	// them when following from the end.
+	for i := l.writer.MaxFiles(); i > 1 && config.Tail != 0; i-- {
+		f, err := openRotatedFile(fmt.Sprintf("%s.%d", pth, i-1))
 		if err != nil {
 			if !os.IsNotExist(err) {
 				logWatcher.Err <- err
@@ -66,7 +71,7 @@ func (l *JSONFileLogger) readLogs(logWatcher *logger.LogWatcher, config logger.R
 
 	if config.Tail != 0 {
 		tailer := ioutils.MultiReadSeeker(append(files, latestFile)...)
-		tailFile(tailer, logWatcher, config.Tail, config.Since)
+		tailFile(tailer, logWatcher, config)
 	}
 
 	// close all the rotated files
@@ -92,7 +97,7 @@ func (l *JSONFileLogger) readLogs(logWatcher *logger.LogWatcher, config logger.R
 	l.mu.Unlock()
 
 	notifyRotate := l.writer.NotifyRotate()
-	followLogs(latestFile, logWatcher, notifyRotate, config.Since)
+	followLogs(latestFile, logWatcher, notifyRotate, config.Since, config.Until)
 
 	l.mu.Lock()
 	delete(l.readers, logWatcher)
@@ -101,10 +106,70 @@ func (l *JSONFileLogger) readLogs(logWatcher *logger.LogWatcher, config logger.R
 	l.writer.NotifyRotateEvict(notifyRotate)
 }
 
-func tailFile(f io.ReadSeeker, logWatcher *logger.LogWatcher, tail int, since time.Time) {
+// openRotatedFile opens a rotated log file, which may have been compressed.
+// Compressed files are decompressed to a temporary file, since tailing needs
+// to seek; the temporary file is removed when the returned file is closed.
+func openRotatedFile(path string) (io.ReadSeeker, error) {
+	f, err := os.Open(path)
+	if err == nil || !os.IsNotExist(err) {
+		return f, err
+	}
+
+	compressed, err := os.Open(path + loggerutils.CompressedSuffix)
+	if err != nil {
+		return nil, err
+	}
+	defer compressed.Close()
+	zr, err := gzip.NewReader(compressed)
+	if err != nil {
+		return nil, err
+	}
+	defer zr.Close()
+
+	tmp, err := ioutil.TempFile("", "docker-log-")
+	if err != nil {
+		return nil, err
+	}
+	if _, err := io.Copy(tmp, zr); err != nil {
+		tmp.Close()
+		os.Remove(tmp.Name())
+		return nil, err
+	}
+	if _, err := tmp.Seek(0, os.SEEK_SET); err != nil {
+		tmp.Close()
+		os.Remove(tmp.Name())
+		return nil, err
+	}
+	return &tempFile{tmp}, nil
+}
+
+// tempFile is a file removed when closed.
+type tempFile struct {
+	*os.File
+}
+
+func (f *tempFile) Close() error {
+	err := f.File.Close()
+	os.Remove(f.Name())
+	return err
+}
+
+func tailFile(f io.ReadSeeker, logWatcher *logger.LogWatcher, config logger.ReadConfig) {
+	// Narrow the logs down to the requested time window first, so that
+	// neither the lines before it are decoded, nor the lines after it are
+	// counted as the tail.
+	if !config.Since.IsZero() || !config.Until.IsZero() {
+		section, err := timeSection(f, config.Since, config.Until)
+		if err != nil {
+			logWatcher.Err <- err
+			return
+		}
+		f = section
+	}
+
 	var rdr io.Reader = f
-	if tail > 0 {
-		ls, err := tailfile.TailFile(f, tail)
+	if config.Tail > 0 {
+		ls, err := tailfile.TailFile(f, config.Tail)
 		if err != nil {
 			logWatcher.Err <- err
 			return
@@ -121,14 +186,41 @@ func tailFile(f io.ReadSeeker, logWatcher *logger.LogWatcher, tail int, since ti
 			}
 			return
 		}
-		if !since.IsZero() && msg.Timestamp.Before(since) {
+		if !config.Since.IsZero() && msg.Timestamp.Before(config.Since) {
 			continue
 		}
+		if !config.Until.IsZero() && msg.Timestamp.After(config.Until) {
+			return
+		}
 		logWatcher.Msg <- msg
 	}
 }
 
-func followLogs(f *os.File, logWatcher *logger.LogWatcher, notifyRotate chan interface{}, since time.Time) {
+// timeSection returns the part of f holding the lines logged between since
+// and until, either of which may be zero.
+func timeSection(f io.ReadSeeker, since, until time.Time) (io.ReadSeeker, error) {
+	end, err := f.Seek(0, os.SEEK_END)
+	if err != nil {
+		return nil, err
+	}
+	var start int64
+	if !since.IsZero() {
+		if start, err = offsetOf(f, since); err != nil {
+			return nil, err
+		}
+	}
+	if !until.IsZero() {
+		if end, err = offsetOf(f, until.Add(time.Nanosecond)); err != nil {
+			return nil, err
+		}
+	}
+	if end < start {
+		end = start
+	}
+	return newSectionReadSeeker(f, start, end)
+}
+
+func followLogs(f *os.File, logWatcher *logger.LogWatcher, notifyRotate chan interface{}, since, until time.Time) {
 	dec := json.NewDecoder(f)
 	l := &jsonlog.JSONLog{}
 
@@ -142,6 +234,14 @@ func followLogs(f *os.File, logWatcher *logger.LogWatcher, notifyRotate chan int
 	}()
 	name := f.Name()
 
+	// Stop following once the end of the requested window has passed.
+	var untilC <-chan time.Time
+	if !until.IsZero() {
+		timer := time.NewTimer(until.Sub(time.Now()))
+		defer timer.Stop()
+		untilC = timer.C
+	}
+
 	if err := fileWatcher.Add(name); err != nil {
 		logrus.WithField("logger", "json-file").Warnf("falling back to file poller due to error: %v", err)
 		fileWatcher.Close()
@@ -190,6 +290,9 @@ func followLogs(f *os.File, logWatcher *logger.LogWatcher, notifyRotate chan int
 			case <-logWatcher.WatchClose():
 				fileWatcher.Remove(name)
 				return
+			case <-untilC:
+				fileWatcher.Remove(name)
+				return
 			case <-notifyRotate:
 				f.Close()
 				fileWatcher.Remove(name)
@@ -220,6 +323,9 @@ func followLogs(f *os.File, logWatcher *logger.LogWatcher, notifyRotate chan int
 		if !since.IsZero() && msg.Timestamp.Before(since) {
 			continue
 		}
+		if !until.IsZero() && msg.Timestamp.After(until) {
+			return
+		}
 		select {
 		case logWatcher.Msg <- msg:
 		case <-logWatcher.WatchClose():
@@ -232,6 +338,9 @@ func followLogs(f *os.File, logWatcher *logger.LogWatcher, notifyRotate chan int
 				if !since.IsZero() && msg.Timestamp.Before(since) {
 					continue
 				}
+				if !until.IsZero() && msg.Timestamp.After(until) {
+					return
+				}
 				logWatcher.Msg <- msg
 			}
 		}
@@ -0,0 +1,131 @@
+package jsonfilelog
+
+import (
+	"bufio"
+	"encoding/json"
+	"io"
+	"os"
+	"time"
+
+	"github.com/docker/docker/pkg/jsonlog"
+)
+
+// seekThreshold is the size of the range below which offsetOf stops
+// bisecting and scans the remaining lines.
+const seekThreshold = 64 * 1024
+
+// offsetOf returns the offset of the first line of the log file logged at or
+// after t, or the size of the file if there is none.
+//
+// Log files are only ever appended to, so their lines are ordered by time and
+// the file itself serves as a sparse index of timestamps: the range holding
+// t is bisected by decoding the line following the middle of the range, and
+// only the last few kilobytes are scanned.
+func offsetOf(f io.ReadSeeker, t time.Time) (int64, error) {
+	size, err := f.Seek(0, os.SEEK_END)
+	if err != nil {
+		return 0, err
+	}
+
+	// lo is always the start of a line logged before t, or 0.
+	lo, hi := int64(0), size
+	for hi-lo > seekThreshold {
+		mid := lo + (hi-lo)/2
+		if _, err := f.Seek(mid, os.SEEK_SET); err != nil {
+			return 0, err
+		}
+		rdr := bufio.NewReader(f)
+		// Skip the rest of the line mid falls in.
+		skipped, err := rdr.ReadBytes('\n')
+		if err != nil {
+			hi = mid
+			continue
+		}
+		off := mid + int64(len(skipped))
+		ts, _, err := lineTime(rdr)
+		if err != nil || off >= hi || !ts.Before(t) {
+			hi = mid
+			continue
+		}
+		lo = off
+	}
+
+	if _, err := f.Seek(lo, os.SEEK_SET); err != nil {
+		return 0, err
+	}
+	rdr := bufio.NewReader(f)
+	for off := lo; off < size; {
+		ts, n, err := lineTime(rdr)
+		if err != nil {
+			if err == io.EOF {
+				break
+			}
+			return 0, err
+		}
+		if !ts.Before(t) {
+			return off, nil
+		}
+		off += n
+	}
+	return size, nil
+}
+
+// lineTime reads a line and returns its timestamp and length.
+func lineTime(rdr *bufio.Reader) (time.Time, int64, error) {
+	line, err := rdr.ReadBytes('\n')
+	if err != nil {
+		if err == io.EOF && len(line) > 0 {
+			err = io.ErrUnexpectedEOF
+		}
+		return time.Time{}, int64(len(line)), err
+	}
+	var l jsonlog.JSONLog
+	if err := json.Unmarshal(line, &l); err != nil {
+		return time.Time{}, int64(len(line)), err
+	}
+	return l.Created, int64(len(line)), nil
+}
+
+// sectionReadSeeker limits an io.ReadSeeker to the bytes between two offsets.
+type sectionReadSeeker struct {
+	rs   io.ReadSeeker
+	base int64
+	size int64
+	off  int64
+}
+
+func newSectionReadSeeker(rs io.ReadSeeker, start, end int64) (*sectionReadSeeker, error) {
+	if _, err := rs.Seek(start, os.SEEK_SET); err != nil {
+		return nil, err
+	}
+	return &sectionReadSeeker{rs: rs, base: start, size: end - start}, nil
+}
+
+func (s *sectionReadSeeker) Read(p []byte) (int, error) {
+	if len(p) == 0 {
+		return 0, nil
+	}
+	if s.off >= s.size {
+		return 0, io.EOF
+	}
+	if max := s.size - s.off; int64(len(p)) > max {
+		p = p[:max]
+	}
+	n, err := s.rs.Read(p)
+	s.off += int64(n)
+	return n, err
+}
+
+func (s *sectionReadSeeker) Seek(offset int64, whence int) (int64, error) {
+	switch whence {
+	case os.SEEK_CUR:
+		offset += s.off
+	case os.SEEK_END:
+		offset += s.size
+	}
+	if _, err := s.rs.Seek(s.base+offset, os.SEEK_SET); err != nil {
+		return 0, err
+	}
+	s.off = offset
+	return offset, nil
+}
@@ -0,0 +1,55 @@
+package jsonfilelog
+
+import (
+	"bytes"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/docker/docker/pkg/jsonlog"
+)
+
+func TestOffsetOf(t *testing.T) {
+	start := time.Date(2016, 9, 1, 0, 0, 0, 0, time.UTC)
+	var buf bytes.Buffer
+	var offsets []int64
+	// Enough lines for the offset to be bisected.
+	for i := 0; i < 10000; i++ {
+		offsets = append(offsets, int64(buf.Len()))
+		line, err := (&jsonlog.JSONLog{Log: "line" + strconv.Itoa(i) + "\n", Stream: "stdout", Created: start.Add(time.Duration(i) * time.Second)}).MarshalJSON()
+		if err != nil {
+			t.Fatal(err)
+		}
+		buf.Write(append(line, '\n'))
+	}
+	if buf.Len() < 2*seekThreshold {
+		t.Fatalf("expected the test log to be larger than %d bytes, got %d", 2*seekThreshold, buf.Len())
+	}
+	f := bytes.NewReader(buf.Bytes())
+
+	for _, i := range []int{0, 1, 2500, 5000, 9998, 9999} {
+		off, err := offsetOf(f, start.Add(time.Duration(i)*time.Second))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if off != offsets[i] {
+			t.Fatalf("expected line %d at offset %d, got %d", i, offsets[i], off)
+		}
+		// Between two lines, the later one is found.
+		off, err = offsetOf(f, start.Add(time.Duration(i)*time.Second-time.Millisecond))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if off != offsets[i] {
+			t.Fatalf("expected line %d at offset %d, got %d", i, offsets[i], off)
+		}
+	}
+
+	off, err := offsetOf(f, start.Add(time.Hour*24))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if off != int64(buf.Len()) {
+		t.Fatalf("expected the end of the file for a time after the last line, got %d", off)
+	}
+}
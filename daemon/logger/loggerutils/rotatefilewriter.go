@@ -1,25 +1,57 @@
 package loggerutils
 
 import (
+	"compress/gzip"
+	"io"
+	"io/ioutil"
 	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
 	"strconv"
 	"sync"
+	"time"
 
+	"github.com/Sirupsen/logrus"
 	"github.com/docker/docker/pkg/pubsub"
 )
 
+// CompressedSuffix is the suffix of the rotated files compressed with gzip.
+const CompressedSuffix = ".gz"
+
+// RotateOptions holds the optional retention settings of a RotateFileWriter.
+type RotateOptions struct {
+	// Compress makes rotated files compressed with gzip.
+	Compress bool
+	// MaxAge, if set, is the age after which the current file is rotated
+	// and rotated files are removed.
+	MaxAge time.Duration
+}
+
 // RotateFileWriter is Logger implementation for default Docker logging.
 type RotateFileWriter struct {
 	f            *os.File // store for closing
+	logPath      string
 	mu           sync.Mutex
 	capacity     int64 //maximum size of each file
 	currentSize  int64 // current size of the latest file
 	maxFiles     int   //maximum number of files
+	opts         RotateOptions
+	created      time.Time // time the latest file was started
 	notifyRotate *pubsub.Publisher
+	// compressing is closed when the compression of the latest rotated
+	// file is done, nil if there was none.
+	compressing chan struct{}
 }
 
 //NewRotateFileWriter creates new RotateFileWriter
 func NewRotateFileWriter(logPath string, capacity int64, maxFiles int) (*RotateFileWriter, error) {
+	return NewRotateFileWriterWithOptions(logPath, capacity, maxFiles, RotateOptions{})
+}
+
+// NewRotateFileWriterWithOptions creates a new RotateFileWriter with
+// retention options.
+func NewRotateFileWriterWithOptions(logPath string, capacity int64, maxFiles int, opts RotateOptions) (*RotateFileWriter, error) {
 	log, err := os.OpenFile(logPath, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0640)
 	if err != nil {
 		return nil, err
@@ -30,13 +62,18 @@ func NewRotateFileWriter(logPath string, capacity int64, maxFiles int) (*RotateF
 		return nil, err
 	}
 
-	return &RotateFileWriter{
+	w := &RotateFileWriter{
 		f:            log,
+		logPath:      logPath,
 		capacity:     capacity,
 		currentSize:  size,
 		maxFiles:     maxFiles,
+		opts:         opts,
+		created:      time.Now(),
 		notifyRotate: pubsub.NewPublisher(0, 1),
-	}, nil
+	}
+	w.removeExpired()
+	return w, nil
 }
 
 //WriteLog write log message to File
@@ -56,48 +93,266 @@ func (w *RotateFileWriter) Write(message []byte) (int, error) {
 }
 
 func (w *RotateFileWriter) checkCapacityAndRotate() error {
-	if w.capacity == -1 {
+	full := w.capacity != -1 && w.currentSize >= w.capacity
+	expired := w.opts.MaxAge > 0 && w.currentSize > 0 && time.Since(w.created) >= w.opts.MaxAge
+	if !full && !expired {
 		return nil
 	}
 
-	if w.currentSize >= w.capacity {
-		name := w.f.Name()
-		if err := w.f.Close(); err != nil {
-			return err
-		}
-		if err := rotate(name, w.maxFiles); err != nil {
-			return err
-		}
-		file, err := os.OpenFile(name, os.O_WRONLY|os.O_TRUNC|os.O_CREATE, 06400)
-		if err != nil {
-			return err
-		}
-		w.f = file
-		w.currentSize = 0
-		w.notifyRotate.Publish(struct{}{})
+	name := w.f.Name()
+	if err := w.f.Close(); err != nil {
+		return err
+	}
+	if err := w.rotate(); err != nil {
+		return err
+	}
+	file, err := os.OpenFile(name, os.O_WRONLY|os.O_TRUNC|os.O_CREATE, 06400)
+	if err != nil {
+		return err
 	}
+	w.f = file
+	w.currentSize = 0
+	w.created = time.Now()
+	w.notifyRotate.Publish(struct{}{})
+	w.removeExpired()
+
+	return nil
+}
+
+// rotate shifts the rotated files, renames the latest file to name.1 and
+// starts compressing it in the background if needed. Rotations of all the
+// writers are serialized, so that removing the oldest files of other writers
+// to enforce the total size cap does not race with their rotation.
+func (w *RotateFileWriter) rotate() error {
+	// The previous rotated file has to be compressed before it is shifted.
+	w.waitCompression()
+
+	rotations.Lock()
+	defer rotations.Unlock()
 
+	name := w.f.Name()
+	if err := rotate(name, w.maxFiles); err != nil {
+		return err
+	}
+	if w.opts.Compress && w.maxFiles > 1 {
+		done := make(chan struct{})
+		w.compressing = done
+		go func() {
+			defer close(done)
+			if err := compressFile(name + ".1"); err != nil {
+				logrus.Errorf("Failed to compress rotated log file %s.1: %v", name, err)
+			}
+		}()
+	}
+	if rotations.maxTotalSize > 0 {
+		enforceTotalSize(rotations.dir, rotations.maxTotalSize)
+	}
 	return nil
 }
 
+// waitCompression waits for the compression of the latest rotated file to
+// be done. It must be called with w.mu held.
+func (w *RotateFileWriter) waitCompression() {
+	if w.compressing != nil {
+		<-w.compressing
+		w.compressing = nil
+	}
+}
+
 func rotate(name string, maxFiles int) error {
 	if maxFiles < 2 {
 		return nil
 	}
+	// The oldest file is dropped, whether it is compressed or not.
+	oldest := name + "." + strconv.Itoa(maxFiles-1)
+	for _, suffix := range []string{"", CompressedSuffix} {
+		if err := os.Remove(oldest + suffix); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+	}
 	for i := maxFiles - 1; i > 1; i-- {
 		toPath := name + "." + strconv.Itoa(i)
 		fromPath := name + "." + strconv.Itoa(i-1)
-		if err := os.Rename(fromPath, toPath); err != nil && !os.IsNotExist(err) {
-			return err
+		// Rotated files may or may not be compressed, depending on the
+		// options the writer had when they were rotated.
+		for _, suffix := range []string{"", CompressedSuffix} {
+			if err := os.Rename(fromPath+suffix, toPath+suffix); err != nil && !os.IsNotExist(err) {
+				return err
+			}
 		}
 	}
-
 	if err := os.Rename(name, name+".1"); err != nil && !os.IsNotExist(err) {
 		return err
 	}
 	return nil
 }
 
+// compressFile replaces the file at path with its gzip-compressed version
+// at path+CompressedSuffix, preserving its modification time. The compressed
+// file is written to a temporary file first, and only replaces the file at
+// path if it was not removed meanwhile by the retention settings.
+func compressFile(path string) error {
+	src, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer src.Close()
+	fi, err := src.Stat()
+	if err != nil {
+		return err
+	}
+
+	// A writer compresses a single file at a time, so the name of the
+	// temporary file does not need to be unique.
+	dest, err := os.OpenFile(path+CompressedSuffix+".tmp", os.O_WRONLY|os.O_TRUNC|os.O_CREATE, 0640)
+	if err != nil {
+		return err
+	}
+	zw := gzip.NewWriter(dest)
+	if _, err := io.Copy(zw, src); err != nil {
+		dest.Close()
+		os.Remove(dest.Name())
+		return err
+	}
+	if err := zw.Close(); err != nil {
+		dest.Close()
+		os.Remove(dest.Name())
+		return err
+	}
+	if err := dest.Close(); err != nil {
+		os.Remove(dest.Name())
+		return err
+	}
+	if err := os.Chtimes(dest.Name(), fi.ModTime(), fi.ModTime()); err != nil {
+		logrus.Debugf("failed to preserve modification time of %s: %v", dest.Name(), err)
+	}
+
+	rotations.Lock()
+	defer rotations.Unlock()
+	if _, err := os.Stat(path); err != nil {
+		os.Remove(dest.Name())
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return err
+	}
+	if err := os.Rename(dest.Name(), path+CompressedSuffix); err != nil {
+		os.Remove(dest.Name())
+		return err
+	}
+	return os.Remove(path)
+}
+
+// rotatedFiles returns the rotated files of the writer that exist.
+func (w *RotateFileWriter) rotatedFiles() []string {
+	var files []string
+	name := w.logPath
+	for i := 1; i < w.maxFiles; i++ {
+		for _, suffix := range []string{"", CompressedSuffix} {
+			path := name + "." + strconv.Itoa(i) + suffix
+			if _, err := os.Stat(path); err == nil {
+				files = append(files, path)
+			}
+		}
+	}
+	return files
+}
+
+// removeExpired removes the rotated files older than the maximum age.
+func (w *RotateFileWriter) removeExpired() {
+	if w.opts.MaxAge <= 0 {
+		return
+	}
+	for _, path := range w.rotatedFiles() {
+		fi, err := os.Stat(path)
+		if err != nil || time.Since(fi.ModTime()) < w.opts.MaxAge {
+			continue
+		}
+		if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+			logrus.Errorf("Failed to remove expired log file %s: %v", path, err)
+		}
+	}
+}
+
+// rotations serializes the rotations of all the writers, and holds the cap on
+// the total size of the rotated files.
+var rotations struct {
+	sync.Mutex
+	dir          string
+	maxTotalSize int64
+}
+
+// SetMaxTotalSize caps the total size of the rotated log files found in the
+// subdirectories of dir, whether their writer is open or not. When a writer
+// rotates, the oldest rotated files are removed until the total size fits in
+// maxTotalSize. A maxTotalSize of 0 removes the cap. The cap is set by the
+// daemon, for the log directories of all the containers.
+func SetMaxTotalSize(dir string, maxTotalSize int64) {
+	rotations.Lock()
+	rotations.dir = dir
+	rotations.maxTotalSize = maxTotalSize
+	rotations.Unlock()
+}
+
+// rotatedFileRegexp matches the names of rotated log files, compressed or not.
+var rotatedFileRegexp = regexp.MustCompile(`\.log\.[0-9]+(` + regexp.QuoteMeta(CompressedSuffix) + `)?$`)
+
+type rotatedFile struct {
+	path    string
+	size    int64
+	modTime time.Time
+}
+
+type byModTime []rotatedFile
+
+func (f byModTime) Len() int           { return len(f) }
+func (f byModTime) Swap(i, j int)      { f[i], f[j] = f[j], f[i] }
+func (f byModTime) Less(i, j int) bool { return f[i].modTime.Before(f[j].modTime) }
+
+// enforceTotalSize removes the oldest rotated log files found in the
+// subdirectories of dir until their total size fits in maxTotalSize. It must
+// be called with rotations held.
+func enforceTotalSize(dir string, maxTotalSize int64) {
+	var (
+		files []rotatedFile
+		total int64
+	)
+	dirs, err := ioutil.ReadDir(dir)
+	if err != nil {
+		logrus.Errorf("Failed to list log directories in %s: %v", dir, err)
+		return
+	}
+	for _, d := range dirs {
+		if !d.IsDir() {
+			continue
+		}
+		entries, err := ioutil.ReadDir(filepath.Join(dir, d.Name()))
+		if err != nil {
+			continue
+		}
+		for _, fi := range entries {
+			if fi.IsDir() || !rotatedFileRegexp.MatchString(fi.Name()) {
+				continue
+			}
+			path := filepath.Join(dir, d.Name(), fi.Name())
+			files = append(files, rotatedFile{path: path, size: fi.Size(), modTime: fi.ModTime()})
+			total += fi.Size()
+		}
+	}
+
+	sort.Sort(byModTime(files))
+	for _, f := range files {
+		if total <= maxTotalSize {
+			break
+		}
+		if err := os.Remove(f.path); err != nil && !os.IsNotExist(err) {
+			logrus.Errorf("Failed to remove log file %s: %v", f.path, err)
+			continue
+		}
+		total -= f.size
+	}
+}
+
 // LogPath returns the location the given writer logs to.
 func (w *RotateFileWriter) LogPath() string {
 	return w.f.Name()
@@ -120,5 +375,8 @@ func (w *RotateFileWriter) NotifyRotateEvict(sub chan interface{}) {
 
 // Close closes underlying file and signals all readers to stop.
 func (w *RotateFileWriter) Close() error {
+	w.mu.Lock()
+	w.waitCompression()
+	w.mu.Unlock()
 	return w.f.Close()
 }
@@ -0,0 +1,159 @@
+package loggerutils
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestRotateMaxAge(t *testing.T) {
+	tmp, err := ioutil.TempDir("", "docker-logger-")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(tmp)
+	name := filepath.Join(tmp, "container.log")
+
+	w, err := NewRotateFileWriterWithOptions(name, -1, 3, RotateOptions{MaxAge: time.Hour})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer w.Close()
+
+	if _, err := w.Write([]byte("old\n")); err != nil {
+		t.Fatal(err)
+	}
+	// The latest file is rotated once it is older than max-age, even
+	// without a max-size.
+	w.created = time.Now().Add(-2 * time.Hour)
+	if _, err := w.Write([]byte("new\n")); err != nil {
+		t.Fatal(err)
+	}
+	b, err := ioutil.ReadFile(name + ".1")
+	if err != nil || string(b) != "old\n" {
+		t.Fatalf("expected the expired file to be rotated, got %q, %v", b, err)
+	}
+
+	// Rotated files older than max-age are removed on the next rotation.
+	old := time.Now().Add(-2 * time.Hour)
+	if err := os.Chtimes(name+".1", old, old); err != nil {
+		t.Fatal(err)
+	}
+	w.created = old
+	if _, err := w.Write([]byte("newer\n")); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := os.Stat(name + ".2"); !os.IsNotExist(err) {
+		t.Fatalf("expected the expired rotated file to be removed, got %v", err)
+	}
+	b, err = ioutil.ReadFile(name + ".1")
+	if err != nil || string(b) != "new\n" {
+		t.Fatalf("expected the recent rotated file to be kept, got %q, %v", b, err)
+	}
+}
+
+func TestRotateMaxTotalSize(t *testing.T) {
+	tmp, err := ioutil.TempDir("", "docker-logger-")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(tmp)
+	for _, dir := range []string{"first", "second", "stopped"} {
+		if err := os.Mkdir(filepath.Join(tmp, dir), 0700); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// The rotated logs of a stopped container count towards the cap, but
+	// not its other files.
+	stopped := filepath.Join(tmp, "stopped", "stopped-json.log.1")
+	if err := ioutil.WriteFile(stopped, []byte(strings.Repeat("x", 10)), 0640); err != nil {
+		t.Fatal(err)
+	}
+	old := time.Now().Add(-time.Hour)
+	if err := os.Chtimes(stopped, old, old); err != nil {
+		t.Fatal(err)
+	}
+	config := filepath.Join(tmp, "stopped", "config.v2.json")
+	if err := ioutil.WriteFile(config, []byte(strings.Repeat("x", 100)), 0640); err != nil {
+		t.Fatal(err)
+	}
+
+	SetMaxTotalSize(tmp, 20)
+	defer SetMaxTotalSize("", 0)
+
+	// The writers have different options, but share the cap of the daemon.
+	first, err := NewRotateFileWriterWithOptions(filepath.Join(tmp, "first", "first-json.log"), 10, 3, RotateOptions{MaxAge: time.Hour})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer first.Close()
+	second, err := NewRotateFileWriterWithOptions(filepath.Join(tmp, "second", "second-json.log"), 10, 2, RotateOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer second.Close()
+
+	line := []byte(strings.Repeat("x", 9) + "\n")
+	for _, w := range []*RotateFileWriter{first, first, first, second, second} {
+		if _, err := w.Write(line); err != nil {
+			t.Fatal(err)
+		}
+		// Make the modification times distinct.
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	// The rotated log of the stopped container, then first-json.log.2, were
+	// the oldest rotated files when the cap was exceeded.
+	for _, path := range []string{stopped, filepath.Join(tmp, "first", "first-json.log.2")} {
+		if _, err := os.Stat(path); !os.IsNotExist(err) {
+			t.Fatalf("expected %s to be removed, got %v", path, err)
+		}
+	}
+	for _, path := range []string{config, filepath.Join(tmp, "first", "first-json.log.1"), filepath.Join(tmp, "second", "second-json.log.1")} {
+		if _, err := os.Stat(path); err != nil {
+			t.Fatalf("expected %s to be kept: %v", path, err)
+		}
+	}
+}
+
+func TestRotateCompress(t *testing.T) {
+	tmp, err := ioutil.TempDir("", "docker-logger-")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(tmp)
+	name := filepath.Join(tmp, "container.log")
+
+	w, err := NewRotateFileWriterWithOptions(name, 10, 3, RotateOptions{Compress: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	line := []byte(strings.Repeat("x", 9) + "\n")
+	for i := 0; i < 3; i++ {
+		if _, err := w.Write(line); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// Close waits for the background compression of the latest rotated
+	// file.
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, suffix := range []string{".1", ".2"} {
+		if _, err := os.Stat(name + suffix + CompressedSuffix); err != nil {
+			t.Fatalf("expected %s to be compressed: %v", name+suffix, err)
+		}
+		if _, err := os.Stat(name + suffix); !os.IsNotExist(err) {
+			t.Fatalf("expected %s to be removed, got %v", name+suffix, err)
+		}
+	}
+	if _, err := os.Stat(name + ".1" + CompressedSuffix + ".tmp"); !os.IsNotExist(err) {
+		t.Fatalf("expected the temporary file to be removed, got %v", err)
+	}
+}
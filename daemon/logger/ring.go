@@ -0,0 +1,234 @@
+package logger
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/Sirupsen/logrus"
+	"github.com/docker/go-units"
+)
+
+const (
+	// ModeKey is the log option selecting how messages are delivered to
+	// the logging driver.
+	ModeKey = "mode"
+	// MaxBufferSizeKey is the log option setting the size of the buffer of
+	// the non-blocking mode.
+	MaxBufferSizeKey = "max-buffer-size"
+
+	// BlockingMode delivers each message to the logging driver before
+	// reading the next one from the container, which is the default.
+	BlockingMode = "blocking"
+	// NonBlockingMode buffers messages in memory, dropping the oldest ones
+	// when the buffer is full, so that a slow logging driver never blocks
+	// the container.
+	NonBlockingMode = "non-blocking"
+
+	// DefaultMaxBufferSize is the default size of the buffer of the
+	// non-blocking mode.
+	DefaultMaxBufferSize = 1024 * 1024
+
+	// dropNotifyInterval limits how often dropped messages are reported.
+	dropNotifyInterval = 10 * time.Second
+)
+
+var errRingClosed = errors.New("logger: ring buffer is closed")
+
+// validateModeOpts validates the options common to all logging drivers.
+func validateModeOpts(cfg map[string]string) error {
+	mode := cfg[ModeKey]
+	switch mode {
+	case "", BlockingMode, NonBlockingMode:
+	default:
+		return fmt.Errorf("logger: invalid log mode '%s', expected '%s' or '%s'", mode, BlockingMode, NonBlockingMode)
+	}
+	if s, ok := cfg[MaxBufferSizeKey]; ok {
+		if mode != NonBlockingMode {
+			return fmt.Errorf("logger: '%s' is only supported with '%s=%s'", MaxBufferSizeKey, ModeKey, NonBlockingMode)
+		}
+		size, err := units.RAMInBytes(s)
+		if err != nil {
+			return fmt.Errorf("logger: invalid '%s': %v", MaxBufferSizeKey, err)
+		}
+		if size <= 0 {
+			return fmt.Errorf("logger: '%s' must be positive", MaxBufferSizeKey)
+		}
+	}
+	return nil
+}
+
+// IsNonBlocking returns whether the log options select the non-blocking
+// mode, and the size of its buffer.
+func IsNonBlocking(cfg map[string]string) (bool, int64) {
+	if cfg[ModeKey] != NonBlockingMode {
+		return false, 0
+	}
+	size := int64(DefaultMaxBufferSize)
+	if s, ok := cfg[MaxBufferSizeKey]; ok {
+		if n, err := units.RAMInBytes(s); err == nil && n > 0 {
+			size = n
+		}
+	}
+	return true, size
+}
+
+// RingLogger is a Logger buffering messages in memory and delivering them to
+// another Logger in the background. When the buffer is full, the oldest
+// messages are dropped, so that logging never blocks the container.
+type RingLogger struct {
+	l       Logger
+	maxSize int64
+	notify  func(dropped uint64)
+
+	mu           sync.Mutex
+	wait         *sync.Cond
+	queue        []*Message
+	size         int64
+	closed       bool
+	dropped      uint64
+	unreported   uint64
+	lastReported time.Time
+	done         chan struct{}
+}
+
+// ringWithReader is a RingLogger whose logging driver can read its logs.
+type ringWithReader struct {
+	*RingLogger
+}
+
+// ReadLogs reads the logs of the logging driver.
+func (r *ringWithReader) ReadLogs(config ReadConfig) *LogWatcher {
+	return r.l.(LogReader).ReadLogs(config)
+}
+
+// NewRingLogger returns a Logger delivering messages to l through a buffer
+// of maxSize bytes. notify, if not nil, is called with the number of
+// messages dropped since its last call, at most once every ten seconds and
+// when the logger is closed.
+func NewRingLogger(l Logger, maxSize int64, notify func(dropped uint64)) Logger {
+	r := &RingLogger{
+		l:       l,
+		maxSize: maxSize,
+		notify:  notify,
+		done:    make(chan struct{}),
+	}
+	r.wait = sync.NewCond(&r.mu)
+	go r.run()
+
+	if _, ok := l.(LogReader); ok {
+		return &ringWithReader{r}
+	}
+	return r
+}
+
+// Log queues the message, dropping the oldest messages if the buffer is
+// full.
+func (r *RingLogger) Log(msg *Message) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.closed {
+		return errRingClosed
+	}
+
+	size := int64(len(msg.Line))
+	var dropped uint64
+	for len(r.queue) > 0 && r.size+size > r.maxSize {
+		r.size -= int64(len(r.queue[0].Line))
+		r.queue[0] = nil
+		r.queue = r.queue[1:]
+		dropped++
+	}
+	r.queue = append(r.queue, msg)
+	r.size += size
+	r.wait.Signal()
+
+	if dropped > 0 {
+		r.dropped += dropped
+		r.unreported += dropped
+		if time.Since(r.lastReported) >= dropNotifyInterval {
+			r.report()
+		}
+	}
+	return nil
+}
+
+// report notifies about the unreported dropped messages. It must be called
+// with r.mu held.
+func (r *RingLogger) report() {
+	if r.unreported == 0 {
+		return
+	}
+	logrus.Warnf("Logging driver %s is too slow, dropped %d log messages", r.l.Name(), r.unreported)
+	if r.notify != nil {
+		go r.notify(r.unreported)
+	}
+	r.unreported = 0
+	r.lastReported = time.Now()
+}
+
+// Dropped returns the number of messages dropped so far.
+func (r *RingLogger) Dropped() uint64 {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.dropped
+}
+
+// Name returns the name of the logging driver.
+func (r *RingLogger) Name() string {
+	return r.l.Name()
+}
+
+// Close delivers the buffered messages and closes the logging driver.
+func (r *RingLogger) Close() error {
+	r.mu.Lock()
+	if r.closed {
+		r.mu.Unlock()
+		return nil
+	}
+	r.closed = true
+	r.report()
+	r.wait.Signal()
+	r.mu.Unlock()
+
+	<-r.done
+	return r.l.Close()
+}
+
+func (r *RingLogger) run() {
+	defer close(r.done)
+	for {
+		r.mu.Lock()
+		for len(r.queue) == 0 && !r.closed {
+			r.wait.Wait()
+		}
+		if len(r.queue) == 0 {
+			// Closed, and every message was delivered.
+			r.mu.Unlock()
+			return
+		}
+		msg := r.queue[0]
+		r.queue[0] = nil
+		r.queue = r.queue[1:]
+		r.size -= int64(len(msg.Line))
+		r.mu.Unlock()
+
+		if err := r.l.Log(msg); err != nil {
+			logrus.Errorf("Failed to log msg %q for logger %s: %s", msg.Line, r.l.Name(), err)
+		}
+	}
+}
+
+// DroppedMessages returns the number of messages dropped by l so far, if it
+// is a logger in non-blocking mode.
+func DroppedMessages(l Logger) uint64 {
+	switch r := l.(type) {
+	case *RingLogger:
+		return r.Dropped()
+	case *ringWithReader:
+		return r.Dropped()
+	}
+	return 0
+}
@@ -0,0 +1,124 @@
+package logger
+
+import (
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+)
+
+// blockingLogger records messages once it is unblocked.
+type blockingLogger struct {
+	unblock chan struct{}
+	mu      sync.Mutex
+	lines   []string
+	closed  bool
+}
+
+func (l *blockingLogger) Log(m *Message) error {
+	<-l.unblock
+	l.mu.Lock()
+	l.lines = append(l.lines, string(m.Line))
+	l.mu.Unlock()
+	return nil
+}
+
+func (l *blockingLogger) Close() error {
+	l.closed = true
+	return nil
+}
+
+func (l *blockingLogger) Name() string { return "blocking" }
+
+func TestRingLoggerDropsOldest(t *testing.T) {
+	l := &blockingLogger{unblock: make(chan struct{})}
+	var (
+		mu       sync.Mutex
+		notified uint64
+	)
+	r := NewRingLogger(l, 10, func(dropped uint64) {
+		mu.Lock()
+		notified += dropped
+		mu.Unlock()
+	})
+
+	done := make(chan struct{})
+	go func() {
+		// Each message is 5 bytes long; the first one is taken by the
+		// blocked driver, the buffer holds two of the others.
+		for i := 0; i < 6; i++ {
+			if err := r.Log(&Message{Line: []byte("line" + strconv.Itoa(i))}); err != nil {
+				t.Error(err)
+			}
+		}
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("logging blocked on the driver")
+	}
+
+	close(l.unblock)
+	if err := r.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if !l.closed {
+		t.Fatal("expected the driver to be closed")
+	}
+
+	if len(l.lines) < 2 || l.lines[len(l.lines)-2] != "line4" || l.lines[len(l.lines)-1] != "line5" {
+		t.Fatalf("expected the latest messages to be delivered, got %q", l.lines)
+	}
+	dropped := DroppedMessages(r)
+	if dropped == 0 || dropped != uint64(6-len(l.lines)) {
+		t.Fatalf("expected %d dropped messages, got %d", 6-len(l.lines), dropped)
+	}
+	// Drops are notified in the background.
+	for i := 0; ; i++ {
+		mu.Lock()
+		n := notified
+		mu.Unlock()
+		if n == dropped {
+			break
+		}
+		if i == 100 {
+			t.Fatalf("expected %d dropped messages to be notified, got %d", dropped, n)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	if err := r.Log(&Message{Line: []byte("late")}); err == nil {
+		t.Fatal("expected logging to a closed logger to fail")
+	}
+}
+
+func TestValidateModeOpts(t *testing.T) {
+	valid := []map[string]string{
+		{},
+		{ModeKey: BlockingMode},
+		{ModeKey: NonBlockingMode},
+		{ModeKey: NonBlockingMode, MaxBufferSizeKey: "4m"},
+	}
+	for _, cfg := range valid {
+		if err := validateModeOpts(cfg); err != nil {
+			t.Fatalf("expected %v to be valid, got %v", cfg, err)
+		}
+	}
+
+	invalid := []map[string]string{
+		{ModeKey: "async"},
+		{MaxBufferSizeKey: "4m"},
+		{ModeKey: NonBlockingMode, MaxBufferSizeKey: "lots"},
+		{ModeKey: NonBlockingMode, MaxBufferSizeKey: "0"},
+	}
+	for _, cfg := range invalid {
+		if err := validateModeOpts(cfg); err == nil {
+			t.Fatalf("expected %v to be invalid", cfg)
+		}
+	}
+
+	if nonBlocking, size := IsNonBlocking(map[string]string{ModeKey: NonBlockingMode}); !nonBlocking || size != DefaultMaxBufferSize {
+		t.Fatalf("expected the default buffer size, got %v, %d", nonBlocking, size)
+	}
+}
@@ -40,7 +40,6 @@ func (daemon *Daemon) ContainerLogs(ctx context.Context, containerName string, c
 		return logger.ErrReadLogsNotSupported
 	}
 
-	follow := config.Follow && container.IsRunning()
 	tailLines, err := strconv.Atoi(config.Tail)
 	if err != nil {
 		tailLines = -1
@@ -56,8 +55,19 @@ func (daemon *Daemon) ContainerLogs(ctx context.Context, containerName string, c
 		}
 		since = time.Unix(s, n)
 	}
+	var until time.Time
+	if config.Until != "" {
+		s, n, err := timetypes.ParseTimestamps(config.Until, 0)
+		if err != nil {
+			return err
+		}
+		until = time.Unix(s, n)
+	}
+	// There is nothing to follow once the end of the window has passed.
+	follow := config.Follow && container.IsRunning() && (until.IsZero() || until.After(time.Now()))
 	readConfig := logger.ReadConfig{
 		Since:  since,
+		Until:  until,
 		Tail:   tailLines,
 		Follow: follow,
 	}
@@ -131,16 +141,24 @@ func (daemon *Daemon) StartLogging(container *container.Container) error {
 		return fmt.Errorf("Failed to initialize logging driver: %v", err)
 	}
 
-	copier := logger.NewCopier(map[string]io.Reader{"stdout": container.StdoutPipe(), "stderr": container.StderrPipe()}, l)
-	container.LogCopier = copier
-	copier.Run()
-	container.LogDriver = l
-
 	// set LogPath field only for json-file logdriver
 	if jl, ok := l.(*jsonfilelog.JSONFileLogger); ok {
 		container.LogPath = jl.LogPath()
 	}
 
+	if nonBlocking, size := logger.IsNonBlocking(container.HostConfig.LogConfig.Config); nonBlocking {
+		l = logger.NewRingLogger(l, size, func(dropped uint64) {
+			daemon.LogContainerEventWithAttributes(container, "log_drop", map[string]string{
+				"dropped": strconv.FormatUint(dropped, 10),
+			})
+		})
+	}
+
+	copier := logger.NewCopier(map[string]io.Reader{"stdout": container.StdoutPipe(), "stderr": container.StderrPipe()}, l)
+	container.LogCopier = copier
+	copier.Run()
+	container.LogDriver = l
+
 	return nil
 }
 
@@ -1,8 +1,15 @@
 package daemon
 
 import (
+	"bytes"
+	"io/ioutil"
+	"os"
 	"testing"
+	"time"
 
+	"github.com/docker/docker/container"
+	"github.com/docker/docker/daemon/events"
+	"github.com/docker/docker/daemon/logger"
 	containertypes "github.com/docker/engine-api/types/container"
 )
 
@@ -13,3 +20,87 @@ func TestMergeAndVerifyLogConfigNilConfig(t *testing.T) {
 		t.Fatal(err)
 	}
 }
+
+// stalledLogger is a logging driver blocking every message until released.
+type stalledLogger struct {
+	release chan struct{}
+}
+
+func (l *stalledLogger) Log(*logger.Message) error {
+	<-l.release
+	return nil
+}
+
+func (l *stalledLogger) Name() string {
+	return "stalled"
+}
+
+func (l *stalledLogger) Close() error {
+	return nil
+}
+
+func TestStartLoggingNonBlocking(t *testing.T) {
+	stalled := &stalledLogger{release: make(chan struct{})}
+	if err := logger.RegisterLogDriver("stalled", func(logger.Context) (logger.Logger, error) {
+		return stalled, nil
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	root, err := ioutil.TempDir("", "docker-logs-test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(root)
+
+	c := container.NewBaseContainer("logs-test", root)
+	c.Config = &containertypes.Config{}
+	c.HostConfig = &containertypes.HostConfig{
+		LogConfig: containertypes.LogConfig{
+			Type: "stalled",
+			Config: map[string]string{
+				logger.ModeKey:          logger.NonBlockingMode,
+				logger.MaxBufferSizeKey: "1k",
+			},
+		},
+	}
+
+	d := &Daemon{EventsService: events.New()}
+	if err := d.StartLogging(c); err != nil {
+		t.Fatal(err)
+	}
+	if c.LogDriver == logger.Logger(stalled) {
+		t.Fatal("expected the logging driver to be wrapped in non-blocking mode")
+	}
+
+	// With a stalled driver, writing more than the buffer holds must not
+	// block the container and must drop messages.
+	line := append(bytes.Repeat([]byte("x"), 99), '\n')
+	written := make(chan struct{})
+	go func() {
+		for i := 0; i < 100; i++ {
+			c.StreamConfig.Stdout().Write(line)
+		}
+		close(written)
+	}()
+	select {
+	case <-written:
+	case <-time.After(10 * time.Second):
+		t.Fatal("writing to the container output blocked on the logging driver")
+	}
+
+	deadline := time.Now().Add(10 * time.Second)
+	for c.DroppedLogMessages() == 0 {
+		if time.Now().After(deadline) {
+			t.Fatal("expected messages to be dropped")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	close(stalled.release)
+	c.StreamConfig.CloseStreams()
+	c.LogCopier.Wait()
+	if err := c.LogDriver.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,98 @@
+package daemon
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/Sirupsen/logrus"
+	"github.com/docker/docker/distribution/peer"
+	"github.com/docker/docker/pkg/discovery"
+
+	// Register the file and nodes backends for peer discovery.
+	_ "github.com/docker/docker/pkg/discovery/file"
+	_ "github.com/docker/docker/pkg/discovery/nodes"
+)
+
+// initPeers starts serving the layers of the daemon to its peers, and makes
+// pulls fetch layers from the peers before the registry, as configured.
+func (daemon *Daemon) initPeers(config *Config) error {
+	if config.PeerListen == "" && config.PeerDiscovery == "" {
+		return nil
+	}
+	secret, err := readPeerSecret(config.PeerSecretFile)
+	if err != nil {
+		return err
+	}
+
+	if config.PeerListen != "" {
+		l, err := net.Listen("tcp", config.PeerListen)
+		if err != nil {
+			return fmt.Errorf("failed to listen for peers: %v", err)
+		}
+		daemon.peerListener = l
+		server := peer.NewServer(secret, daemon.imageStore, daemon.layerStore, daemon.blobCache)
+		go func() {
+			if err := http.Serve(l, server); err != nil && !daemon.IsShuttingDown() {
+				logrus.Errorf("Serving layers to peers failed: %v", err)
+			}
+		}()
+		logrus.Infof("Serving layers to peers on %s", l.Addr())
+	}
+
+	if config.PeerDiscovery != "" {
+		backend, err := discovery.NewInstance(config.PeerDiscovery, defaultDiscoveryHeartbeat, defaultDiscoveryTTLFactor*defaultDiscoveryHeartbeat, nil)
+		if err != nil {
+			return fmt.Errorf("peer discovery initialization failed (%v)", err)
+		}
+
+		var advertise string
+		if config.PeerAdvertise != "" {
+			advertise, err = discovery.ParseAdvertise(config.PeerAdvertise)
+			if err != nil {
+				return err
+			}
+			advertiser := &daemonDiscoveryReloader{
+				backend: backend,
+				ticker:  time.NewTicker(defaultDiscoveryHeartbeat),
+				term:    make(chan bool),
+				readyCh: make(chan struct{}),
+			}
+			go advertiser.advertiseHeartbeat(advertise)
+			daemon.peerAdvertiser = advertiser
+		}
+
+		daemon.peers = peer.NewPeers(backend, advertise, secret)
+		daemon.downloadManager.SetPeerSource(daemon.peers)
+	}
+	return nil
+}
+
+// stopPeers stops serving layers to the peers and watching for them.
+func (daemon *Daemon) stopPeers() {
+	if daemon.peerListener != nil {
+		daemon.peerListener.Close()
+	}
+	if daemon.peerAdvertiser != nil {
+		daemon.peerAdvertiser.Stop()
+	}
+	if daemon.peers != nil {
+		daemon.peers.Stop()
+	}
+}
+
+func readPeerSecret(path string) ([]byte, error) {
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the peer secret: %v", err)
+	}
+	secret := bytes.TrimSpace(b)
+	if len(secret) == 0 {
+		return nil, errors.New("the peer secret file is empty")
+	}
+	return secret, nil
+}
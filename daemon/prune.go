@@ -0,0 +1,320 @@
+package daemon
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/Sirupsen/logrus"
+	"github.com/docker/docker/container"
+	"github.com/docker/docker/image"
+	"github.com/docker/docker/layer"
+	"github.com/docker/docker/pkg/directory"
+	"github.com/docker/docker/reference"
+	"github.com/docker/docker/runconfig"
+	"github.com/docker/docker/volume"
+	"github.com/docker/engine-api/types"
+	"github.com/docker/engine-api/types/filters"
+	timetypes "github.com/docker/engine-api/types/time"
+	"github.com/docker/libnetwork"
+)
+
+var (
+	acceptedContainersPruneFilters = map[string]bool{
+		"label": true,
+		"until": true,
+	}
+	acceptedImagesPruneFilters = map[string]bool{
+		"dangling": true,
+		"label":    true,
+		"until":    true,
+	}
+	// Volumes and networks don't record when they were created.
+	acceptedVolumesPruneFilters = map[string]bool{
+		"label": true,
+	}
+	acceptedNetworksPruneFilters = map[string]bool{
+		"label": true,
+	}
+)
+
+// ContainersPrune removes the stopped containers matching the filters, and
+// returns them with the space freed by their writable layers.
+func (daemon *Daemon) ContainersPrune(pruneFilters filters.Args) (*types.ContainersPruneReport, error) {
+	if err := pruneFilters.Validate(acceptedContainersPruneFilters); err != nil {
+		return nil, err
+	}
+	until, err := getUntilFromPruneFilters(pruneFilters)
+	if err != nil {
+		return nil, err
+	}
+
+	// The label filters are the same as the ones of the container list.
+	listFilters := filters.NewArgs()
+	for _, label := range pruneFilters.Get("label") {
+		listFilters.Add("label", label)
+	}
+	for _, status := range []string{"created", "exited", "dead"} {
+		listFilters.Add("status", status)
+	}
+	config := &types.ContainerListOptions{All: true, Filter: listFilters}
+	sizes := map[string]int64{}
+	containers, err := daemon.reduceContainers(config, func(c *container.Container, ctx *listContext) (*types.Container, error) {
+		if !until.IsZero() && !c.Created.Before(until) {
+			return nil, nil
+		}
+		sizes[c.ID], _ = daemon.getSize(c)
+		return &types.Container{ID: c.ID}, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	rep := &types.ContainersPruneReport{}
+	for _, c := range containers {
+		if err := daemon.ContainerRm(c.ID, &types.ContainerRmConfig{}); err != nil {
+			logrus.Warnf("could not remove container %s: %v", c.ID, err)
+			continue
+		}
+		rep.SpaceReclaimed += uint64(sizes[c.ID])
+		rep.ContainersDeleted = append(rep.ContainersDeleted, c.ID)
+	}
+	return rep, nil
+}
+
+// VolumesPrune removes the local volumes which are not used by any container
+// and match the filters.
+func (daemon *Daemon) VolumesPrune(pruneFilters filters.Args) (*types.VolumesPruneReport, error) {
+	if err := pruneFilters.Validate(acceptedVolumesPruneFilters); err != nil {
+		return nil, err
+	}
+
+	vols, err := daemon.volumes.FilterByDriver(volume.DefaultDriverName)
+	if err != nil {
+		return nil, err
+	}
+
+	rep := &types.VolumesPruneReport{}
+	for _, v := range daemon.volumes.FilterByUsed(vols, false) {
+		var labels map[string]string
+		if lv, ok := v.(volume.LabeledVolume); ok {
+			labels = lv.Labels()
+		}
+		if !pruneFilters.MatchKVList("label", labels) {
+			continue
+		}
+
+		size, err := directory.Size(v.Path())
+		if err != nil {
+			logrus.Warnf("could not determine size of volume %s: %v", v.Name(), err)
+		}
+		if err := daemon.volumes.Remove(v); err != nil {
+			logrus.Warnf("could not remove volume %s: %v", v.Name(), err)
+			continue
+		}
+		daemon.LogVolumeEvent(v.Name(), "destroy", map[string]string{"driver": v.DriverName()})
+		rep.SpaceReclaimed += uint64(size)
+		rep.VolumesDeleted = append(rep.VolumesDeleted, v.Name())
+	}
+	return rep, nil
+}
+
+// ImagesPrune removes the images which are not used by any container and
+// match the filters. Only dangling images are removed unless the filters
+// include dangling=false.
+func (daemon *Daemon) ImagesPrune(pruneFilters filters.Args) (*types.ImagesPruneReport, error) {
+	if err := pruneFilters.Validate(acceptedImagesPruneFilters); err != nil {
+		return nil, err
+	}
+	until, err := getUntilFromPruneFilters(pruneFilters)
+	if err != nil {
+		return nil, err
+	}
+
+	danglingOnly := true
+	if pruneFilters.Include("dangling") {
+		if pruneFilters.ExactMatch("dangling", "false") || pruneFilters.ExactMatch("dangling", "0") {
+			danglingOnly = false
+		} else if !pruneFilters.ExactMatch("dangling", "true") && !pruneFilters.ExactMatch("dangling", "1") {
+			return nil, fmt.Errorf("Invalid filter 'dangling=%s'", pruneFilters.Get("dangling"))
+		}
+	}
+
+	var allImages map[image.ID]*image.Image
+	if danglingOnly {
+		allImages = daemon.imageStore.Heads()
+	} else {
+		allImages = daemon.imageStore.Map()
+	}
+
+	used := map[image.ID]bool{}
+	for _, c := range daemon.List() {
+		used[c.ImageID] = true
+	}
+
+	// The layers are looked up before they are released by the removal of
+	// the images.
+	allLayers := daemon.layerStore.Map()
+
+	// Children are removed before their parents, which can only be removed
+	// once they have no children left.
+	candidates := byImageDepth{depths: map[image.ID]int{}}
+	for id := range allImages {
+		candidates.ids = append(candidates.ids, id)
+		candidates.depths[id] = daemon.imageDepth(id)
+	}
+	sort.Sort(sort.Reverse(candidates))
+
+	rep := &types.ImagesPruneReport{}
+	for _, id := range candidates.ids {
+		img := allImages[id]
+		if used[id] {
+			continue
+		}
+		if _, err := daemon.imageStore.Get(id); err != nil {
+			// Removed along with one of its children.
+			continue
+		}
+		if len(daemon.imageStore.Children(id)) > 0 {
+			continue
+		}
+		if !until.IsZero() && !img.Created.Before(until) {
+			continue
+		}
+		var labels map[string]string
+		if img.Config != nil {
+			labels = img.Config.Labels
+		}
+		if !pruneFilters.MatchKVList("label", labels) {
+			continue
+		}
+
+		refs := daemon.referenceStore.References(id)
+		if danglingOnly && hasTag(refs) {
+			continue
+		}
+
+		var deleted []types.ImageDelete
+		if len(refs) > 0 {
+			for _, ref := range refs {
+				records, err := daemon.ImageDelete(ref.String(), false, true)
+				if err != nil {
+					logrus.Warnf("could not remove image %s: %v", ref.String(), err)
+					continue
+				}
+				deleted = append(deleted, records...)
+			}
+		} else {
+			records, err := daemon.ImageDelete(id.String(), false, true)
+			if err != nil {
+				logrus.Warnf("could not remove image %s: %v", id.String(), err)
+				continue
+			}
+			deleted = records
+		}
+		rep.ImagesDeleted = append(rep.ImagesDeleted, deleted...)
+	}
+
+	// The removed layers are recorded as deleted with their ChainID.
+	for _, d := range rep.ImagesDeleted {
+		if d.Deleted == "" {
+			continue
+		}
+		if l, ok := allLayers[layer.ChainID(d.Deleted)]; ok {
+			size, err := l.DiffSize()
+			if err != nil {
+				logrus.Warnf("could not determine size of layer %s: %v", d.Deleted, err)
+				continue
+			}
+			rep.SpaceReclaimed += uint64(size)
+		}
+	}
+	return rep, nil
+}
+
+// NetworksPrune removes the local networks without any endpoint which match
+// the filters. The predefined networks and the networks managed by the swarm
+// are kept.
+func (daemon *Daemon) NetworksPrune(pruneFilters filters.Args) (*types.NetworksPruneReport, error) {
+	if err := pruneFilters.Validate(acceptedNetworksPruneFilters); err != nil {
+		return nil, err
+	}
+
+	var networks []libnetwork.Network
+	daemon.netController.WalkNetworks(func(nw libnetwork.Network) bool {
+		if runconfig.IsPreDefinedNetwork(nw.Name()) || nw.Info().Dynamic() || len(nw.Endpoints()) > 0 {
+			return false
+		}
+		if pruneFilters.MatchKVList("label", nw.Info().Labels()) {
+			networks = append(networks, nw)
+		}
+		return false
+	})
+
+	rep := &types.NetworksPruneReport{}
+	for _, nw := range networks {
+		if err := daemon.DeleteNetwork(nw.ID()); err != nil {
+			logrus.Warnf("could not remove network %s: %v", nw.Name(), err)
+			continue
+		}
+		rep.NetworksDeleted = append(rep.NetworksDeleted, nw.Name())
+	}
+	return rep, nil
+}
+
+// imageDepth returns the number of ancestors of the image.
+func (daemon *Daemon) imageDepth(id image.ID) int {
+	depth := 0
+	for {
+		parent, err := daemon.imageStore.GetParent(id)
+		if err != nil || parent == "" {
+			return depth
+		}
+		id = parent
+		depth++
+	}
+}
+
+// byImageDepth sorts images by their number of ancestors.
+type byImageDepth struct {
+	ids    []image.ID
+	depths map[image.ID]int
+}
+
+func (r byImageDepth) Len() int           { return len(r.ids) }
+func (r byImageDepth) Swap(i, j int)      { r.ids[i], r.ids[j] = r.ids[j], r.ids[i] }
+func (r byImageDepth) Less(i, j int) bool { return r.depths[r.ids[i]] < r.depths[r.ids[j]] }
+
+// hasTag returns whether one of refs is a tag, rather than a digest.
+func hasTag(refs []reference.Named) bool {
+	for _, ref := range refs {
+		if _, ok := ref.(reference.NamedTagged); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// getUntilFromPruneFilters returns the time of the until filter, before which
+// objects must have been created to be pruned, or the zero time if there is
+// no such filter.
+func getUntilFromPruneFilters(pruneFilters filters.Args) (time.Time, error) {
+	untilFilters := pruneFilters.Get("until")
+	switch len(untilFilters) {
+	case 0:
+		return time.Time{}, nil
+	case 1:
+	default:
+		return time.Time{}, fmt.Errorf("more than one until filter specified")
+	}
+
+	ts, err := timetypes.GetTimestamp(untilFilters[0], time.Now())
+	if err != nil {
+		return time.Time{}, err
+	}
+	seconds, nanoseconds, err := timetypes.ParseTimestamps(ts, 0)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return time.Unix(seconds, nanoseconds), nil
+}
@@ -0,0 +1,40 @@
+package daemon
+
+import (
+	"testing"
+	"time"
+
+	"github.com/docker/engine-api/types/filters"
+)
+
+func TestGetUntilFromPruneFilters(t *testing.T) {
+	until, err := getUntilFromPruneFilters(filters.NewArgs())
+	if err != nil || !until.IsZero() {
+		t.Fatalf("expected no until time, got %v, %v", until, err)
+	}
+
+	f := filters.NewArgs()
+	f.Add("until", "1475000000")
+	until, err = getUntilFromPruneFilters(f)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !until.Equal(time.Unix(1475000000, 0)) {
+		t.Fatalf("unexpected until time %v", until)
+	}
+
+	f = filters.NewArgs()
+	f.Add("until", "1h")
+	until, err = getUntilFromPruneFilters(f)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if d := time.Since(until); d < time.Hour || d > time.Hour+time.Minute {
+		t.Fatalf("expected an hour ago, got %v", until)
+	}
+
+	f.Add("until", "2h")
+	if _, err := getUntilFromPruneFilters(f); err == nil {
+		t.Fatal("expected an error with two until filters")
+	}
+}
@@ -0,0 +1,29 @@
+package daemon
+
+import (
+	"fmt"
+	"runtime"
+
+	"github.com/docker/docker/container"
+)
+
+// SetContainerSecrets sets the secrets exposed to a container by the swarm.
+// They are mounted on a tmpfs when the container starts.
+func (daemon *Daemon) SetContainerSecrets(name string, secrets []*container.Secret) error {
+	if !secretsSupported() {
+		if len(secrets) > 0 {
+			return fmt.Errorf("secrets are not supported on %s", runtime.GOOS)
+		}
+		return nil
+	}
+
+	c, err := daemon.GetContainer(name)
+	if err != nil {
+		return err
+	}
+
+	c.Lock()
+	c.Secrets = secrets
+	c.Unlock()
+	return nil
+}
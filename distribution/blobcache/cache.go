@@ -0,0 +1,283 @@
+// Package blobcache implements a local cache of compressed registry blobs,
+// keyed by digest, so that a blob is not downloaded again when it is pulled
+// from another registry or under another name.
+package blobcache
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/Sirupsen/logrus"
+	"github.com/docker/distribution/digest"
+)
+
+// ErrNotFound is returned when a blob is not in the cache.
+var ErrNotFound = errors.New("blob not in cache")
+
+type entry struct {
+	size     int64
+	lastUsed time.Time
+}
+
+// Cache stores compressed blobs on disk, up to a maximum total size. When
+// the cache is full, the least recently used blobs are evicted first. The
+// time a blob was last used is kept as the modification time of its file,
+// so the eviction order survives restarts. Cache is goroutine-safe.
+type Cache struct {
+	mu      sync.Mutex
+	root    string
+	maxSize int64
+	size    int64
+	entries map[digest.Digest]*entry
+}
+
+// New creates a cache in root holding up to maxSize bytes, and indexes the
+// blobs already stored there.
+func New(root string, maxSize int64) (*Cache, error) {
+	if err := os.MkdirAll(root, 0700); err != nil {
+		return nil, err
+	}
+	c := &Cache{
+		root:    root,
+		maxSize: maxSize,
+		entries: make(map[digest.Digest]*entry),
+	}
+
+	algorithms, err := ioutil.ReadDir(root)
+	if err != nil {
+		return nil, err
+	}
+	for _, algorithm := range algorithms {
+		if !algorithm.IsDir() {
+			continue
+		}
+		files, err := ioutil.ReadDir(filepath.Join(root, algorithm.Name()))
+		if err != nil {
+			return nil, err
+		}
+		for _, f := range files {
+			dgst := digest.NewDigestFromHex(algorithm.Name(), f.Name())
+			if f.IsDir() || dgst.Validate() != nil {
+				// Leftover of an interrupted Add.
+				os.RemoveAll(filepath.Join(root, algorithm.Name(), f.Name()))
+				continue
+			}
+			c.entries[dgst] = &entry{size: f.Size(), lastUsed: f.ModTime()}
+			c.size += f.Size()
+		}
+	}
+
+	c.mu.Lock()
+	c.evict()
+	c.mu.Unlock()
+	return c, nil
+}
+
+func (c *Cache) path(dgst digest.Digest) string {
+	return filepath.Join(c.root, string(dgst.Algorithm()), dgst.Hex())
+}
+
+// Open returns a reader for the cached blob and its size, or ErrNotFound.
+// The content is verified against the digest before it is returned: a
+// corrupted blob is evicted and an error is returned, so that the caller can
+// fetch the blob again instead.
+func (c *Cache) Open(dgst digest.Digest) (io.ReadCloser, int64, error) {
+	c.mu.Lock()
+	e, ok := c.entries[dgst]
+	if !ok {
+		c.mu.Unlock()
+		return nil, 0, ErrNotFound
+	}
+	f, err := os.Open(c.path(dgst))
+	if err != nil {
+		c.remove(dgst)
+		c.mu.Unlock()
+		if os.IsNotExist(err) {
+			return nil, 0, ErrNotFound
+		}
+		return nil, 0, err
+	}
+	e.lastUsed = time.Now()
+	if err := os.Chtimes(f.Name(), e.lastUsed, e.lastUsed); err != nil {
+		logrus.Debugf("failed to update access time of cached blob %s: %v", dgst, err)
+	}
+	size := e.size
+	c.mu.Unlock()
+
+	// The blob is read once more to verify it, without holding the lock.
+	if err := verify(f, dgst); err != nil {
+		f.Close()
+		logrus.Errorf("cached blob %s is corrupted, evicting it: %v", dgst, err)
+		c.Remove(dgst)
+		return nil, 0, err
+	}
+	return f, size, nil
+}
+
+// Contains returns whether the blob is in the cache.
+func (c *Cache) Contains(dgst digest.Digest) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	_, ok := c.entries[dgst]
+	return ok
+}
+
+// Add moves the file at path, whose content must have been verified against
+// dgst, into the cache. The file is copied if it cannot be moved. In both
+// cases the file at path no longer exists once Add returns.
+func (c *Cache) Add(dgst digest.Digest, path string) error {
+	defer os.Remove(path)
+
+	fi, err := os.Stat(path)
+	if err != nil {
+		return err
+	}
+	if fi.Size() > c.maxSize {
+		return nil
+	}
+
+	dest := c.path(dgst)
+	if err := os.MkdirAll(filepath.Dir(dest), 0700); err != nil {
+		return err
+	}
+	// Blobs are moved in under a unique temporary name, so that a partially
+	// copied blob is never mistaken for a complete one and concurrent adds of
+	// the same digest don't clobber each other.
+	tf, err := ioutil.TempFile(filepath.Dir(dest), ".tmp-")
+	if err != nil {
+		return err
+	}
+	tmp := tf.Name()
+	tf.Close()
+	if err := os.Rename(path, tmp); err != nil {
+		if err := copyFile(path, tmp); err != nil {
+			os.Remove(tmp)
+			return err
+		}
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if err := os.Rename(tmp, dest); err != nil {
+		os.Remove(tmp)
+		return err
+	}
+	now := time.Now()
+	os.Chtimes(dest, now, now)
+	if e, ok := c.entries[dgst]; ok {
+		c.size -= e.size
+	}
+	c.entries[dgst] = &entry{size: fi.Size(), lastUsed: now}
+	c.size += fi.Size()
+	c.evict()
+	return nil
+}
+
+// Remove evicts the blob from the cache.
+func (c *Cache) Remove(dgst digest.Digest) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if _, ok := c.entries[dgst]; !ok {
+		return ErrNotFound
+	}
+	return c.remove(dgst)
+}
+
+// Size returns the total size of the cached blobs.
+func (c *Cache) Size() int64 {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.size
+}
+
+// remove must be called with c.mu held.
+func (c *Cache) remove(dgst digest.Digest) error {
+	if e, ok := c.entries[dgst]; ok {
+		c.size -= e.size
+		delete(c.entries, dgst)
+	}
+	if err := os.Remove(c.path(dgst)); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	return nil
+}
+
+// evict removes the least recently used blobs until the cache fits in its
+// maximum size. It must be called with c.mu held.
+func (c *Cache) evict() {
+	if c.size <= c.maxSize {
+		return
+	}
+
+	dgsts := make([]digest.Digest, 0, len(c.entries))
+	for dgst := range c.entries {
+		dgsts = append(dgsts, dgst)
+	}
+	sort.Sort(byLastUsed{dgsts: dgsts, entries: c.entries})
+
+	for _, dgst := range dgsts {
+		if c.size <= c.maxSize {
+			break
+		}
+		logrus.Debugf("evicting blob %s from cache", dgst)
+		if err := c.remove(dgst); err != nil {
+			logrus.Errorf("Failed to evict blob %s from cache: %v", dgst, err)
+		}
+	}
+}
+
+type byLastUsed struct {
+	dgsts   []digest.Digest
+	entries map[digest.Digest]*entry
+}
+
+func (b byLastUsed) Len() int      { return len(b.dgsts) }
+func (b byLastUsed) Swap(i, j int) { b.dgsts[i], b.dgsts[j] = b.dgsts[j], b.dgsts[i] }
+func (b byLastUsed) Less(i, j int) bool {
+	return b.entries[b.dgsts[i]].lastUsed.Before(b.entries[b.dgsts[j]].lastUsed)
+}
+
+// verify checks the content of f against dgst, and rewinds f.
+func verify(f *os.File, dgst digest.Digest) error {
+	verifier, err := digest.NewDigestVerifier(dgst)
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(verifier, f); err != nil {
+		return err
+	}
+	if !verifier.Verified() {
+		return fmt.Errorf("cached blob verification failed for digest %s", dgst)
+	}
+	_, err = f.Seek(0, os.SEEK_SET)
+	return err
+}
+
+func copyFile(src, dest string) error {
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+
+	out, err := os.OpenFile(dest, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0600)
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(out, in); err != nil {
+		out.Close()
+		return err
+	}
+	return out.Close()
+}
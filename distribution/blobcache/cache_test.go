@@ -0,0 +1,184 @@
+package blobcache
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/docker/distribution/digest"
+)
+
+func addBlob(t *testing.T, c *Cache, content string) digest.Digest {
+	f, err := ioutil.TempFile("", "blob")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := f.WriteString(content); err != nil {
+		t.Fatal(err)
+	}
+	f.Close()
+
+	dgst := digest.FromBytes([]byte(content))
+	if err := c.Add(dgst, f.Name()); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := os.Stat(f.Name()); !os.IsNotExist(err) {
+		t.Fatalf("expected the added file to be moved, got %v", err)
+	}
+	return dgst
+}
+
+func TestAddOpen(t *testing.T) {
+	root, err := ioutil.TempDir("", "blobcache")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(root)
+
+	c, err := New(root, 1024)
+	if err != nil {
+		t.Fatal(err)
+	}
+	dgst := addBlob(t, c, "layer content")
+
+	rc, size, err := c.Open(dgst)
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, err := ioutil.ReadAll(rc)
+	rc.Close()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(b) != "layer content" || size != int64(len(b)) {
+		t.Fatalf("unexpected cached blob %q of size %d", b, size)
+	}
+
+	if _, _, err := c.Open(digest.FromBytes([]byte("other"))); err != ErrNotFound {
+		t.Fatalf("expected ErrNotFound, got %v", err)
+	}
+
+	// A new cache on the same directory finds the blob.
+	c, err = New(root, 1024)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !c.Contains(dgst) || c.Size() != int64(len("layer content")) {
+		t.Fatalf("expected the blob to be indexed on restart")
+	}
+}
+
+func TestConcurrentAddSameDigest(t *testing.T) {
+	root, err := ioutil.TempDir("", "blobcache")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(root)
+
+	c, err := New(root, 1024)
+	if err != nil {
+		t.Fatal(err)
+	}
+	content := []byte("layer content")
+	dgst := digest.FromBytes(content)
+
+	var wg sync.WaitGroup
+	errs := make(chan error, 10)
+	for i := 0; i < 10; i++ {
+		f, err := ioutil.TempFile("", "blob")
+		if err != nil {
+			t.Fatal(err)
+		}
+		f.Write(content)
+		f.Close()
+		wg.Add(1)
+		go func(path string) {
+			defer wg.Done()
+			errs <- c.Add(dgst, path)
+		}(f.Name())
+	}
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if c.Size() != int64(len(content)) {
+		t.Fatalf("expected cache size %d, got %d", len(content), c.Size())
+	}
+	files, err := ioutil.ReadDir(filepath.Join(root, string(dgst.Algorithm())))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(files) != 1 {
+		t.Fatalf("expected only the cached blob, got %d files", len(files))
+	}
+}
+
+func TestEvictLeastRecentlyUsed(t *testing.T) {
+	root, err := ioutil.TempDir("", "blobcache")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(root)
+
+	c, err := New(root, 20)
+	if err != nil {
+		t.Fatal(err)
+	}
+	first := addBlob(t, c, "0123456789")
+	second := addBlob(t, c, "abcdefghij")
+
+	// Make the first blob the most recently used.
+	past := time.Now().Add(-time.Minute)
+	c.entries[second].lastUsed = past
+	rc, _, err := c.Open(first)
+	if err != nil {
+		t.Fatal(err)
+	}
+	rc.Close()
+
+	third := addBlob(t, c, "ABCDEFGHIJ")
+	if !c.Contains(first) || c.Contains(second) || !c.Contains(third) {
+		t.Fatalf("expected the least recently used blob to be evicted")
+	}
+	if c.Size() != 20 {
+		t.Fatalf("expected a cache size of 20, got %d", c.Size())
+	}
+
+	// Blobs larger than the cache are not kept.
+	large := addBlob(t, c, "this blob does not fit in the cache")
+	if c.Contains(large) {
+		t.Fatalf("expected a blob larger than the cache not to be cached")
+	}
+}
+
+func TestCorruptedBlobIsEvicted(t *testing.T) {
+	root, err := ioutil.TempDir("", "blobcache")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(root)
+
+	c, err := New(root, 1024)
+	if err != nil {
+		t.Fatal(err)
+	}
+	dgst := addBlob(t, c, "layer content")
+	path := filepath.Join(root, string(dgst.Algorithm()), dgst.Hex())
+	if err := ioutil.WriteFile(path, []byte("corrupted"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, _, err := c.Open(dgst); err == nil || err == ErrNotFound {
+		t.Fatalf("expected opening a corrupted blob to fail verification, got %v", err)
+	}
+	if c.Contains(dgst) {
+		t.Fatal("expected the corrupted blob to be evicted")
+	}
+}
@@ -47,6 +47,22 @@ func (f fallbackError) Cause() error {
 	return f.err
 }
 
+// endpointFailed returns true if the error shows that the endpoint itself is
+// unusable, as opposed to the registry answering that it cannot serve the
+// request, for example because the image does not exist.
+func endpointFailed(f fallbackError) bool {
+	if !f.transportOK {
+		return true
+	}
+	switch v := f.err.(type) {
+	case errcode.Errors, errcode.Error:
+		return false
+	case ErrNoSupport:
+		return endpointFailed(fallbackError{err: v.Err, transportOK: true})
+	}
+	return true
+}
+
 // shouldV2Fallback returns true if this error is a reason to fall back to v1.
 func shouldV2Fallback(err errcode.Error) bool {
 	switch err.Code {
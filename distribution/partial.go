@@ -0,0 +1,355 @@
+package distribution
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Sirupsen/logrus"
+	"github.com/docker/distribution/digest"
+	"github.com/docker/distribution/registry/client/transport"
+	"github.com/docker/docker/pkg/ioutils"
+	"github.com/docker/docker/pkg/progress"
+	"golang.org/x/net/context"
+)
+
+const (
+	// PartialDownloadMaxAge is how long a partially downloaded blob is kept
+	// without being resumed before CleanupPartialDownloads removes it.
+	PartialDownloadMaxAge = 7 * 24 * time.Hour
+
+	// downloadChunkSize is the size of the ranges requested in parallel
+	// when a blob is downloaded in chunks.
+	downloadChunkSize = 16 << 20
+	// minChunkedDownloadSize is the size from which blobs are downloaded
+	// in chunks, when parallel downloads are enabled.
+	minChunkedDownloadSize = 4 * downloadChunkSize
+
+	chunkStateSuffix = ".chunks"
+)
+
+// errRangeNotSupported is returned when the registry ignores range requests,
+// which makes a chunked download impossible.
+var errRangeNotSupported = errors.New("registry does not support range requests")
+
+// partialDownloadPath returns the file a blob is downloaded to in dir. Files
+// are named after the digest, so that any later pull of the same blob finds
+// and resumes the download.
+func partialDownloadPath(dir string, dgst digest.Digest) string {
+	return filepath.Join(dir, string(dgst.Algorithm()), dgst.Hex())
+}
+
+// openPartialDownload opens the partial download file of the blob, creating
+// it if needed. Its modification time is updated to keep it from being
+// cleaned up while in use.
+func openPartialDownload(dir string, dgst digest.Digest) (*os.File, error) {
+	path := partialDownloadPath(dir, dgst)
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return nil, err
+	}
+	f, err := os.OpenFile(path, os.O_RDWR|os.O_CREATE, 0600)
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	if err := os.Chtimes(path, now, now); err != nil {
+		logrus.Debugf("failed to update modification time of %s: %v", path, err)
+	}
+	return f, nil
+}
+
+// removePartialDownload removes the file of a partial download and its chunk
+// state, if any.
+func removePartialDownload(path string) error {
+	if err := os.Remove(path + chunkStateSuffix); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	return nil
+}
+
+// CleanupPartialDownloads removes the partial downloads of dir that have not
+// been resumed for longer than maxAge.
+func CleanupPartialDownloads(dir string, maxAge time.Duration) error {
+	deadline := time.Now().Add(-maxAge)
+	err := filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			if os.IsNotExist(err) {
+				return nil
+			}
+			return err
+		}
+		if info.IsDir() || strings.HasSuffix(path, chunkStateSuffix) || !info.ModTime().Before(deadline) {
+			return nil
+		}
+		logrus.Debugf("removing stale partial download %s", path)
+		return removePartialDownload(path)
+	})
+	if os.IsNotExist(err) {
+		return nil
+	}
+	return err
+}
+
+// chunkState records which chunks of a chunked download are complete. While
+// it exists, the size of the download file says nothing about the progress
+// of the download, since chunks are written at their offset.
+type chunkState struct {
+	Size      int64
+	ChunkSize int64
+	Done      []bool
+}
+
+func loadChunkState(path string) (*chunkState, error) {
+	b, err := ioutil.ReadFile(path + chunkStateSuffix)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	var state chunkState
+	if err := json.Unmarshal(b, &state); err != nil {
+		return nil, err
+	}
+	if state.ChunkSize <= 0 || int64(len(state.Done)) != (state.Size+state.ChunkSize-1)/state.ChunkSize {
+		return nil, errors.New("invalid chunk state")
+	}
+	return &state, nil
+}
+
+func (s *chunkState) save(path string) error {
+	b, err := json.Marshal(s)
+	if err != nil {
+		return err
+	}
+	return ioutils.AtomicWriteFile(path+chunkStateSuffix, b, 0600)
+}
+
+// newChunkState returns the state of a chunked download of size bytes, where
+// the first offset bytes were already downloaded sequentially.
+func newChunkState(size, offset int64) *chunkState {
+	s := &chunkState{
+		Size:      size,
+		ChunkSize: downloadChunkSize,
+		Done:      make([]bool, (size+downloadChunkSize-1)/downloadChunkSize),
+	}
+	for i := range s.Done {
+		if s.end(i) <= offset {
+			s.Done[i] = true
+		}
+	}
+	return s
+}
+
+func (s *chunkState) start(i int) int64 {
+	return int64(i) * s.ChunkSize
+}
+
+func (s *chunkState) end(i int) int64 {
+	end := s.start(i) + s.ChunkSize
+	if end > s.Size {
+		end = s.Size
+	}
+	return end
+}
+
+// downloaded returns the number of bytes in complete chunks.
+func (s *chunkState) downloaded() int64 {
+	var n int64
+	for i, done := range s.Done {
+		if done {
+			n += s.end(i) - s.start(i)
+		}
+	}
+	return n
+}
+
+// prefix returns the number of bytes downloaded from the beginning of the
+// blob without gap, from which a sequential download can resume.
+func (s *chunkState) prefix() int64 {
+	for i, done := range s.Done {
+		if !done {
+			return s.start(i)
+		}
+	}
+	return s.Size
+}
+
+// sequentialOffset returns the offset from which a sequential download of
+// the blob can resume. A chunked download left by a previous attempt is
+// truncated to its complete prefix.
+func sequentialOffset(f *os.File) (int64, error) {
+	state, err := loadChunkState(f.Name())
+	if err != nil {
+		logrus.Debugf("discarding chunk state of %s: %v", f.Name(), err)
+		state = &chunkState{}
+	}
+	if state != nil {
+		if err := f.Truncate(state.prefix()); err != nil {
+			return 0, err
+		}
+		if err := os.Remove(f.Name() + chunkStateSuffix); err != nil && !os.IsNotExist(err) {
+			return 0, err
+		}
+	}
+	return f.Seek(0, os.SEEK_END)
+}
+
+// offsetWriter writes to a file from an offset, counting the bytes written.
+type offsetWriter struct {
+	f       *os.File
+	offset  int64
+	written func(n int64)
+}
+
+func (w *offsetWriter) Write(p []byte) (int, error) {
+	n, err := w.f.WriteAt(p, w.offset)
+	w.offset += int64(n)
+	w.written(int64(n))
+	return n, err
+}
+
+// downloadChunks downloads the blob to f with up to ld.maxChunks parallel
+// range requests. Chunks completed by previous attempts, including previous
+// pulls, are not downloaded again; resumed reports whether there were any.
+func (ld *v2LayerDescriptor) downloadChunks(ctx context.Context, f *os.File, size int64, progressOutput progress.Output) (resumed bool, err error) {
+	path := f.Name()
+	state, err := loadChunkState(path)
+	if err != nil || (state != nil && state.Size != size) {
+		logrus.Debugf("discarding chunk state of %s: %v", path, err)
+		state = nil
+	}
+	if state == nil {
+		offset, err := f.Seek(0, os.SEEK_END)
+		if err != nil {
+			return false, err
+		}
+		if offset > size {
+			offset = 0
+		}
+		state = newChunkState(size, offset)
+		if err := f.Truncate(size); err != nil {
+			return false, err
+		}
+		if err := state.save(path); err != nil {
+			return false, err
+		}
+	}
+	resumed = state.downloaded() > 0
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var (
+		mu         sync.Mutex
+		wg         sync.WaitGroup
+		firstErr   error
+		downloaded = state.downloaded()
+		chunks     = make(chan int)
+	)
+	written := func(n int64) {
+		mu.Lock()
+		downloaded += n
+		mu.Unlock()
+	}
+	fail := func(err error) {
+		mu.Lock()
+		if firstErr == nil {
+			firstErr = err
+		}
+		mu.Unlock()
+		cancel()
+	}
+
+	for w := 0; w < ld.maxChunks; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range chunks {
+				if err := ld.downloadChunk(ctx, f, state.start(i), state.end(i), written); err != nil {
+					fail(err)
+					continue
+				}
+				mu.Lock()
+				state.Done[i] = true
+				err := state.save(path)
+				mu.Unlock()
+				if err != nil {
+					fail(err)
+				}
+			}
+		}()
+	}
+
+	done := make(chan struct{})
+	go func() {
+		ticker := time.NewTicker(500 * time.Millisecond)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-done:
+				return
+			case <-ticker.C:
+				mu.Lock()
+				current := downloaded
+				mu.Unlock()
+				progressOutput.WriteProgress(progress.Progress{ID: ld.ID(), Action: "Downloading", Current: current, Total: size})
+			}
+		}
+	}()
+
+queue:
+	for i, complete := range state.Done {
+		if complete {
+			continue
+		}
+		select {
+		case chunks <- i:
+		case <-ctx.Done():
+			break queue
+		}
+	}
+	close(chunks)
+	wg.Wait()
+	close(done)
+
+	if firstErr == nil {
+		firstErr = ctx.Err()
+	}
+	return resumed, firstErr
+}
+
+// downloadChunk downloads the bytes of the blob between start and end and
+// writes them at the same offset in f.
+func (ld *v2LayerDescriptor) downloadChunk(ctx context.Context, f *os.File, start, end int64, written func(int64)) error {
+	rsc, err := ld.open(ctx)
+	if err != nil {
+		return err
+	}
+	defer rsc.Close()
+
+	if _, err := rsc.Seek(start, os.SEEK_SET); err != nil {
+		return err
+	}
+	w := &offsetWriter{f: f, offset: start, written: written}
+	n, err := io.Copy(w, io.LimitReader(rsc, end-start))
+	if err == transport.ErrWrongCodeForByteRange {
+		return errRangeNotSupported
+	}
+	if err != nil {
+		return err
+	}
+	if n != end-start {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
@@ -0,0 +1,151 @@
+package distribution
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/docker/distribution/digest"
+	"github.com/docker/docker/reference"
+	"github.com/docker/docker/registry"
+	"golang.org/x/net/context"
+)
+
+func TestChunkState(t *testing.T) {
+	size := int64(3*downloadChunkSize + 10)
+	state := newChunkState(size, downloadChunkSize+5)
+	if len(state.Done) != 4 {
+		t.Fatalf("expected 4 chunks, got %d", len(state.Done))
+	}
+	if !state.Done[0] || state.Done[1] || state.Done[2] || state.Done[3] {
+		t.Fatalf("only the first chunk should be done, got %v", state.Done)
+	}
+	if end := state.end(3); end != size {
+		t.Fatalf("expected the last chunk to end at %d, got %d", size, end)
+	}
+
+	state.Done[2] = true
+	if prefix := state.prefix(); prefix != downloadChunkSize {
+		t.Fatalf("expected a prefix of %d bytes, got %d", downloadChunkSize, prefix)
+	}
+	if downloaded := state.downloaded(); downloaded != 2*downloadChunkSize {
+		t.Fatalf("expected %d bytes downloaded, got %d", 2*downloadChunkSize, downloaded)
+	}
+}
+
+func TestSequentialOffsetAfterChunkedDownload(t *testing.T) {
+	dir, err := ioutil.TempDir("", "partial-download")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	dgst := digest.FromBytes([]byte("blob"))
+	f, err := openPartialDownload(dir, dgst)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+
+	size := int64(2*downloadChunkSize + 1)
+	state := newChunkState(size, 0)
+	state.Done[0] = true
+	state.Done[2] = true
+	if err := f.Truncate(size); err != nil {
+		t.Fatal(err)
+	}
+	if err := state.save(f.Name()); err != nil {
+		t.Fatal(err)
+	}
+
+	offset, err := sequentialOffset(f)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if offset != downloadChunkSize {
+		t.Fatalf("expected to resume from %d, got %d", downloadChunkSize, offset)
+	}
+	if _, err := os.Stat(f.Name() + chunkStateSuffix); !os.IsNotExist(err) {
+		t.Fatalf("expected the chunk state to be removed, got %v", err)
+	}
+}
+
+func TestCleanupPartialDownloads(t *testing.T) {
+	dir, err := ioutil.TempDir("", "partial-download")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	stale := digest.FromBytes([]byte("stale"))
+	recent := digest.FromBytes([]byte("recent"))
+	for _, dgst := range []digest.Digest{stale, recent} {
+		f, err := openPartialDownload(dir, dgst)
+		if err != nil {
+			t.Fatal(err)
+		}
+		f.Close()
+		if err := newChunkState(1, 0).save(f.Name()); err != nil {
+			t.Fatal(err)
+		}
+	}
+	old := time.Now().Add(-2 * time.Hour)
+	if err := os.Chtimes(partialDownloadPath(dir, stale), old, old); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := CleanupPartialDownloads(dir, time.Hour); err != nil {
+		t.Fatal(err)
+	}
+	for _, path := range []string{partialDownloadPath(dir, stale), partialDownloadPath(dir, stale) + chunkStateSuffix} {
+		if _, err := os.Stat(path); !os.IsNotExist(err) {
+			t.Fatalf("expected %s to be removed, got %v", path, err)
+		}
+	}
+	for _, path := range []string{partialDownloadPath(dir, recent), partialDownloadPath(dir, recent) + chunkStateSuffix} {
+		if _, err := os.Stat(path); err != nil {
+			t.Fatalf("expected %s to be kept, got %v", path, err)
+		}
+	}
+
+	if err := CleanupPartialDownloads(dir+"-missing", time.Hour); err != nil {
+		t.Fatalf("cleaning up a missing directory should succeed, got %v", err)
+	}
+}
+
+func TestPullCleansUpPartialDownloads(t *testing.T) {
+	dir, err := ioutil.TempDir("", "partial-download")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	dgst := digest.FromBytes([]byte("stale"))
+	f, err := openPartialDownload(dir, dgst)
+	if err != nil {
+		t.Fatal(err)
+	}
+	f.Close()
+	old := time.Now().Add(-2 * PartialDownloadMaxAge)
+	if err := os.Chtimes(f.Name(), old, old); err != nil {
+		t.Fatal(err)
+	}
+
+	// The pull itself fails, as scratch cannot be pulled, but the stale
+	// partial download is removed first.
+	ref, err := reference.ParseNamed("scratch")
+	if err != nil {
+		t.Fatal(err)
+	}
+	config := &ImagePullConfig{
+		RegistryService: registry.NewService(registry.ServiceOptions{}),
+		DownloadDir:     dir,
+	}
+	if err := Pull(context.Background(), ref, config); err == nil {
+		t.Fatal("expected pulling scratch to fail")
+	}
+	if _, err := os.Stat(f.Name()); !os.IsNotExist(err) {
+		t.Fatalf("expected %s to be removed, got %v", f.Name(), err)
+	}
+}
@@ -0,0 +1,128 @@
+package peer
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/docker/distribution/digest"
+	"github.com/docker/docker/distribution/blobcache"
+	"github.com/docker/docker/pkg/discovery"
+	"golang.org/x/net/context"
+)
+
+// staticWatcher announces a fixed list of peers.
+type staticWatcher struct {
+	addrs []string
+}
+
+func (w staticWatcher) Watch(stopCh <-chan struct{}) (<-chan discovery.Entries, <-chan error) {
+	ch := make(chan discovery.Entries, 1)
+	entries, _ := discovery.CreateEntries(w.addrs)
+	ch <- entries
+	return ch, nil
+}
+
+func newPeers(t *testing.T, secret string, servers ...*httptest.Server) *Peers {
+	var addrs []string
+	for _, s := range servers {
+		addrs = append(addrs, strings.TrimPrefix(s.URL, "http://"))
+	}
+	p := NewPeers(staticWatcher{addrs: append(addrs, "127.0.0.1:1")}, "127.0.0.1:1", []byte(secret))
+	for i := 0; len(p.Addrs()) != len(servers); i++ {
+		if i == 100 {
+			t.Fatal("peers were not discovered")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	return p
+}
+
+func newCache(t *testing.T, content string) (*blobcache.Cache, digest.Digest, func()) {
+	root, err := ioutil.TempDir("", "peer-cache")
+	if err != nil {
+		t.Fatal(err)
+	}
+	cache, err := blobcache.New(root, 1024)
+	if err != nil {
+		t.Fatal(err)
+	}
+	path := root + "-blob"
+	if err := ioutil.WriteFile(path, []byte(content), 0600); err != nil {
+		t.Fatal(err)
+	}
+	dgst := digest.FromBytes([]byte(content))
+	if err := cache.Add(dgst, path); err != nil {
+		t.Fatal(err)
+	}
+	return cache, dgst, func() { os.RemoveAll(root) }
+}
+
+func TestFetchBlob(t *testing.T) {
+	cache, dgst, cleanup := newCache(t, "compressed layer")
+	defer cleanup()
+
+	server := httptest.NewServer(NewServer([]byte("secret"), nil, nil, cache))
+	defer server.Close()
+
+	p := newPeers(t, "secret", server)
+	defer p.Stop()
+
+	rc, size, err := p.FetchBlob(context.Background(), dgst)
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, err := ioutil.ReadAll(rc)
+	rc.Close()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(b) != "compressed layer" || size != int64(len(b)) {
+		t.Fatalf("unexpected blob %q of size %d", b, size)
+	}
+
+	if _, _, err := p.FetchBlob(context.Background(), digest.FromBytes([]byte("missing"))); err != ErrNotFound {
+		t.Fatalf("expected ErrNotFound for a missing blob, got %v", err)
+	}
+}
+
+func TestFetchBlobUnauthorized(t *testing.T) {
+	cache, dgst, cleanup := newCache(t, "compressed layer")
+	defer cleanup()
+
+	server := httptest.NewServer(NewServer([]byte("secret"), nil, nil, cache))
+	defer server.Close()
+
+	resp, err := http.Get(server.URL + blobsPath + dgst.String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusUnauthorized {
+		t.Fatalf("expected an unauthenticated request to be refused, got %s", resp.Status)
+	}
+
+	p := newPeers(t, "wrong secret", server)
+	defer p.Stop()
+	if _, _, err := p.FetchBlob(context.Background(), dgst); err != ErrNotFound {
+		t.Fatalf("expected ErrNotFound with a wrong secret, got %v", err)
+	}
+}
+
+func TestFetchBlobVerifiesDigest(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("altered layer"))
+	}))
+	defer server.Close()
+
+	p := newPeers(t, "secret", server)
+	defer p.Stop()
+
+	if _, _, err := p.FetchBlob(context.Background(), digest.FromBytes([]byte("compressed layer"))); err != ErrNotFound {
+		t.Fatalf("expected data not matching the digest to be rejected, got %v", err)
+	}
+}
@@ -0,0 +1,193 @@
+package peer
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"math/rand"
+	"net"
+	"net/http"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/Sirupsen/logrus"
+	"github.com/docker/distribution/digest"
+	"github.com/docker/docker/layer"
+	"github.com/docker/docker/pkg/discovery"
+	"github.com/docker/docker/pkg/ioutils"
+	"golang.org/x/net/context"
+	"golang.org/x/net/context/ctxhttp"
+)
+
+// ErrNotFound is returned when no peer has the requested data.
+var ErrNotFound = errors.New("not found on any peer")
+
+// Peers keeps track of the peers announced by a discovery backend, and
+// fetches blobs and layers from them.
+type Peers struct {
+	secret []byte
+	self   string
+	client *http.Client
+
+	mu     sync.Mutex
+	addrs  []string
+	stopCh chan struct{}
+}
+
+// NewPeers returns a Peers watching the entries of the discovery backend.
+// The entry equal to self, the address of this daemon, is ignored. Requests
+// are authenticated with secret.
+func NewPeers(watcher discovery.Watcher, self string, secret []byte) *Peers {
+	p := &Peers{
+		secret: secret,
+		self:   self,
+		client: &http.Client{
+			Transport: &http.Transport{
+				Dial: (&net.Dialer{
+					Timeout:   5 * time.Second,
+					KeepAlive: 30 * time.Second,
+				}).Dial,
+				ResponseHeaderTimeout: 10 * time.Second,
+			},
+		},
+		stopCh: make(chan struct{}),
+	}
+	go p.watch(watcher)
+	return p
+}
+
+func (p *Peers) watch(watcher discovery.Watcher) {
+	entriesCh, errCh := watcher.Watch(p.stopCh)
+	for entriesCh != nil || errCh != nil {
+		select {
+		case entries, ok := <-entriesCh:
+			if !ok {
+				entriesCh = nil
+				continue
+			}
+			p.setEntries(entries)
+		case err, ok := <-errCh:
+			if !ok {
+				errCh = nil
+				continue
+			}
+			logrus.Warnf("Peer discovery failed: %v", err)
+		case <-p.stopCh:
+			return
+		}
+	}
+}
+
+func (p *Peers) setEntries(entries discovery.Entries) {
+	addrs := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		if addr := entry.String(); addr != p.self {
+			addrs = append(addrs, addr)
+		}
+	}
+	logrus.Debugf("peers: %v", addrs)
+
+	p.mu.Lock()
+	p.addrs = addrs
+	p.mu.Unlock()
+}
+
+// Addrs returns the addresses of the known peers.
+func (p *Peers) Addrs() []string {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	return append([]string(nil), p.addrs...)
+}
+
+// Stop stops watching the discovery backend.
+func (p *Peers) Stop() {
+	close(p.stopCh)
+}
+
+// FetchBlob fetches the compressed blob with the given digest from a peer.
+func (p *Peers) FetchBlob(ctx context.Context, dgst digest.Digest) (io.ReadCloser, int64, error) {
+	return p.fetch(ctx, blobsPath, dgst)
+}
+
+// FetchLayer fetches the uncompressed tar stream of the layer with the given
+// DiffID from a peer.
+func (p *Peers) FetchLayer(ctx context.Context, diffID layer.DiffID) (io.ReadCloser, int64, error) {
+	return p.fetch(ctx, layersPath, digest.Digest(diffID))
+}
+
+// fetch tries the peers in random order, to spread the load, until one of
+// them has the data. The data is saved to a temporary file and verified
+// against dgst before it is returned.
+func (p *Peers) fetch(ctx context.Context, path string, dgst digest.Digest) (io.ReadCloser, int64, error) {
+	addrs := p.Addrs()
+	for _, i := range rand.Perm(len(addrs)) {
+		rc, size, err := p.fetchFrom(ctx, addrs[i], path, dgst)
+		if err == nil {
+			return rc, size, nil
+		}
+		if ctx.Err() != nil {
+			return nil, 0, ctx.Err()
+		}
+		if err != ErrNotFound {
+			logrus.Debugf("failed to fetch %s from peer %s: %v", dgst, addrs[i], err)
+		}
+	}
+	return nil, 0, ErrNotFound
+}
+
+func (p *Peers) fetchFrom(ctx context.Context, addr, path string, dgst digest.Digest) (io.ReadCloser, int64, error) {
+	req, err := http.NewRequest("GET", "http://"+addr+path+dgst.String(), nil)
+	if err != nil {
+		return nil, 0, err
+	}
+	req.Header.Set("Authorization", "Bearer "+string(p.secret))
+
+	resp, err := ctxhttp.Do(ctx, p.client, req)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer resp.Body.Close()
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+	case http.StatusNotFound:
+		return nil, 0, ErrNotFound
+	default:
+		return nil, 0, fmt.Errorf("unexpected status %s", resp.Status)
+	}
+
+	verifier, err := digest.NewDigestVerifier(dgst)
+	if err != nil {
+		return nil, 0, err
+	}
+	f, err := ioutil.TempFile("", "peer-blob")
+	if err != nil {
+		return nil, 0, err
+	}
+	cleanup := func() {
+		f.Close()
+		os.Remove(f.Name())
+	}
+
+	size, err := io.Copy(f, io.TeeReader(resp.Body, verifier))
+	if err != nil {
+		cleanup()
+		return nil, 0, err
+	}
+	if !verifier.Verified() {
+		cleanup()
+		return nil, 0, fmt.Errorf("verification failed for digest %s", dgst)
+	}
+	if _, err := f.Seek(0, os.SEEK_SET); err != nil {
+		cleanup()
+		return nil, 0, err
+	}
+
+	return ioutils.NewReadCloserWrapper(f, func() error {
+		cleanup()
+		return nil
+	}), size, nil
+}
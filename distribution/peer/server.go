@@ -0,0 +1,152 @@
+// Package peer lets daemons on the same network share the layers they have
+// already pulled, so that an image pulled by many hosts at once is only
+// downloaded once from the registry.
+//
+// A daemon serves the compressed blobs of its blob cache, and the layers
+// registered in its layer store, over HTTP. Requests must carry the secret
+// shared by the peers. Clients verify the data they receive against the
+// digest they requested, so a peer cannot serve altered layers.
+package peer
+
+import (
+	"crypto/subtle"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/Sirupsen/logrus"
+	"github.com/docker/distribution/digest"
+	"github.com/docker/docker/distribution/blobcache"
+	"github.com/docker/docker/image"
+	"github.com/docker/docker/layer"
+)
+
+const (
+	blobsPath  = "/v1/blobs/"
+	layersPath = "/v1/layers/"
+)
+
+// Server serves the blobs and layers of a daemon to its peers.
+type Server struct {
+	secret     []byte
+	imageStore image.Store
+	layerStore layer.Store
+	blobCache  *blobcache.Cache
+}
+
+// NewServer returns a Server authenticating requests with secret. blobCache
+// may be nil, in which case only registered layers are served.
+func NewServer(secret []byte, imageStore image.Store, layerStore layer.Store, blobCache *blobcache.Cache) *Server {
+	return &Server{
+		secret:     secret,
+		imageStore: imageStore,
+		layerStore: layerStore,
+		blobCache:  blobCache,
+	}
+}
+
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != "GET" {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	if !s.authorized(r) {
+		http.Error(w, "unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	switch {
+	case strings.HasPrefix(r.URL.Path, blobsPath):
+		s.serveBlob(w, strings.TrimPrefix(r.URL.Path, blobsPath))
+	case strings.HasPrefix(r.URL.Path, layersPath):
+		s.serveLayer(w, strings.TrimPrefix(r.URL.Path, layersPath))
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+func (s *Server) authorized(r *http.Request) bool {
+	token := strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer ")
+	return subtle.ConstantTimeCompare([]byte(token), s.secret) == 1
+}
+
+// serveBlob serves a compressed blob from the blob cache.
+func (s *Server) serveBlob(w http.ResponseWriter, ref string) {
+	dgst, err := digest.ParseDigest(ref)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	if s.blobCache == nil {
+		http.Error(w, blobcache.ErrNotFound.Error(), http.StatusNotFound)
+		return
+	}
+
+	rc, size, err := s.blobCache.Open(dgst)
+	if err != nil {
+		if err == blobcache.ErrNotFound {
+			http.Error(w, err.Error(), http.StatusNotFound)
+		} else {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		}
+		return
+	}
+	defer rc.Close()
+
+	logrus.Debugf("serving blob %s to peer", dgst)
+	w.Header().Set("Content-Type", "application/octet-stream")
+	w.Header().Set("Content-Length", strconv.FormatInt(size, 10))
+	if _, err := io.Copy(w, rc); err != nil {
+		logrus.Debugf("failed to serve blob %s to peer: %v", dgst, err)
+	}
+}
+
+// serveLayer serves the uncompressed tar stream of a registered layer.
+func (s *Server) serveLayer(w http.ResponseWriter, ref string) {
+	dgst, err := digest.ParseDigest(ref)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	l, err := s.findLayer(layer.DiffID(dgst))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	}
+	defer layer.ReleaseAndLog(s.layerStore, l)
+
+	rc, err := l.TarStream()
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	defer rc.Close()
+
+	logrus.Debugf("serving layer %s to peer", dgst)
+	w.Header().Set("Content-Type", "application/x-tar")
+	if _, err := io.Copy(w, rc); err != nil {
+		logrus.Debugf("failed to serve layer %s to peer: %v", dgst, err)
+	}
+}
+
+// findLayer returns a registered layer with the given DiffID. Layers are
+// indexed by ChainID, so the chains of the local images are searched for
+// the DiffID. The returned layer must be released.
+func (s *Server) findLayer(diffID layer.DiffID) (layer.Layer, error) {
+	for _, img := range s.imageStore.Map() {
+		if img.RootFS == nil {
+			continue
+		}
+		for i, id := range img.RootFS.DiffIDs {
+			if id != diffID {
+				continue
+			}
+			if l, err := s.layerStore.Get(layer.CreateChainID(img.RootFS.DiffIDs[:i+1])); err == nil {
+				return l, nil
+			}
+		}
+	}
+	return nil, layer.ErrLayerDoesNotExist
+}
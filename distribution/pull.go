@@ -6,6 +6,7 @@ import (
 	"github.com/Sirupsen/logrus"
 	"github.com/docker/distribution/digest"
 	"github.com/docker/docker/api"
+	"github.com/docker/docker/distribution/blobcache"
 	"github.com/docker/docker/distribution/metadata"
 	"github.com/docker/docker/distribution/xfer"
 	"github.com/docker/docker/image"
@@ -40,6 +41,16 @@ type ImagePullConfig struct {
 	ReferenceStore reference.Store
 	// DownloadManager manages concurrent pulls.
 	DownloadManager *xfer.LayerDownloadManager
+	// DownloadDir is the directory where partially downloaded blobs are
+	// kept, so that later pulls can resume them. If empty, partial
+	// downloads are discarded at the end of the pull.
+	DownloadDir string
+	// MaxDownloadChunks is the number of parallel range requests used to
+	// download a large blob. Values below 2 disable chunked downloads.
+	MaxDownloadChunks int
+	// BlobCache, if set, holds compressed blobs already pulled, whatever
+	// the registry they were pulled from.
+	BlobCache *blobcache.Cache
 }
 
 // Puller is an interface that abstracts pulling for different API versions.
@@ -78,6 +89,14 @@ func newPuller(endpoint registry.APIEndpoint, repoInfo *registry.RepositoryInfo,
 // Pull initiates a pull operation. image is the repository name to pull, and
 // tag may be either empty, or indicate a specific tag to pull.
 func Pull(ctx context.Context, ref reference.Named, imagePullConfig *ImagePullConfig) error {
+	// Stale partial downloads are cleaned up at each pull, as the daemon
+	// may run for much longer than they are kept.
+	if imagePullConfig.DownloadDir != "" {
+		if err := CleanupPartialDownloads(imagePullConfig.DownloadDir, PartialDownloadMaxAge); err != nil {
+			logrus.Warnf("Failed to clean up partial downloads: %v", err)
+		}
+	}
+
 	// Resolve the Repository name from fqn to RepositoryInfo
 	repoInfo, err := imagePullConfig.RegistryService.ResolveRepository(ref)
 	if err != nil {
@@ -145,6 +164,13 @@ func Pull(ctx context.Context, ref reference.Named, imagePullConfig *ImagePullCo
 			default:
 				if fallbackErr, ok := err.(fallbackError); ok {
 					fallback = true
+					if endpoint.Mirror {
+						if endpointFailed(fallbackErr) {
+							imagePullConfig.RegistryService.ReportEndpointResult(endpoint, fallbackErr.err)
+						} else {
+							imagePullConfig.RegistryService.ReportEndpointResult(endpoint, nil)
+						}
+					}
 					confirmedV2 = confirmedV2 || fallbackErr.confirmedV2
 					if fallbackErr.transportOK && endpoint.URL.Scheme == "https" {
 						confirmedTLSRegistries[endpoint.URL.Host] = struct{}{}
@@ -171,6 +197,9 @@ func Pull(ctx context.Context, ref reference.Named, imagePullConfig *ImagePullCo
 			return err
 		}
 
+		if endpoint.Mirror {
+			imagePullConfig.RegistryService.ReportEndpointResult(endpoint, nil)
+		}
 		imagePullConfig.ImageEventLogger(ref.String(), repoInfo.Name(), "pull")
 		return nil
 	}
@@ -9,6 +9,7 @@ import (
 	"net/url"
 	"os"
 	"runtime"
+	"time"
 
 	"github.com/Sirupsen/logrus"
 	"github.com/docker/distribution"
@@ -19,6 +20,7 @@ import (
 	"github.com/docker/distribution/registry/api/errcode"
 	"github.com/docker/distribution/registry/client/auth"
 	"github.com/docker/distribution/registry/client/transport"
+	"github.com/docker/docker/distribution/blobcache"
 	"github.com/docker/docker/distribution/metadata"
 	"github.com/docker/docker/distribution/xfer"
 	"github.com/docker/docker/image"
@@ -58,11 +60,15 @@ type v2Puller struct {
 
 func (p *v2Puller) Pull(ctx context.Context, ref reference.Named) (err error) {
 	// TODO(tiborvass): was ReceiveTimeout
+	start := time.Now()
 	p.repo, p.confirmedV2, err = NewV2Repository(ctx, p.repoInfo, p.endpoint, p.config.MetaHeaders, p.config.AuthConfig, "pull")
 	if err != nil {
 		logrus.Warnf("Error getting v2 registry: %v", err)
 		return err
 	}
+	if p.endpoint.Mirror {
+		p.config.RegistryService.ReportEndpointLatency(p.endpoint, time.Since(start))
+	}
 
 	if err = p.pullV2Repository(ctx, ref); err != nil {
 		if _, ok := err.(fallbackError); ok {
@@ -134,6 +140,15 @@ type v2LayerDescriptor struct {
 	tmpFile           *os.File
 	verifier          digest.Verifier
 	src               distribution.Descriptor
+	// downloadDir is the directory partial downloads are kept in across
+	// pulls. If empty, blobs are downloaded to temporary files.
+	downloadDir string
+	// maxChunks is the number of parallel range requests used to download
+	// large blobs of known size.
+	maxChunks int
+	// blobCache, if set, is consulted before downloading the blob, and
+	// receives it once downloaded.
+	blobCache *blobcache.Cache
 }
 
 func (ld *v2LayerDescriptor) Key() string {
@@ -148,36 +163,65 @@ func (ld *v2LayerDescriptor) DiffID() (layer.DiffID, error) {
 	return ld.V2MetadataService.GetDiffID(ld.digest)
 }
 
+// PeerDigest returns the digest of the blob, unless the blob is in the local
+// blob cache, which is cheaper to read than fetching it from a peer.
+func (ld *v2LayerDescriptor) PeerDigest() (digest.Digest, bool) {
+	if ld.blobCache != nil && ld.blobCache.Contains(ld.digest) {
+		return "", false
+	}
+	return ld.digest, true
+}
+
 func (ld *v2LayerDescriptor) Download(ctx context.Context, progressOutput progress.Output) (io.ReadCloser, int64, error) {
 	logrus.Debugf("pulling blob %q", ld.digest)
 
+	if ld.blobCache != nil {
+		rc, size, err := ld.blobCache.Open(ld.digest)
+		if err == nil {
+			logrus.Debugf("using cached blob %q", ld.digest)
+			progress.Update(progressOutput, ld.ID(), "Using cached blob")
+			return rc, size, nil
+		}
+		if err != blobcache.ErrNotFound {
+			logrus.Warnf("Failed to open cached blob %s: %v", ld.digest, err)
+		}
+	}
+
+	if ld.downloadDir != "" && ld.maxChunks > 1 && ld.src.Size >= minChunkedDownloadSize {
+		rc, err := ld.downloadChunked(ctx, progressOutput)
+		if err != errRangeNotSupported {
+			return rc, ld.src.Size, err
+		}
+		logrus.Debugf("range requests not supported, downloading %q sequentially", ld.digest)
+		ld.maxChunks = 1
+	}
+
 	var (
 		err    error
 		offset int64
 	)
 
 	if ld.tmpFile == nil {
-		ld.tmpFile, err = createDownloadFile()
+		ld.tmpFile, err = ld.openDownloadFile()
 		if err != nil {
 			return nil, 0, xfer.DoNotRetry{Err: err}
 		}
-	} else {
-		offset, err = ld.tmpFile.Seek(0, os.SEEK_END)
-		if err != nil {
-			logrus.Debugf("error seeking to end of download file: %v", err)
-			offset = 0
+	}
+	offset, err = ld.tmpFile.Seek(0, os.SEEK_END)
+	if err != nil {
+		logrus.Debugf("error seeking to end of download file: %v", err)
+		offset = 0
 
-			ld.tmpFile.Close()
-			if err := os.Remove(ld.tmpFile.Name()); err != nil {
-				logrus.Errorf("Failed to remove temp file: %s", ld.tmpFile.Name())
-			}
-			ld.tmpFile, err = createDownloadFile()
-			if err != nil {
-				return nil, 0, xfer.DoNotRetry{Err: err}
-			}
-		} else if offset != 0 {
-			logrus.Debugf("attempting to resume download of %q from %d bytes", ld.digest, offset)
+		ld.tmpFile.Close()
+		if err := removePartialDownload(ld.tmpFile.Name()); err != nil {
+			logrus.Errorf("Failed to remove temp file: %s", ld.tmpFile.Name())
+		}
+		ld.tmpFile, err = ld.openDownloadFile()
+		if err != nil {
+			return nil, 0, xfer.DoNotRetry{Err: err}
 		}
+	} else if offset != 0 {
+		logrus.Debugf("attempting to resume download of %q from %d bytes", ld.digest, offset)
 	}
 
 	tmpFile := ld.tmpFile
@@ -232,6 +276,13 @@ func (ld *v2LayerDescriptor) Download(ctx context.Context, progressOutput progre
 		if err != nil {
 			return nil, 0, xfer.DoNotRetry{Err: err}
 		}
+		// The download resumes from a previous pull, so the bytes already
+		// in the file have not gone through this verifier.
+		if offset != 0 {
+			if _, err := io.Copy(ld.verifier, io.NewSectionReader(tmpFile, 0, offset)); err != nil {
+				return nil, 0, xfer.DoNotRetry{Err: err}
+			}
+		}
 	}
 
 	_, err = io.Copy(tmpFile, io.TeeReader(reader, ld.verifier))
@@ -260,6 +311,10 @@ func (ld *v2LayerDescriptor) Download(ctx context.Context, progressOutput progre
 
 			return nil, 0, err
 		}
+		// Do not let a later pull resume from corrupted data.
+		if ld.downloadDir != "" {
+			ld.truncateDownloadFile()
+		}
 		return nil, 0, xfer.DoNotRetry{Err: err}
 	}
 
@@ -270,7 +325,7 @@ func (ld *v2LayerDescriptor) Download(ctx context.Context, progressOutput progre
 	_, err = tmpFile.Seek(0, os.SEEK_SET)
 	if err != nil {
 		tmpFile.Close()
-		if err := os.Remove(tmpFile.Name()); err != nil {
+		if err := removePartialDownload(tmpFile.Name()); err != nil {
 			logrus.Errorf("Failed to remove temp file: %s", tmpFile.Name())
 		}
 		ld.tmpFile = nil
@@ -282,25 +337,119 @@ func (ld *v2LayerDescriptor) Download(ctx context.Context, progressOutput progre
 	// be closed once
 	ld.tmpFile = nil
 
-	return ioutils.NewReadCloserWrapper(tmpFile, func() error {
-		tmpFile.Close()
-		err := os.RemoveAll(tmpFile.Name())
+	return ld.downloadFileReadCloser(tmpFile), size, nil
+}
+
+// downloadChunked downloads the blob to its partial download file with
+// parallel range requests, and verifies it once complete.
+func (ld *v2LayerDescriptor) downloadChunked(ctx context.Context, progressOutput progress.Output) (io.ReadCloser, error) {
+	f, err := openPartialDownload(ld.downloadDir, ld.digest)
+	if err != nil {
+		return nil, xfer.DoNotRetry{Err: err}
+	}
+
+	resumed, err := ld.downloadChunks(ctx, f, ld.src.Size, progressOutput)
+	if err != nil {
+		f.Close()
+		if err == errRangeNotSupported {
+			return nil, err
+		}
+		return nil, retryOnError(err)
+	}
+
+	progress.Update(progressOutput, ld.ID(), "Verifying Checksum")
+
+	verifier, err := digest.NewDigestVerifier(ld.digest)
+	if err != nil {
+		f.Close()
+		return nil, xfer.DoNotRetry{Err: err}
+	}
+	if _, err := io.Copy(verifier, io.NewSectionReader(f, 0, ld.src.Size)); err != nil {
+		f.Close()
+		return nil, retryOnError(err)
+	}
+	if !verifier.Verified() {
+		err = fmt.Errorf("filesystem layer verification failed for digest %s", ld.digest)
+		logrus.Error(err)
+
+		f.Close()
+		if err := removePartialDownload(f.Name()); err != nil {
+			logrus.Errorf("Failed to remove partial download: %s", f.Name())
+		}
+		// Allow a retry if some of the chunks come from an earlier attempt.
+		if resumed {
+			return nil, err
+		}
+		return nil, xfer.DoNotRetry{Err: err}
+	}
+
+	progress.Update(progressOutput, ld.ID(), "Download complete")
+
+	logrus.Debugf("Downloaded %s to %s", ld.ID(), f.Name())
+
+	if _, err := f.Seek(0, os.SEEK_SET); err != nil {
+		f.Close()
+		if err := removePartialDownload(f.Name()); err != nil {
+			logrus.Errorf("Failed to remove partial download: %s", f.Name())
+		}
+		return nil, xfer.DoNotRetry{Err: err}
+	}
+	return ld.downloadFileReadCloser(f), nil
+}
+
+// downloadFileReadCloser returns a ReadCloser for a complete download file.
+// Once closed, the file is moved to the blob cache, or removed if there is
+// no cache.
+func (ld *v2LayerDescriptor) downloadFileReadCloser(f *os.File) io.ReadCloser {
+	return ioutils.NewReadCloserWrapper(f, func() error {
+		f.Close()
+		if ld.blobCache != nil {
+			os.Remove(f.Name() + chunkStateSuffix)
+			if err := ld.blobCache.Add(ld.digest, f.Name()); err != nil {
+				logrus.Warnf("Failed to add blob %s to cache: %v", ld.digest, err)
+			}
+			return nil
+		}
+		err := removePartialDownload(f.Name())
 		if err != nil {
-			logrus.Errorf("Failed to remove temp file: %s", tmpFile.Name())
+			logrus.Errorf("Failed to remove temp file: %s", f.Name())
 		}
 		return err
-	}), size, nil
+	})
 }
 
 func (ld *v2LayerDescriptor) Close() {
 	if ld.tmpFile != nil {
 		ld.tmpFile.Close()
+		// Partial downloads in the download directory are kept for a
+		// later pull to resume.
+		if ld.downloadDir != "" {
+			return
+		}
 		if err := os.RemoveAll(ld.tmpFile.Name()); err != nil {
 			logrus.Errorf("Failed to remove temp file: %s", ld.tmpFile.Name())
 		}
 	}
 }
 
+// openDownloadFile opens the file the blob is downloaded to: its partial
+// download file if a download directory is configured, otherwise a new
+// temporary file.
+func (ld *v2LayerDescriptor) openDownloadFile() (*os.File, error) {
+	if ld.downloadDir == "" {
+		return createDownloadFile()
+	}
+	f, err := openPartialDownload(ld.downloadDir, ld.digest)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := sequentialOffset(f); err != nil {
+		f.Close()
+		return nil, err
+	}
+	return f, nil
+}
+
 func (ld *v2LayerDescriptor) truncateDownloadFile() error {
 	// Need a new hash context since we will be redoing the download
 	ld.verifier = nil
@@ -465,6 +614,9 @@ func (p *v2Puller) pullSchema1(ctx context.Context, ref reference.Named, unverif
 			repoInfo:          p.repoInfo,
 			repo:              p.repo,
 			V2MetadataService: p.V2MetadataService,
+			downloadDir:       p.config.DownloadDir,
+			maxChunks:         p.config.MaxDownloadChunks,
+			blobCache:         p.config.BlobCache,
 		}
 
 		descriptors = append(descriptors, layerDescriptor)
@@ -516,6 +668,9 @@ func (p *v2Puller) pullSchema2(ctx context.Context, ref reference.Named, mfst *s
 			repoInfo:          p.repoInfo,
 			V2MetadataService: p.V2MetadataService,
 			src:               d,
+			downloadDir:       p.config.DownloadDir,
+			maxChunks:         p.config.MaxDownloadChunks,
+			blobCache:         p.config.BlobCache,
 		}
 
 		descriptors = append(descriptors, layerDescriptor)
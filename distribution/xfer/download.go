@@ -8,6 +8,7 @@ import (
 
 	"github.com/Sirupsen/logrus"
 	"github.com/docker/distribution"
+	"github.com/docker/distribution/digest"
 	"github.com/docker/docker/image"
 	"github.com/docker/docker/layer"
 	"github.com/docker/docker/pkg/archive"
@@ -18,12 +19,15 @@ import (
 
 const maxDownloadAttempts = 5
 
+var errNoPeers = errors.New("layer not fetched from peers")
+
 // LayerDownloadManager figures out which layers need to be downloaded, then
 // registers and downloads those, taking into account dependencies between
 // layers.
 type LayerDownloadManager struct {
 	layerStore layer.Store
 	tm         TransferManager
+	peers      PeerSource
 }
 
 // SetConcurrency set the max concurrent downloads for each pull
@@ -31,6 +35,12 @@ func (ldm *LayerDownloadManager) SetConcurrency(concurrency int) {
 	ldm.tm.SetConcurrency(concurrency)
 }
 
+// SetPeerSource sets the peers layers are fetched from before the registry.
+// It must be called before any download is started.
+func (ldm *LayerDownloadManager) SetPeerSource(peers PeerSource) {
+	ldm.peers = peers
+}
+
 // NewLayerDownloadManager returns a new LayerDownloadManager.
 func NewLayerDownloadManager(layerStore layer.Store, concurrencyLimit int) *LayerDownloadManager {
 	return &LayerDownloadManager{
@@ -81,6 +91,25 @@ type DownloadDescriptorWithRegistered interface {
 	Registered(diffID layer.DiffID)
 }
 
+// A PeerSource fetches layer data from other daemons. The data it returns
+// must have been verified against the requested digest.
+type PeerSource interface {
+	// FetchBlob returns the compressed layer data with the given digest.
+	FetchBlob(ctx context.Context, dgst digest.Digest) (io.ReadCloser, int64, error)
+	// FetchLayer returns the uncompressed tar stream of the layer with the
+	// given DiffID.
+	FetchLayer(ctx context.Context, diffID layer.DiffID) (io.ReadCloser, int64, error)
+}
+
+// DownloadDescriptorWithPeers is a DownloadDescriptor whose layer can be
+// fetched from peers. PeerDigest returns the digest of the compressed layer
+// data, and false if the layer should not be fetched from peers, for example
+// because it is available locally.
+type DownloadDescriptorWithPeers interface {
+	DownloadDescriptor
+	PeerDigest() (digest.Digest, bool)
+}
+
 // Download is a blocking function which ensures the requested layers are
 // present in the layer store. It uses the string returned by the Key method to
 // deduplicate downloads. If a given layer is not already known to present in
@@ -245,7 +274,8 @@ func (ldm *LayerDownloadManager) makeDownloadFunc(descriptor DownloadDescriptor,
 
 			defer descriptor.Close()
 
-			for {
+			downloadReader, size, err = ldm.downloadFromPeers(d.Transfer.Context(), descriptor, progressOutput)
+			for err != nil {
 				downloadReader, size, err = descriptor.Download(d.Transfer.Context(), progressOutput)
 				if err == nil {
 					break
@@ -358,6 +388,38 @@ func (ldm *LayerDownloadManager) makeDownloadFunc(descriptor DownloadDescriptor,
 	}
 }
 
+// downloadFromPeers tries to fetch the layer data from the peers, first as a
+// registered layer if its DiffID is known, then as a compressed blob.
+func (ldm *LayerDownloadManager) downloadFromPeers(ctx context.Context, descriptor DownloadDescriptor, progressOutput progress.Output) (io.ReadCloser, int64, error) {
+	withPeers, ok := descriptor.(DownloadDescriptorWithPeers)
+	if ldm.peers == nil || !ok {
+		return nil, 0, errNoPeers
+	}
+	dgst, ok := withPeers.PeerDigest()
+	if !ok {
+		return nil, 0, errNoPeers
+	}
+
+	progress.Update(progressOutput, descriptor.ID(), "Looking for peers")
+	if diffID, err := descriptor.DiffID(); err == nil {
+		rc, size, err := ldm.peers.FetchLayer(ctx, diffID)
+		if err == nil {
+			logrus.Debugf("fetched layer %s from peer", diffID)
+			progress.Update(progressOutput, descriptor.ID(), "Downloaded from peer")
+			return rc, size, nil
+		}
+		logrus.Debugf("fetching layer %s from peers failed: %v", diffID, err)
+	}
+	rc, size, err := ldm.peers.FetchBlob(ctx, dgst)
+	if err != nil {
+		logrus.Debugf("fetching blob %s from peers failed: %v", dgst, err)
+		return nil, 0, err
+	}
+	logrus.Debugf("fetched blob %s from peer", dgst)
+	progress.Update(progressOutput, descriptor.ID(), "Downloaded from peer")
+	return rc, size, nil
+}
+
 // makeDownloadFuncFromDownload returns a function that performs the layer
 // registration when the layer data is coming from an existing download. It
 // waits for sourceDownload and parentDownload to complete, and then
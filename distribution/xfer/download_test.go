@@ -108,6 +108,14 @@ func (ls *mockLayerStore) Get(chainID layer.ChainID) (layer.Layer, error) {
 	return l, nil
 }
 
+func (ls *mockLayerStore) Map() map[layer.ChainID]layer.Layer {
+	layers := map[layer.ChainID]layer.Layer{}
+	for k, v := range ls.layers {
+		layers[k] = v
+	}
+	return layers
+}
+
 func (ls *mockLayerStore) Release(l layer.Layer) ([]layer.Metadata, error) {
 	return []layer.Metadata{}, nil
 }
@@ -51,6 +51,14 @@ clone() {
 	echo -n 'rm vendor, '
 	( cd "$target" && rm -rf vendor Godeps/_workspace )
 
+	# patches carried on top of the upstream revision, see hack/vendor-patches
+	local patch
+	for patch in "hack/vendor-patches/$pkg"/*.patch; do
+		[ -f "$patch" ] || continue
+		echo -n "apply $(basename "$patch"), "
+		patch --quiet -p1 -d "$target" < "$patch"
+	done
+
 	echo done
 }
 
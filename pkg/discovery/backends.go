@@ -3,6 +3,7 @@ package discovery
 import (
 	"fmt"
 	"net"
+	"reflect"
 	"strings"
 	"time"
 
@@ -13,6 +14,9 @@ var (
 	// Backends is a global map of discovery backends indexed by their
 	// associated scheme.
 	backends = make(map[string]Backend)
+	// prototypes holds a pristine copy of each registered backend, from
+	// which NewInstance creates independent instances.
+	prototypes = make(map[string]Backend)
 )
 
 // Register makes a discovery backend available by the provided scheme.
@@ -23,9 +27,22 @@ func Register(scheme string, d Backend) error {
 	}
 	log.WithField("name", scheme).Debug("Registering discovery service")
 	backends[scheme] = d
+	prototypes[scheme] = copyBackend(d)
 	return nil
 }
 
+// copyBackend returns a shallow copy of a backend implemented by a pointer
+// to a struct. Other backends cannot be copied and are returned as is.
+func copyBackend(d Backend) Backend {
+	v := reflect.ValueOf(d)
+	if v.Kind() != reflect.Ptr || v.Elem().Kind() != reflect.Struct {
+		return d
+	}
+	c := reflect.New(v.Elem().Type())
+	c.Elem().Set(v.Elem())
+	return c.Interface().(Backend)
+}
+
 func parse(rawurl string) (string, string) {
 	parts := strings.SplitN(rawurl, "://", 2)
 
@@ -105,3 +122,19 @@ func New(rawurl string, heartbeat time.Duration, ttl time.Duration, clusterOpts
 
 	return nil, ErrNotSupported
 }
+
+// NewInstance is like New, but initializes a new instance of the backend
+// rather than the one shared by all the callers of New. This allows a
+// component to use a discovery backend with its own settings alongside the
+// discovery of the cluster store.
+func NewInstance(rawurl string, heartbeat time.Duration, ttl time.Duration, clusterOpts map[string]string) (Backend, error) {
+	scheme, uri := parse(rawurl)
+	if prototype, exists := prototypes[scheme]; exists {
+		backend := copyBackend(prototype)
+		log.WithFields(log.Fields{"name": scheme, "uri": uri}).Debug("Initializing discovery service instance")
+		err := backend.Initialize(uri, heartbeat, ttl, clusterOpts)
+		return backend, err
+	}
+
+	return nil, ErrNotSupported
+}
@@ -2,6 +2,7 @@ package discovery
 
 import (
 	"testing"
+	"time"
 
 	"github.com/go-check/check"
 )
@@ -135,3 +136,36 @@ func (s *DiscoverySuite) TestEntriesDiff(c *check.C) {
 	c.Assert(removed, check.HasLen, 1)
 	c.Assert(removed.Contains(entry2), check.Equals, true)
 }
+
+type fakeBackend struct {
+	uri string
+}
+
+func (f *fakeBackend) Initialize(uri string, _ time.Duration, _ time.Duration, _ map[string]string) error {
+	f.uri = uri
+	return nil
+}
+
+func (f *fakeBackend) Watch(stopCh <-chan struct{}) (<-chan Entries, <-chan error) {
+	return nil, nil
+}
+
+func (f *fakeBackend) Register(string) error {
+	return nil
+}
+
+func (s *DiscoverySuite) TestNewInstance(c *check.C) {
+	c.Assert(Register("fake", &fakeBackend{}), check.IsNil)
+
+	shared, err := New("fake://shared", 0, 0, nil)
+	c.Assert(err, check.IsNil)
+	instance, err := NewInstance("fake://instance", 0, 0, nil)
+	c.Assert(err, check.IsNil)
+
+	c.Assert(instance, check.Not(check.Equals), shared)
+	c.Assert(shared.(*fakeBackend).uri, check.Equals, "shared")
+	c.Assert(instance.(*fakeBackend).uri, check.Equals, "instance")
+
+	_, err = NewInstance("unknown://instance", 0, 0, nil)
+	c.Assert(err, check.Equals, ErrNotSupported)
+}
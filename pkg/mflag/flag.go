@@ -95,6 +95,11 @@ import (
 	"github.com/docker/docker/pkg/homedir"
 )
 
+// Translate returns the message to print for the given message of the
+// package, e.g. in the language of the user. By default, messages are printed
+// as is.
+var Translate = func(message string) string { return message }
+
 // ErrHelp is the error returned if the flag -help is invoked but no such flag is defined.
 var ErrHelp = errors.New("flag: help requested")
 
@@ -503,7 +508,7 @@ func (fs *FlagSet) CheckArgs() (message string) {
 func (fs *FlagSet) Set(name, value string) error {
 	flag, ok := fs.formal[name]
 	if !ok {
-		return fmt.Errorf("no such flag -%v", name)
+		return fmt.Errorf(Translate("no such flag -%v"), name)
 	}
 	if err := flag.Value.Set(value); err != nil {
 		return err
@@ -587,9 +592,9 @@ func PrintDefaults() {
 // defaultUsage is the default function to print a usage message.
 func defaultUsage(fs *FlagSet) {
 	if fs.name == "" {
-		fmt.Fprintf(fs.Out(), "Usage:\n")
+		fmt.Fprint(fs.Out(), Translate("Usage:\n"))
 	} else {
-		fmt.Fprintf(fs.Out(), "Usage of %s:\n", fs.name)
+		fmt.Fprintf(fs.Out(), Translate("Usage of %s:\n"), fs.name)
 	}
 	fs.PrintDefaults()
 }
@@ -601,14 +606,14 @@ func defaultUsage(fs *FlagSet) {
 // Usage prints to standard error a usage message documenting all defined command-line flags.
 // The function is a variable that may be changed to point to a custom function.
 var Usage = func() {
-	fmt.Fprintf(CommandLine.Out(), "Usage of %s:\n", os.Args[0])
+	fmt.Fprintf(CommandLine.Out(), Translate("Usage of %s:\n"), os.Args[0])
 	PrintDefaults()
 }
 
 // ShortUsage prints to standard error a usage message documenting the standard command layout
 // The function is a variable that may be changed to point to a custom function.
 var ShortUsage = func() {
-	fmt.Fprintf(CommandLine.output, "Usage of %s:\n", os.Args[0])
+	fmt.Fprintf(CommandLine.output, Translate("Usage of %s:\n"), os.Args[0])
 }
 
 // FlagCount returns the number of flags that have been defined.
@@ -940,9 +945,9 @@ func (fs *FlagSet) failf(format string, a ...interface{}) error {
 	err := fmt.Errorf(format, a...)
 	fmt.Fprintln(fs.Out(), err)
 	if os.Args[0] == fs.name {
-		fmt.Fprintf(fs.Out(), "See '%s --help'.\n", os.Args[0])
+		fmt.Fprintf(fs.Out(), Translate("See '%s --help'.\n"), os.Args[0])
 	} else {
-		fmt.Fprintf(fs.Out(), "See '%s %s --help'.\n", os.Args[0], fs.name)
+		fmt.Fprintf(fs.Out(), Translate("See '%s %s --help'.\n"), os.Args[0], fs.name)
 	}
 	return err
 }
@@ -1008,7 +1013,7 @@ func (fs *FlagSet) parseOne() (bool, string, error) {
 	}
 	name := s[1:]
 	if len(name) == 0 || name[0] == '=' {
-		return false, "", fs.failf("bad flag syntax: %s", s)
+		return false, "", fs.failf(Translate("bad flag syntax: %s"), s)
 	}
 
 	// it's a flag. does it have an argument?
@@ -1029,14 +1034,14 @@ func (fs *FlagSet) parseOne() (bool, string, error) {
 			return false, "", ErrHelp
 		}
 		if len(name) > 0 && name[0] == '-' {
-			return false, "", fs.failf("flag provided but not defined: -%s", name)
+			return false, "", fs.failf(Translate("flag provided but not defined: -%s"), name)
 		}
 		return false, name, ErrRetry
 	}
 	if fv, ok := flag.Value.(boolFlag); ok && fv.IsBoolFlag() { // special case: doesn't need an arg
 		if hasValue {
 			if err := fv.Set(value); err != nil {
-				return false, "", fs.failf("invalid boolean value %q for  -%s: %v", value, name, err)
+				return false, "", fs.failf(Translate("invalid boolean value %q for  -%s: %v"), value, name, err)
 			}
 		} else {
 			fv.Set("true")
@@ -1049,10 +1054,10 @@ func (fs *FlagSet) parseOne() (bool, string, error) {
 			value, fs.args = fs.args[0], fs.args[1:]
 		}
 		if !hasValue {
-			return false, "", fs.failf("命令行需要一个参数: -%s", name)
+			return false, "", fs.failf(Translate("flag needs an argument: -%s"), name)
 		}
 		if err := flag.Value.Set(value); err != nil {
-			return false, "", fs.failf("invalid value %q for flag -%s: %v", value, name, err)
+			return false, "", fs.failf(Translate("invalid value %q for flag -%s: %v"), value, name, err)
 		}
 	}
 	if fs.actual == nil {
@@ -1069,9 +1074,9 @@ func (fs *FlagSet) parseOne() (bool, string, error) {
 				}
 			}
 			if replacement != "" {
-				fmt.Fprintf(fs.Out(), "Warning: '-%s' is deprecated, it will be replaced by '-%s' soon. See usage.\n", name, replacement)
+				fmt.Fprintf(fs.Out(), Translate("Warning: '-%s' is deprecated, it will be replaced by '-%s' soon. See usage.\n"), name, replacement)
 			} else {
-				fmt.Fprintf(fs.Out(), "Warning: '-%s' is deprecated, it will be removed soon. See usage.\n", name)
+				fmt.Fprintf(fs.Out(), Translate("Warning: '-%s' is deprecated, it will be removed soon. See usage.\n"), name)
 			}
 		}
 	}
@@ -1103,7 +1108,7 @@ func (fs *FlagSet) Parse(arguments []string) error {
 						continue
 					}
 					if err2 != nil {
-						err = fs.failf("flag provided but not defined: -%s", name)
+						err = fs.failf(Translate("flag provided but not defined: -%s"), name)
 						break
 					}
 				}
@@ -1111,7 +1116,7 @@ func (fs *FlagSet) Parse(arguments []string) error {
 					continue
 				}
 			} else {
-				err = fs.failf("flag provided but not defined: -%s", name)
+				err = fs.failf(Translate("flag provided but not defined: -%s"), name)
 			}
 		}
 		switch fs.errorHandling {
@@ -1248,9 +1253,9 @@ func Merge(dest *FlagSet, flagsets ...*FlagSet) error {
 			if _, ok := dest.formal[k]; ok {
 				var err error
 				if fset.name == "" {
-					err = fmt.Errorf("flag redefined: %s", k)
+					err = fmt.Errorf(Translate("flag redefined: %s"), k)
 				} else {
-					err = fmt.Errorf("%s flag redefined: %s", fset.name, k)
+					err = fmt.Errorf(Translate("%s flag redefined: %s"), fset.name, k)
 				}
 				fmt.Fprintln(fset.Out(), err.Error())
 				// Happens only if flags are declared with identical names
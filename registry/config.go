@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"net"
 	"net/url"
+	"sort"
 	"strings"
 
 	"github.com/docker/docker/opts"
@@ -128,6 +129,22 @@ func newServiceConfig(options ServiceOptions) *serviceConfig {
 	return config
 }
 
+// insecureIndexes returns the insecure registries and subnets of config as a
+// sorted list, suitable for comparing configurations.
+func insecureIndexes(config *serviceConfig) []string {
+	var indexes []string
+	for _, ipnet := range config.InsecureRegistryCIDRs {
+		indexes = append(indexes, (*net.IPNet)(ipnet).String())
+	}
+	for name, index := range config.IndexConfigs {
+		if !index.Secure {
+			indexes = append(indexes, name)
+		}
+	}
+	sort.Strings(indexes)
+	return indexes
+}
+
 // isSecureIndex returns false if the provided indexName is part of the list of insecure registries
 // Insecure registries accept HTTP and/or accept HTTPS with certificates from unknown CAs.
 //
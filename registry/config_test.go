@@ -47,3 +47,49 @@ func TestValidateMirror(t *testing.T) {
 		}
 	}
 }
+
+func TestReplaceConfig(t *testing.T) {
+	s := NewService(ServiceOptions{
+		Mirrors:            []string{"https://mirror-1.com"},
+		InsecureRegistries: []string{"insecure.example.com"},
+	})
+	previous := s.ServiceConfig()
+
+	changed, err := s.ReplaceConfig(ServiceOptions{
+		Mirrors:            []string{"https://mirror-2.com"},
+		InsecureRegistries: []string{"insecure.example.com"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(changed) != 1 || changed[0] != "registry-mirrors" {
+		t.Fatalf("expected only registry-mirrors to change, got %v", changed)
+	}
+	if mirrors := s.ServiceConfig().Mirrors; len(mirrors) != 1 || mirrors[0] != "https://mirror-2.com/" {
+		t.Fatalf("expected the new mirror to be used, got %v", mirrors)
+	}
+	if previous.Mirrors[0] != "https://mirror-1.com" {
+		t.Fatalf("expected the previous configuration to be left untouched, got %v", previous.Mirrors)
+	}
+
+	changed, err = s.ReplaceConfig(ServiceOptions{
+		Mirrors:            []string{"https://mirror-2.com"},
+		InsecureRegistries: []string{"10.0.0.0/8"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(changed) != 1 || changed[0] != "insecure-registries" {
+		t.Fatalf("expected only insecure-registries to change, got %v", changed)
+	}
+	if !isSecureIndex(s.currentConfig(), "insecure.example.com") {
+		t.Fatal("expected insecure.example.com to be secure after reload")
+	}
+
+	if _, err := s.ReplaceConfig(ServiceOptions{Mirrors: []string{"ftp://mirror-3.com"}}); err == nil {
+		t.Fatal("expected an error for an invalid mirror")
+	}
+	if mirrors := s.ServiceConfig().Mirrors; mirrors[0] != "https://mirror-2.com/" {
+		t.Fatalf("expected a failed reload to keep the configuration, got %v", mirrors)
+	}
+}
@@ -0,0 +1,169 @@
+package registry
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	registrytypes "github.com/docker/engine-api/types/registry"
+)
+
+const (
+	// healthDecay is the weight given to the latest observation in the
+	// moving averages of the error rate and of the latency.
+	healthDecay = 0.3
+	// minSkipDuration is the time a mirror is skipped after a failure. It
+	// doubles with each consecutive failure, up to maxSkipDuration.
+	minSkipDuration = 30 * time.Second
+	maxSkipDuration = 10 * time.Minute
+)
+
+// endpointHealth is the health state of a registry endpoint.
+type endpointHealth struct {
+	errorRate           float64
+	latency             time.Duration
+	consecutiveFailures uint
+	lastFailure         time.Time
+	lastError           string
+	skipUntil           time.Time
+}
+
+// healthTracker records the outcome of the requests made to registry
+// endpoints, keyed by endpoint URL. The zero value is ready to use.
+type healthTracker struct {
+	mu        sync.Mutex
+	endpoints map[string]*endpointHealth
+	// now is replaced in tests.
+	now func() time.Time
+}
+
+func (t *healthTracker) get(endpoint string) *endpointHealth {
+	if t.endpoints == nil {
+		t.endpoints = make(map[string]*endpointHealth)
+	}
+	h, ok := t.endpoints[endpoint]
+	if !ok {
+		h = &endpointHealth{}
+		t.endpoints[endpoint] = h
+	}
+	return h
+}
+
+func (t *healthTracker) time() time.Time {
+	if t.now != nil {
+		return t.now()
+	}
+	return time.Now()
+}
+
+// reportResult records the outcome of a pull from the endpoint. A failure
+// makes the endpoint skipped for a while, increasingly long if it keeps
+// failing.
+func (t *healthTracker) reportResult(endpoint string, err error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	h := t.get(endpoint)
+	if err == nil {
+		h.errorRate = (1 - healthDecay) * h.errorRate
+		h.consecutiveFailures = 0
+		h.skipUntil = time.Time{}
+		return
+	}
+
+	now := t.time()
+	h.errorRate = (1-healthDecay)*h.errorRate + healthDecay
+	h.consecutiveFailures++
+	h.lastFailure = now
+	h.lastError = err.Error()
+
+	skip := maxSkipDuration
+	if h.consecutiveFailures <= 5 {
+		skip = minSkipDuration << (h.consecutiveFailures - 1)
+		if skip > maxSkipDuration {
+			skip = maxSkipDuration
+		}
+	}
+	h.skipUntil = now.Add(skip)
+}
+
+// reportLatency records the round trip time to the endpoint.
+func (t *healthTracker) reportLatency(endpoint string, latency time.Duration) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	h := t.get(endpoint)
+	if h.latency == 0 {
+		h.latency = latency
+		return
+	}
+	h.latency = time.Duration((1-healthDecay)*float64(h.latency) + healthDecay*float64(latency))
+}
+
+// orderMirrors returns the mirrors in the order they should be tried: the
+// mirrors with the lowest error rate first, then the fastest ones. Mirrors
+// that recently failed are left out until their skip period is over.
+func (t *healthTracker) orderMirrors(mirrors []string) []string {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	now := t.time()
+	var available []string
+	for _, mirror := range mirrors {
+		if h, ok := t.endpoints[mirror]; ok && now.Before(h.skipUntil) {
+			continue
+		}
+		available = append(available, mirror)
+	}
+
+	sort.Stable(byHealth{mirrors: available, endpoints: t.endpoints})
+	return available
+}
+
+// mirrorHealth returns the health state of the mirrors.
+func (t *healthTracker) mirrorHealth(mirrors []string) []registrytypes.MirrorHealth {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	now := t.time()
+	health := make([]registrytypes.MirrorHealth, 0, len(mirrors))
+	for _, mirror := range mirrors {
+		mh := registrytypes.MirrorHealth{Mirror: mirror}
+		if h, ok := t.endpoints[mirror]; ok {
+			mh.ErrorRate = h.errorRate
+			mh.Latency = h.latency
+			mh.LastFailure = h.lastFailure
+			mh.LastError = h.lastError
+			if now.Before(h.skipUntil) {
+				mh.SkippedUntil = h.skipUntil
+			}
+		}
+		health = append(health, mh)
+	}
+	return health
+}
+
+// byHealth sorts endpoints by increasing error rate, then by increasing
+// latency. Error rates are compared in steps of 10% so that an old failure
+// does not outweigh a much lower latency. Endpoints without latency
+// measurement come first, so that they get measured.
+type byHealth struct {
+	mirrors   []string
+	endpoints map[string]*endpointHealth
+}
+
+func (b byHealth) Len() int      { return len(b.mirrors) }
+func (b byHealth) Swap(i, j int) { b.mirrors[i], b.mirrors[j] = b.mirrors[j], b.mirrors[i] }
+func (b byHealth) Less(i, j int) bool {
+	var hi, hj endpointHealth
+	if h, ok := b.endpoints[b.mirrors[i]]; ok {
+		hi = *h
+	}
+	if h, ok := b.endpoints[b.mirrors[j]]; ok {
+		hj = *h
+	}
+	if ri, rj := int(hi.errorRate*10), int(hj.errorRate*10); ri != rj {
+		return ri < rj
+	}
+	return hi.latency < hj.latency
+}
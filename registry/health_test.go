@@ -0,0 +1,103 @@
+package registry
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestHealthTrackerSkipsFailingMirrors(t *testing.T) {
+	now := time.Now()
+	tracker := &healthTracker{now: func() time.Time { return now }}
+	mirrors := []string{"https://mirror-1.com/", "https://mirror-2.com/"}
+
+	tracker.reportResult(mirrors[0], errors.New("connection refused"))
+	if ordered := tracker.orderMirrors(mirrors); !reflect.DeepEqual(ordered, mirrors[1:]) {
+		t.Fatalf("expected failing mirror to be skipped, got %v", ordered)
+	}
+
+	health := tracker.mirrorHealth(mirrors)
+	if health[0].LastError != "connection refused" || !health[0].SkippedUntil.Equal(now.Add(minSkipDuration)) {
+		t.Fatalf("unexpected health for failing mirror: %+v", health[0])
+	}
+	if !health[1].LastFailure.IsZero() || !health[1].SkippedUntil.IsZero() {
+		t.Fatalf("unexpected health for healthy mirror: %+v", health[1])
+	}
+
+	// Once the skip period is over, the mirror is tried again, after the
+	// healthy one.
+	now = now.Add(minSkipDuration)
+	if ordered := tracker.orderMirrors(mirrors); !reflect.DeepEqual(ordered, []string{mirrors[1], mirrors[0]}) {
+		t.Fatalf("expected failing mirror to be tried last, got %v", ordered)
+	}
+
+	// Consecutive failures make the mirror skipped for longer.
+	tracker.reportResult(mirrors[0], errors.New("connection refused"))
+	if skip := tracker.endpoints[mirrors[0]].skipUntil.Sub(now); skip != 2*minSkipDuration {
+		t.Fatalf("expected mirror to be skipped for %s, got %s", 2*minSkipDuration, skip)
+	}
+	for i := 0; i < 10; i++ {
+		tracker.reportResult(mirrors[0], errors.New("connection refused"))
+	}
+	if skip := tracker.endpoints[mirrors[0]].skipUntil.Sub(now); skip != maxSkipDuration {
+		t.Fatalf("expected mirror to be skipped for %s, got %s", maxSkipDuration, skip)
+	}
+
+	// A success makes the mirror available immediately.
+	tracker.reportResult(mirrors[0], nil)
+	if ordered := tracker.orderMirrors(mirrors); len(ordered) != 2 {
+		t.Fatalf("expected recovered mirror to be available, got %v", ordered)
+	}
+}
+
+func TestHealthTrackerOrdersByLatency(t *testing.T) {
+	tracker := &healthTracker{}
+	mirrors := []string{"https://slow.com/", "https://unknown.com/", "https://fast.com/"}
+
+	tracker.reportLatency(mirrors[0], 300*time.Millisecond)
+	tracker.reportLatency(mirrors[2], 20*time.Millisecond)
+
+	expected := []string{"https://unknown.com/", "https://fast.com/", "https://slow.com/"}
+	if ordered := tracker.orderMirrors(mirrors); !reflect.DeepEqual(ordered, expected) {
+		t.Fatalf("expected %v, got %v", expected, ordered)
+	}
+
+	tracker.reportLatency(mirrors[2], 120*time.Millisecond)
+	if latency := tracker.endpoints[mirrors[2]].latency; latency != 50*time.Millisecond {
+		t.Fatalf("expected averaged latency of 50ms, got %s", latency)
+	}
+}
+
+func TestLookupEndpointsSkipsFailingMirrors(t *testing.T) {
+	s := NewService(ServiceOptions{Mirrors: []string{"https://mirror-1.com", "mirror-2.com"}})
+
+	endpoints, err := s.LookupPullEndpoints(IndexName)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !endpoints[0].Mirror || endpoints[0].URL.String() != "https://mirror-1.com" {
+		t.Fatalf("expected the first mirror to be tried first, got %s", endpoints[0].URL)
+	}
+	s.ReportEndpointResult(endpoints[0], errors.New("timeout"))
+
+	endpoints, err = s.LookupPullEndpoints(IndexName)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var urls []string
+	for _, endpoint := range endpoints {
+		if endpoint.Version == APIVersion2 {
+			urls = append(urls, endpoint.URL.String())
+		}
+	}
+	expected := []string{"https://mirror-2.com", DefaultV2Registry.String()}
+	if !reflect.DeepEqual(urls, expected) {
+		t.Fatalf("expected endpoints %v, got %v", expected, urls)
+	}
+
+	health := s.MirrorHealth()
+	if len(health) != 2 || health[0].LastError != "timeout" || health[1].LastError != "" {
+		t.Fatalf("unexpected mirror health %+v", health)
+	}
+}
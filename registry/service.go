@@ -3,9 +3,13 @@ package registry
 import (
 	"crypto/tls"
 	"fmt"
+	"net"
 	"net/http"
 	"net/url"
+	"reflect"
 	"strings"
+	"sync"
+	"time"
 
 	"golang.org/x/net/context"
 
@@ -30,13 +34,22 @@ type Service interface {
 	ResolveIndex(name string) (*registrytypes.IndexInfo, error)
 	Search(ctx context.Context, term string, limit int, authConfig *types.AuthConfig, userAgent string, headers map[string][]string) (*registrytypes.SearchResults, error)
 	ServiceConfig() *registrytypes.ServiceConfig
+	ReplaceConfig(options ServiceOptions) (changed []string, err error)
+	ReportEndpointResult(endpoint APIEndpoint, err error)
+	ReportEndpointLatency(endpoint APIEndpoint, latency time.Duration)
+	MirrorHealth() []registrytypes.MirrorHealth
 	TLSConfig(hostname string) (*tls.Config, error)
 }
 
 // DefaultService is a registry service. It tracks configuration data such as a list
 // of mirrors.
 type DefaultService struct {
+	// mu protects config. The configuration itself is never modified once
+	// built, it is replaced as a whole by ReplaceConfig.
+	mu     sync.Mutex
 	config *serviceConfig
+
+	health healthTracker
 }
 
 // NewService returns a new instance of DefaultService ready to be
@@ -49,7 +62,86 @@ func NewService(options ServiceOptions) *DefaultService {
 
 // ServiceConfig returns the public registry service configuration.
 func (s *DefaultService) ServiceConfig() *registrytypes.ServiceConfig {
-	return &s.config.ServiceConfig
+	return &s.currentConfig().ServiceConfig
+}
+
+// ReplaceConfig validates the registry options and atomically replaces the
+// configuration of the service with them. Operations already in progress
+// keep using the previous configuration. It returns the names of the options
+// whose value changed.
+func (s *DefaultService) ReplaceConfig(options ServiceOptions) ([]string, error) {
+	mirrors := make([]string, 0, len(options.Mirrors))
+	for _, mirror := range options.Mirrors {
+		m, err := ValidateMirror(mirror)
+		if err != nil {
+			return nil, err
+		}
+		mirrors = append(mirrors, m)
+	}
+	insecureRegistries := make([]string, 0, len(options.InsecureRegistries))
+	for _, r := range options.InsecureRegistries {
+		if _, _, err := net.ParseCIDR(r); err != nil {
+			if _, err := ValidateIndexName(r); err != nil {
+				return nil, err
+			}
+		}
+		insecureRegistries = append(insecureRegistries, r)
+	}
+	options.Mirrors = mirrors
+	options.InsecureRegistries = insecureRegistries
+	config := newServiceConfig(options)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var changed []string
+	if !reflect.DeepEqual(s.config.Mirrors, config.Mirrors) {
+		changed = append(changed, "registry-mirrors")
+	}
+	if !reflect.DeepEqual(insecureIndexes(s.config), insecureIndexes(config)) {
+		changed = append(changed, "insecure-registries")
+	}
+	if s.config.V2Only != config.V2Only {
+		changed = append(changed, "disable-legacy-registry")
+	}
+	s.config = config
+	return changed, nil
+}
+
+// ReportEndpointResult records whether pulling from the endpoint succeeded.
+// Mirrors that fail are tried after the healthy ones, or skipped for a while
+// if they keep failing.
+func (s *DefaultService) ReportEndpointResult(endpoint APIEndpoint, err error) {
+	s.health.reportResult(endpoint.URL.String(), err)
+}
+
+// ReportEndpointLatency records the time the endpoint took to answer.
+func (s *DefaultService) ReportEndpointLatency(endpoint APIEndpoint, latency time.Duration) {
+	s.health.reportLatency(endpoint.URL.String(), latency)
+}
+
+// MirrorHealth returns the health state of the configured mirrors.
+func (s *DefaultService) MirrorHealth() []registrytypes.MirrorHealth {
+	return s.health.mirrorHealth(s.mirrors())
+}
+
+// mirrors returns the URLs of the configured mirrors, defaulting to HTTPS
+// for the ones without scheme.
+func (s *DefaultService) mirrors() []string {
+	var mirrors []string
+	for _, mirror := range s.currentConfig().Mirrors {
+		if !strings.HasPrefix(mirror, "http://") && !strings.HasPrefix(mirror, "https://") {
+			mirror = "https://" + mirror
+		}
+		mirrors = append(mirrors, mirror)
+	}
+	return mirrors
+}
+
+func (s *DefaultService) currentConfig() *serviceConfig {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.config
 }
 
 // Auth contacts the public registry with the provided credentials,
@@ -122,7 +214,7 @@ func (s *DefaultService) Search(ctx context.Context, term string, limit int, aut
 
 	indexName, remoteName := splitReposSearchTerm(term)
 
-	index, err := newIndexInfo(s.config, indexName)
+	index, err := newIndexInfo(s.currentConfig(), indexName)
 	if err != nil {
 		return nil, err
 	}
@@ -186,12 +278,12 @@ func (s *DefaultService) Search(ctx context.Context, term string, limit int, aut
 // ResolveRepository splits a repository name into its components
 // and configuration of the associated registry.
 func (s *DefaultService) ResolveRepository(name reference.Named) (*RepositoryInfo, error) {
-	return newRepositoryInfo(s.config, name)
+	return newRepositoryInfo(s.currentConfig(), name)
 }
 
 // ResolveIndex takes indexName and returns index info
 func (s *DefaultService) ResolveIndex(name string) (*registrytypes.IndexInfo, error) {
-	return newIndexInfo(s.config, name)
+	return newIndexInfo(s.currentConfig(), name)
 }
 
 // APIEndpoint represents a remote API endpoint
@@ -211,7 +303,7 @@ func (e APIEndpoint) ToV1Endpoint(userAgent string, metaHeaders http.Header) (*V
 
 // TLSConfig constructs a client TLS configuration based on server defaults
 func (s *DefaultService) TLSConfig(hostname string) (*tls.Config, error) {
-	return newTLSConfig(hostname, isSecureIndex(s.config, hostname))
+	return newTLSConfig(hostname, isSecureIndex(s.currentConfig(), hostname))
 }
 
 func (s *DefaultService) tlsConfigForMirror(mirrorURL *url.URL) (*tls.Config, error) {
@@ -246,7 +338,7 @@ func (s *DefaultService) lookupEndpoints(hostname string) (endpoints []APIEndpoi
 		return nil, err
 	}
 
-	if s.config.V2Only {
+	if s.currentConfig().V2Only {
 		return endpoints, nil
 	}
 
@@ -2,7 +2,6 @@ package registry
 
 import (
 	"net/url"
-	"strings"
 
 	"github.com/docker/go-connections/tlsconfig"
 )
@@ -11,11 +10,8 @@ func (s *DefaultService) lookupV2Endpoints(hostname string) (endpoints []APIEndp
 	var cfg = tlsconfig.ServerDefault
 	tlsConfig := &cfg
 	if hostname == DefaultNamespace || hostname == DefaultV1Registry.Host {
-		// v2 mirrors
-		for _, mirror := range s.config.Mirrors {
-			if !strings.HasPrefix(mirror, "http://") && !strings.HasPrefix(mirror, "https://") {
-				mirror = "https://" + mirror
-			}
+		// v2 mirrors, healthiest first
+		for _, mirror := range s.health.orderMirrors(s.mirrors()) {
 			mirrorURL, err := url.Parse(mirror)
 			if err != nil {
 				return nil, err
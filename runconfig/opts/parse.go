@@ -10,6 +10,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/docker/docker/cli/i18n"
 	"github.com/docker/docker/opts"
 	"github.com/docker/docker/pkg/mount"
 	"github.com/docker/docker/pkg/signal"
@@ -145,103 +146,103 @@ func AddFlags(flags *pflag.FlagSet) *ContainerOptions {
 	}
 
 	// General purpose flags
-	flags.VarP(&copts.flAttach, "attach", "a", "附加标准输入、标准输出和标准错误")
-	flags.Var(&copts.flDevices, "device", "为容器添加一个宿主机设备Add")
-	flags.VarP(&copts.flEnv, "env", "e", "设置容器运行时环境变量")
-	flags.Var(&copts.flEnvFile, "env-file", "从一个文件中为容器读取环境变量")
-	flags.StringVar(&copts.flEntrypoint, "entrypoint", "", "覆盖镜像默认的ENTRYPOINT")
-	flags.Var(&copts.flGroupAdd, "group-add", "添加容器加入的额外组")
-	flags.StringVarP(&copts.flHostname, "hostname", "h", "", "容器的主机名")
-	flags.BoolVarP(&copts.flStdin, "interactive", "i", false, "即使不被附加也保持标准输入打开")
-	flags.VarP(&copts.flLabels, "label", "l", "为一个容器上设置元数据")
-	flags.Var(&copts.flLabelsFile, "label-file", "从一个标签文件中读取标签信息")
-	flags.BoolVar(&copts.flReadonlyRootfs, "read-only", false, "将容器的根文件系统挂载为只读模式")
-	flags.StringVar(&copts.flRestartPolicy, "restart", "no", "当一个容器退出时为其采取的重启策略")
-	flags.StringVar(&copts.flStopSignal, "stop-signal", signal.DefaultStopSignal, fmt.Sprintf("停止一个容器的信号, 默认是 %v", signal.DefaultStopSignal))
-	flags.Var(copts.flSysctls, "sysctl", "系统控制 sysctl 选项")
-	flags.BoolVarP(&copts.flTty, "tty", "t", false, "分配一个伪终端")
-	flags.Var(copts.flUlimits, "ulimit", "用户限制 Ulimit 选项")
-	flags.StringVarP(&copts.flUser, "user", "u", "", "用户名或用户ID (格式: <用户名|用户ID>[:<组|组ID>])")
-	flags.StringVarP(&copts.flWorkingDir, "workdir", "w", "", "进程在容器内部的工作目录")
+	flags.VarP(&copts.flAttach, "attach", "a", i18n.T("Attach to STDIN, STDOUT or STDERR"))
+	flags.Var(&copts.flDevices, "device", i18n.T("Add a host device to the container"))
+	flags.VarP(&copts.flEnv, "env", "e", i18n.T("Set environment variables"))
+	flags.Var(&copts.flEnvFile, "env-file", i18n.T("Read in a file of environment variables"))
+	flags.StringVar(&copts.flEntrypoint, "entrypoint", "", i18n.T("Overwrite the default ENTRYPOINT of the image"))
+	flags.Var(&copts.flGroupAdd, "group-add", i18n.T("Add additional groups to join"))
+	flags.StringVarP(&copts.flHostname, "hostname", "h", "", i18n.T("Container host name"))
+	flags.BoolVarP(&copts.flStdin, "interactive", "i", false, i18n.T("Keep STDIN open even if not attached"))
+	flags.VarP(&copts.flLabels, "label", "l", i18n.T("Set meta data on a container"))
+	flags.Var(&copts.flLabelsFile, "label-file", i18n.T("Read in a line delimited file of labels"))
+	flags.BoolVar(&copts.flReadonlyRootfs, "read-only", false, i18n.T("Mount the container's root filesystem as read only"))
+	flags.StringVar(&copts.flRestartPolicy, "restart", "no", i18n.T("Restart policy to apply when a container exits"))
+	flags.StringVar(&copts.flStopSignal, "stop-signal", signal.DefaultStopSignal, fmt.Sprintf(i18n.T("Signal to stop a container, %v by default"), signal.DefaultStopSignal))
+	flags.Var(copts.flSysctls, "sysctl", i18n.T("Sysctl options"))
+	flags.BoolVarP(&copts.flTty, "tty", "t", false, i18n.T("Allocate a pseudo-TTY"))
+	flags.Var(copts.flUlimits, "ulimit", i18n.T("Ulimit options"))
+	flags.StringVarP(&copts.flUser, "user", "u", "", i18n.T("Username or UID (format: <name|uid>[:<group|gid>])"))
+	flags.StringVarP(&copts.flWorkingDir, "workdir", "w", "", i18n.T("Working directory inside the container"))
 
 	// Security
-	flags.Var(&copts.flCapAdd, "cap-add", "添加 Linux 特权")
-	flags.Var(&copts.flCapDrop, "cap-drop", "丢弃 Linux 特权")
-	flags.BoolVar(&copts.flPrivileged, "privileged", false, "授予容器所有的特权")
-	flags.Var(&copts.flSecurityOpt, "security-opt", "安全选项")
-	flags.StringVar(&copts.flUsernsMode, "userns", "", "使用的用户命名空间")
+	flags.Var(&copts.flCapAdd, "cap-add", i18n.T("Add Linux capabilities"))
+	flags.Var(&copts.flCapDrop, "cap-drop", i18n.T("Drop Linux capabilities"))
+	flags.BoolVar(&copts.flPrivileged, "privileged", false, i18n.T("Give extended privileges to this container"))
+	flags.Var(&copts.flSecurityOpt, "security-opt", i18n.T("Security Options"))
+	flags.StringVar(&copts.flUsernsMode, "userns", "", i18n.T("User namespace to use"))
 
 	// Network and port publishing flag
-	flags.Var(&copts.flExtraHosts, "add-host", "为容器添加一个自定义的主机名到IP的映射(主机名:IP)")
-	flags.Var(&copts.flDNS, "dns", "设置自定义的DNS服务器地址")
-	flags.Var(&copts.flDNSOptions, "dns-opt", "设置DNS选项")
-	flags.Var(&copts.flDNSSearch, "dns-search", "设置自定义的DNS搜索域")
-	flags.Var(&copts.flExpose, "expose", "暴露一个或者指定范围的端口")
-	flags.StringVar(&copts.flIPv4Address, "ip", "", "容器的IPv4地址(比如172.30.100.104)")
-	flags.StringVar(&copts.flIPv6Address, "ip6", "", "容器的IPv6地址(比如2001:db8::33)")
-	flags.Var(&copts.flLinks, "link", "添加到另一个容器的连接")
-	flags.Var(&copts.flLinkLocalIPs, "link-local-ip", "容器 IPv4/IPv6 本地连接地址")
-	flags.StringVar(&copts.flMacAddress, "mac-address", "", "容器MAC地址 (e.g. 92:d0:c6:0a:29:33)")
-	flags.VarP(&copts.flPublish, "publish", "p", "将容器内部端口映射到宿主机的指定端口")
-	flags.BoolVarP(&copts.flPublishAll, "publish-all", "P", false, "映射容器内部的所有端口到宿主机上的随机端口")
+	flags.Var(&copts.flExtraHosts, "add-host", i18n.T("Add a custom host-to-IP mapping (host:ip)"))
+	flags.Var(&copts.flDNS, "dns", i18n.T("Set custom DNS servers"))
+	flags.Var(&copts.flDNSOptions, "dns-opt", i18n.T("Set DNS options"))
+	flags.Var(&copts.flDNSSearch, "dns-search", i18n.T("Set custom DNS search domains"))
+	flags.Var(&copts.flExpose, "expose", i18n.T("Expose a port or a range of ports"))
+	flags.StringVar(&copts.flIPv4Address, "ip", "", i18n.T("Container IPv4 address (e.g. 172.30.100.104)"))
+	flags.StringVar(&copts.flIPv6Address, "ip6", "", i18n.T("Container IPv6 address (e.g. 2001:db8::33)"))
+	flags.Var(&copts.flLinks, "link", i18n.T("Add link to another container"))
+	flags.Var(&copts.flLinkLocalIPs, "link-local-ip", i18n.T("Container IPv4/IPv6 link-local addresses"))
+	flags.StringVar(&copts.flMacAddress, "mac-address", "", i18n.T("Container MAC address (e.g. 92:d0:c6:0a:29:33)"))
+	flags.VarP(&copts.flPublish, "publish", "p", i18n.T("Publish a container's port(s) to the host"))
+	flags.BoolVarP(&copts.flPublishAll, "publish-all", "P", false, i18n.T("Publish all exposed ports to random ports"))
 	// We allow for both "--net" and "--network", although the latter is the recommended way.
-	flags.StringVar(&copts.flNetMode, "net", "default", "为容器指定网络类型")
-	flags.StringVar(&copts.flNetMode, "network", "default", "为容器指定网络类型")
+	flags.StringVar(&copts.flNetMode, "net", "default", i18n.T("Connect a container to a network"))
+	flags.StringVar(&copts.flNetMode, "network", "default", i18n.T("Connect a container to a network"))
 	flags.MarkHidden("net")
 	// We allow for both "--net-alias" and "--network-alias", although the latter is the recommended way.
-	flags.Var(&copts.flAliases, "net-alias", "为容器添加网络范围内的别名")
-	flags.Var(&copts.flAliases, "network-alias", "为容器添加网络范围内的别名")
+	flags.Var(&copts.flAliases, "net-alias", i18n.T("Add network-scoped alias for the container"))
+	flags.Var(&copts.flAliases, "network-alias", i18n.T("Add network-scoped alias for the container"))
 	flags.MarkHidden("net-alias")
 
 	// Logging and storage
-	flags.StringVar(&copts.flLoggingDriver, "log-driver", "", "为容器指定日志驱动")
-	flags.StringVar(&copts.flVolumeDriver, "volume-driver", "", "为容器指定可选的存储卷驱动")
-	flags.Var(&copts.flLoggingOpts, "log-opt", "日志驱动选项")
-	flags.Var(&copts.flStorageOpt, "storage-opt", "为容器设置存储驱动选项")
-	flags.Var(&copts.flTmpfs, "tmpfs", "挂载一个临时文件系统目录")
-	flags.Var(&copts.flVolumesFrom, "volumes-from", "从指定的容器挂载存储卷")
-	flags.VarP(&copts.flVolumes, "volume", "v", "绑定挂载一个存储卷")
+	flags.StringVar(&copts.flLoggingDriver, "log-driver", "", i18n.T("Logging driver for container"))
+	flags.StringVar(&copts.flVolumeDriver, "volume-driver", "", i18n.T("Optional volume driver for the container"))
+	flags.Var(&copts.flLoggingOpts, "log-opt", i18n.T("Log driver options"))
+	flags.Var(&copts.flStorageOpt, "storage-opt", i18n.T("Storage driver options for the container"))
+	flags.Var(&copts.flTmpfs, "tmpfs", i18n.T("Mount a tmpfs directory"))
+	flags.Var(&copts.flVolumesFrom, "volumes-from", i18n.T("Mount volumes from the specified container(s)"))
+	flags.VarP(&copts.flVolumes, "volume", "v", i18n.T("Bind mount a volume"))
 
 	// Health-checking
-	flags.StringVar(&copts.flHealthCmd, "health-cmd", "", "运行健康的检查的命令")
-	flags.DurationVar(&copts.flHealthInterval, "health-interval", 0, "运行健康检查的时间间隔")
-	flags.IntVar(&copts.flHealthRetries, "health-retries", 0, "需要汇报不健康的最终错误次数")
-	flags.DurationVar(&copts.flHealthTimeout, "health-timeout", 0, "允许一次健康检查运行的最长时间")
-	flags.BoolVar(&copts.flNoHealthcheck, "no-healthcheck", false, "禁用容器内任何指定的健康检查")
+	flags.StringVar(&copts.flHealthCmd, "health-cmd", "", i18n.T("Command to run to check health"))
+	flags.DurationVar(&copts.flHealthInterval, "health-interval", 0, i18n.T("Time between running the check"))
+	flags.IntVar(&copts.flHealthRetries, "health-retries", 0, i18n.T("Consecutive failures needed to report unhealthy"))
+	flags.DurationVar(&copts.flHealthTimeout, "health-timeout", 0, i18n.T("Maximum time to allow one check to run"))
+	flags.BoolVar(&copts.flNoHealthcheck, "no-healthcheck", false, i18n.T("Disable any container-specified HEALTHCHECK"))
 
 	// Resource management
-	flags.Uint16Var(&copts.flBlkioWeight, "blkio-weight", 0, "磁盘IO设置(相对值),从10到1000")
-	flags.Var(&copts.flBlkioWeightDevice, "blkio-weight-device", "磁盘设备IO设置(相对值),从10到1000")
-	flags.StringVar(&copts.flContainerIDFile, "cidfile", "", "写容器ID的文件路径地址")
-	flags.StringVar(&copts.flCpusetCpus, "cpuset-cpus", "", "允许容器执行的CPU核指定(0-3,0,1): 0-3代表运行运行在0,1,2,3这4个核上")
-	flags.StringVar(&copts.flCpusetMems, "cpuset-mems", "", "允许容器执行的CPU内存所在核指定(0-3,0,1): 0-3代表运行运行在0,1,2,3这4个核上")
-	flags.Int64Var(&copts.flCPUPercent, "cpu-percent", 0, "CPU百分比(只支持Windows)")
-	flags.Int64Var(&copts.flCPUPeriod, "cpu-period", 0, "限制CPU绝对公平调度算法（CFS）的时间周期")
-	flags.Int64Var(&copts.flCPUQuota, "cpu-quota", 0, "限制CPU绝对公平调度算法（CFS）的时间限额")
-	flags.Int64VarP(&copts.flCPUShares, "cpu-shares", "c", 0, "CPU计算资源的值(相对值)")
-	flags.Var(&copts.flDeviceReadBps, "device-read-bps", "限制一个设备的读速率(bps)")
-	flags.Var(&copts.flDeviceReadIOps, "device-read-iops", "限制一个设备的读速率(IOps)")
-	flags.Var(&copts.flDeviceWriteBps, "device-write-bps", "限制一个设备的写速率(bps)")
-	flags.Var(&copts.flDeviceWriteIOps, "device-write-iops", "限制一个设备的写速率(IOps)")
-	flags.StringVar(&copts.flIOMaxBandwidth, "io-maxbandwidth", "", "系统驱动的最大IO带宽限制(只支持Windows)")
-	flags.Uint64Var(&copts.flIOMaxIOps, "io-maxiops", 0, "系统驱动的最大IOps限制(只支持Windows)")
-	flags.StringVar(&copts.flKernelMemory, "kernel-memory", "", "内核内存限制")
-	flags.StringVarP(&copts.flMemoryString, "memory", "m", "", "内存限制")
-	flags.StringVar(&copts.flMemoryReservation, "memory-reservation", "", "内存软限制")
-	flags.StringVar(&copts.flMemorySwap, "memory-swap", "", "交换内存限制 等于 实际内存 ＋ 交换区内存: '-1' 代表启用不受限的交换区内存")
-	flags.Int64Var(&copts.flSwappiness, "memory-swappiness", -1, "设置容器内存swappiness参数 (0 到 100)")
-	flags.BoolVar(&copts.flOomKillDisable, "oom-kill-disable", false, "禁用OOM Killer")
-	flags.IntVar(&copts.flOomScoreAdj, "oom-score-adj", 0, "设置OOM偏好参数 (-1000 至 1000)")
-	flags.Int64Var(&copts.flPidsLimit, "pids-limit", 0, "设置容器进程上限(设置-1代表没有限制)")
+	flags.Uint16Var(&copts.flBlkioWeight, "blkio-weight", 0, i18n.T("Block IO (relative weight), between 10 and 1000"))
+	flags.Var(&copts.flBlkioWeightDevice, "blkio-weight-device", i18n.T("Block IO weight (relative device weight)"))
+	flags.StringVar(&copts.flContainerIDFile, "cidfile", "", i18n.T("Write the container ID to the file"))
+	flags.StringVar(&copts.flCpusetCpus, "cpuset-cpus", "", i18n.T("CPUs in which to allow execution (0-3, 0,1)"))
+	flags.StringVar(&copts.flCpusetMems, "cpuset-mems", "", i18n.T("MEMs in which to allow execution (0-3, 0,1)"))
+	flags.Int64Var(&copts.flCPUPercent, "cpu-percent", 0, i18n.T("CPU percent (Windows only)"))
+	flags.Int64Var(&copts.flCPUPeriod, "cpu-period", 0, i18n.T("Limit CPU CFS (Completely Fair Scheduler) period"))
+	flags.Int64Var(&copts.flCPUQuota, "cpu-quota", 0, i18n.T("Limit CPU CFS (Completely Fair Scheduler) quota"))
+	flags.Int64VarP(&copts.flCPUShares, "cpu-shares", "c", 0, i18n.T("CPU shares (relative weight)"))
+	flags.Var(&copts.flDeviceReadBps, "device-read-bps", i18n.T("Limit read rate (bytes per second) from a device"))
+	flags.Var(&copts.flDeviceReadIOps, "device-read-iops", i18n.T("Limit read rate (IO per second) from a device"))
+	flags.Var(&copts.flDeviceWriteBps, "device-write-bps", i18n.T("Limit write rate (bytes per second) to a device"))
+	flags.Var(&copts.flDeviceWriteIOps, "device-write-iops", i18n.T("Limit write rate (IO per second) to a device"))
+	flags.StringVar(&copts.flIOMaxBandwidth, "io-maxbandwidth", "", i18n.T("Maximum IO bandwidth limit for the system drive (Windows only)"))
+	flags.Uint64Var(&copts.flIOMaxIOps, "io-maxiops", 0, i18n.T("Maximum IOps limit for the system drive (Windows only)"))
+	flags.StringVar(&copts.flKernelMemory, "kernel-memory", "", i18n.T("Kernel memory limit"))
+	flags.StringVarP(&copts.flMemoryString, "memory", "m", "", i18n.T("Memory limit"))
+	flags.StringVar(&copts.flMemoryReservation, "memory-reservation", "", i18n.T("Memory soft limit"))
+	flags.StringVar(&copts.flMemorySwap, "memory-swap", "", i18n.T("Swap limit equal to memory plus swap: '-1' to enable unlimited swap"))
+	flags.Int64Var(&copts.flSwappiness, "memory-swappiness", -1, i18n.T("Tune container memory swappiness (0 to 100)"))
+	flags.BoolVar(&copts.flOomKillDisable, "oom-kill-disable", false, i18n.T("Disable OOM Killer"))
+	flags.IntVar(&copts.flOomScoreAdj, "oom-score-adj", 0, i18n.T("Tune host's OOM preferences (-1000 to 1000)"))
+	flags.Int64Var(&copts.flPidsLimit, "pids-limit", 0, i18n.T("Tune container pids limit (set -1 for unlimited)"))
 
 	// Low-level execution (cgroups, namespaces, ...)
-	flags.StringVar(&copts.flCgroupParent, "cgroup-parent", "", "为容器设置的可选cgroup父系统")
-	flags.StringVar(&copts.flIpcMode, "ipc", "", "使用的IPC命名空间")
-	flags.StringVar(&copts.flIsolation, "isolation", "", "容器的隔离技术")
-	flags.StringVar(&copts.flPidMode, "pid", "", "使用的PID命名空间")
-	flags.StringVar(&copts.flShmSize, "shm-size", "", "内存共享文件的/dev/shm的大小, 默认值为64MB")
-	flags.StringVar(&copts.flUTSMode, "uts", "", "使用的UTS命名空间")
-	flags.StringVar(&copts.flRuntime, "runtime", "", "为容器选择的容器运行时驱动类型")
+	flags.StringVar(&copts.flCgroupParent, "cgroup-parent", "", i18n.T("Optional parent cgroup for the container"))
+	flags.StringVar(&copts.flIpcMode, "ipc", "", i18n.T("IPC namespace to use"))
+	flags.StringVar(&copts.flIsolation, "isolation", "", i18n.T("Container isolation technology"))
+	flags.StringVar(&copts.flPidMode, "pid", "", i18n.T("PID namespace to use"))
+	flags.StringVar(&copts.flShmSize, "shm-size", "", i18n.T("Size of /dev/shm, default value is 64MB"))
+	flags.StringVar(&copts.flUTSMode, "uts", "", i18n.T("UTS namespace to use"))
+	flags.StringVar(&copts.flRuntime, "runtime", "", i18n.T("Runtime to use for this container"))
 	return copts
 }
 